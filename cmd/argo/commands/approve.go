@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflow"
+)
+
+type approveOps struct {
+	nodeFieldSelector string   // --node-field-selector
+	parameters        []string // --parameter
+	message           string   // --message
+}
+
+func NewApproveCommand() *cobra.Command {
+	var approveArgs approveOps
+
+	command := &cobra.Command{
+		Use:   "approve WORKFLOW1 WORKFLOW2...",
+		Short: "approve the approval gates of zero or more workflows",
+		Long: `Approve the approval gates of zero or more workflows, which are resumed once enough approvers approve them.
+
+You must be one of the approvers of the gates, and be allowed to update the workflows.`,
+		Example: `# Approve the approval gates of a workflow:
+
+  argo approve my-wf
+
+# Approve an approval gate, supplying its output parameters:
+
+  argo approve my-wf --node-field-selector displayName=approve -p version=1.2.3 -m "LGTM"
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parameters := make(map[string]string)
+			for _, param := range approveArgs.parameters {
+				name, value, ok := strings.Cut(param, "=")
+				if !ok {
+					return fmt.Errorf("expected parameter of the form: NAME=VALUE. Received: %s", param)
+				}
+				if unquoted, err := strconv.Unquote(value); err == nil {
+					value = unquoted
+				}
+				parameters[name] = value
+			}
+			selector, err := fields.ParseSelector(approveArgs.nodeFieldSelector)
+			if err != nil {
+				return fmt.Errorf("unable to parse node field selector '%s': %w", approveArgs.nodeFieldSelector, err)
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			namespace := client.Namespace(ctx)
+			for _, wfName := range args {
+				_, err := serviceClient.ApproveWorkflow(ctx, &workflowpkg.WorkflowApproveRequest{
+					Name:              wfName,
+					Namespace:         namespace,
+					NodeFieldSelector: selector.String(),
+					Parameters:        parameters,
+					Message:           approveArgs.message,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to approve %s: %w", wfName, err)
+				}
+				fmt.Printf("workflow %s approved\n", wfName)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&approveArgs.nodeFieldSelector, "node-field-selector", "", "selector of the approval gates to approve, eg: --node-field-selector displayName=approve")
+	command.Flags().StringArrayVarP(&approveArgs.parameters, "parameter", "p", []string{}, "output parameter of the approval gate to supply, eg: -p version=1.2.3")
+	command.Flags().StringVarP(&approveArgs.message, "message", "m", "", "reason for approving, recorded on the node")
+	return command
+}
@@ -0,0 +1,150 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v4/persist/archivefile"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowarchive"
+)
+
+type exportFlags struct {
+	allNamespaces bool     // --all-namespaces
+	selector      string   // --selector
+	where         []string // --where
+	format        string   // --format
+	key           string   // --key
+}
+
+func NewExportCommand() *cobra.Command {
+	var flags exportFlags
+	command := &cobra.Command{
+		Use:   "export [FILE]",
+		Short: "export workflows from the archive to a file",
+		Long: `Export archived workflows, with their offloaded node status, to a file which can be imported into another archive with "argo archive import".
+
+The file is written to the path, or to stdout if it is "-" or there is no path. With --key, the file is written by the Argo Server to the namespace's artifact repository instead.
+
+The format is newline-delimited JSON, or Parquet if the path or key ends with .parquet.`,
+		Example: `# Export the archived workflows of the namespace:
+  argo archive export workflows.ndjson
+
+# Export the archived workflows of all namespaces as Parquet:
+  argo archive export -A workflows.parquet
+
+# Export the failed archived workflows with a label:
+  argo archive export -l team=data --where status.phase=Failed > failed.ndjson
+
+# Export the archived workflows to the namespace's artifact repository:
+  argo archive export --key archive/workflows.parquet
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "-"
+			if len(args) == 1 {
+				path = args[0]
+			}
+			if flags.key != "" && len(args) == 1 {
+				return errors.New("cannot export to both a file and an artifact repository")
+			}
+			name := path
+			if flags.key != "" {
+				name = flags.key
+			}
+			format, err := fileFormat(flags.format, name)
+			if err != nil {
+				return err
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if flags.allNamespaces {
+				namespace = ""
+			}
+			req := &workflowarchivepkg.ExportArchivedWorkflowsRequest{
+				Namespace:   namespace,
+				ListOptions: &metav1.ListOptions{LabelSelector: flags.selector, FieldSelector: whereFieldSelector(flags.where)},
+				Format:      string(format),
+				Key:         flags.key,
+			}
+			if flags.key != "" {
+				resp, err := serviceClient.ExportArchivedWorkflowsToArtifactRepository(ctx, req)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Exported %d archived workflows to %s\n", resp.Exported, flags.key)
+				return nil
+			}
+			out := io.Writer(os.Stdout)
+			if path != "-" {
+				file, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+			exported, err := exportArchivedWorkflows(ctx, serviceClient, req, out, format)
+			if err != nil {
+				return err
+			}
+			// stdout may be the file
+			_, _ = fmt.Fprintf(os.Stderr, "Exported %d archived workflows\n", exported)
+			return nil
+		},
+	}
+	command.Flags().BoolVarP(&flags.allNamespaces, "all-namespaces", "A", false, "Export workflows from all namespaces")
+	command.Flags().StringVarP(&flags.selector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringArrayVar(&flags.where, "where", []string{}, "Field of the workflow to filter on, as 'path=value' or 'path!=value', where '*' in the value matches anything. Can be repeated.")
+	command.Flags().StringVar(&flags.format, "format", "", "Format of the file, one of: "+strings.Join(archivefile.Formats, "|")+". The default is parquet if the path or key ends with .parquet, otherwise ndjson.")
+	command.Flags().StringVar(&flags.key, "key", "", "Key to write the file to in the namespace's artifact repository, instead of a local file")
+	return command
+}
+
+// fileFormat returns the format of the file, from the flag if it is set, otherwise from the extension of the path
+func fileFormat(flag, path string) (archivefile.Format, error) {
+	if flag == "" && strings.HasSuffix(path, ".parquet") {
+		return archivefile.Parquet, nil
+	}
+	return archivefile.ParseFormat(flag)
+}
+
+func exportArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, req *workflowarchivepkg.ExportArchivedWorkflowsRequest, out io.Writer, format archivefile.Format) (int64, error) {
+	writer, err := archivefile.NewWriter(out, format)
+	if err != nil {
+		return 0, err
+	}
+	stream, err := serviceClient.ExportArchivedWorkflows(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	var exported int64
+	for {
+		wf, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return exported, err
+		}
+		if err := writer.Write(wf); err != nil {
+			return exported, err
+		}
+		exported++
+	}
+	return exported, writer.Close()
+}
@@ -0,0 +1,84 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v4/persist/archivefile"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+type exportStream struct {
+	grpc.ClientStream
+	workflows []*wfv1.Workflow
+}
+
+func (s *exportStream) Recv() (*wfv1.Workflow, error) {
+	if len(s.workflows) == 0 {
+		return nil, io.EOF
+	}
+	wf := s.workflows[0]
+	s.workflows = s.workflows[1:]
+	return wf, nil
+}
+
+func TestFileFormat(t *testing.T) {
+	for _, tt := range []struct {
+		flag, path string
+		want       archivefile.Format
+	}{
+		{"", "-", archivefile.NDJSON},
+		{"", "workflows.ndjson", archivefile.NDJSON},
+		{"", "workflows.parquet", archivefile.Parquet},
+		{"ndjson", "workflows.parquet", archivefile.NDJSON},
+		{"parquet", "-", archivefile.Parquet},
+	} {
+		got, err := fileFormat(tt.flag, tt.path)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, tt.flag+" "+tt.path)
+	}
+	_, err := fileFormat("csv", "workflows.csv")
+	require.Error(t, err)
+}
+
+func TestExportImportArchivedWorkflows(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	workflows := make([]*wfv1.Workflow, importBatchSize+1)
+	for i := range workflows {
+		workflows[i] = &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("wf-%d", i), Namespace: "my-ns", UID: types.UID(fmt.Sprintf("uid-%d", i))}}
+	}
+	req := &workflowarchivepkg.ExportArchivedWorkflowsRequest{Namespace: "my-ns"}
+	for _, format := range []archivefile.Format{archivefile.NDJSON, archivefile.Parquet} {
+		t.Run(string(format), func(t *testing.T) {
+			serviceClient := &mockArchivedWorkflowServiceClient{}
+			serviceClient.On("ExportArchivedWorkflows", mock.Anything, req, mock.Anything).Return(&exportStream{workflows: workflows}, nil)
+			var batches []int
+			recordBatch := func(args mock.Arguments) {
+				batches = append(batches, len(args.Get(1).(*workflowarchivepkg.ImportArchivedWorkflowsRequest).Workflows))
+			}
+			serviceClient.On("ImportArchivedWorkflows", mock.Anything, mock.Anything, mock.Anything).Run(recordBatch).Return(&workflowarchivepkg.ImportArchivedWorkflowsResponse{Imported: importBatchSize}, nil).Once()
+			serviceClient.On("ImportArchivedWorkflows", mock.Anything, mock.Anything, mock.Anything).Run(recordBatch).Return(&workflowarchivepkg.ImportArchivedWorkflowsResponse{Imported: 1}, nil).Once()
+
+			buf := &bytes.Buffer{}
+			exported, err := exportArchivedWorkflows(ctx, serviceClient, req, buf, format)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(workflows)), exported)
+
+			imported, err := importArchivedWorkflows(ctx, serviceClient, buf, format)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(workflows)), imported)
+			assert.Equal(t, []int{importBatchSize, 1}, batches)
+		})
+	}
+}
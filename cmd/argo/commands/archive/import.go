@@ -0,0 +1,142 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v4/persist/archivefile"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// the workflows of a file are imported in batches, each of which is at most this many workflows and bytes
+	importBatchSize  = 100
+	importBatchBytes = 8 << 20
+)
+
+func NewImportCommand() *cobra.Command {
+	var (
+		format string
+		key    string
+	)
+	command := &cobra.Command{
+		Use:   "import [FILE...]",
+		Short: "import workflows into the archive from files",
+		Long: `Import archived workflows from files written by "argo archive export".
+
+The workflows are archived as if they completed in this cluster, so they have the cluster name and instance ID of the Argo Server. Workflows which are already in the archive are replaced, so importing a file again is safe.
+
+The files are read from the paths, or from stdin if a path is "-" or there are no paths. With --key, the file is read by the Argo Server from the namespace's artifact repository instead.
+
+The format is newline-delimited JSON, or Parquet if the path or key ends with .parquet.`,
+		Example: `# Import archived workflows from a file:
+  argo archive import workflows.ndjson
+
+# Import archived workflows from Parquet files:
+  argo archive import january.parquet february.parquet
+
+# Import archived workflows from the namespace's artifact repository:
+  argo archive import --key archive/workflows.parquet
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if key != "" && len(args) > 0 {
+				return errors.New("cannot import from both files and an artifact repository")
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			if key != "" {
+				f, err := fileFormat(format, key)
+				if err != nil {
+					return err
+				}
+				resp, err := serviceClient.ImportArchivedWorkflowsFromArtifactRepository(ctx, &workflowarchivepkg.ImportArchivedWorkflowsFromArtifactRepositoryRequest{
+					Namespace: client.Namespace(ctx),
+					Key:       key,
+					Format:    string(f),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Imported %d archived workflows from %s\n", resp.Imported, key)
+				return nil
+			}
+			if len(args) == 0 {
+				args = []string{"-"}
+			}
+			for _, path := range args {
+				f, err := fileFormat(format, path)
+				if err != nil {
+					return err
+				}
+				imported, err := importArchivedWorkflowsFile(ctx, serviceClient, path, f)
+				if err != nil {
+					return fmt.Errorf("failed to import %s: %w", path, err)
+				}
+				fmt.Printf("Imported %d archived workflows from %s\n", imported, path)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&format, "format", "", "Format of the files, one of: "+strings.Join(archivefile.Formats, "|")+". The default is parquet if the path or key ends with .parquet, otherwise ndjson.")
+	command.Flags().StringVar(&key, "key", "", "Key to read the file from in the namespace's artifact repository, instead of local files")
+	return command
+}
+
+func importArchivedWorkflowsFile(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, path string, format archivefile.Format) (int64, error) {
+	if path == "-" {
+		return importArchivedWorkflows(ctx, serviceClient, os.Stdin, format)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	return importArchivedWorkflows(ctx, serviceClient, file, format)
+}
+
+func importArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, in io.Reader, format archivefile.Format) (int64, error) {
+	var imported int64
+	req := &workflowarchivepkg.ImportArchivedWorkflowsRequest{}
+	size := 0
+	send := func() error {
+		if len(req.Workflows) == 0 {
+			return nil
+		}
+		resp, err := serviceClient.ImportArchivedWorkflows(ctx, req)
+		if err != nil {
+			return err
+		}
+		imported += resp.Imported
+		req.Workflows = nil
+		size = 0
+		return nil
+	}
+	err := archivefile.Read(in, format, func(wf *wfv1.Workflow) error {
+		if len(req.Workflows) >= importBatchSize || size+wf.Size() > importBatchBytes {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+		req.Workflows = append(req.Workflows, wf)
+		size += wf.Size()
+		return nil
+	})
+	if err != nil {
+		return imported, err
+	}
+	return imported, send()
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/common"
@@ -19,6 +21,7 @@ import (
 func NewListCommand() *cobra.Command {
 	var (
 		selector  string
+		where     []string
 		output    = common.NewPrintWorkflowOutputValue("wide")
 		chunkSize int64
 	)
@@ -36,6 +39,15 @@ func NewListCommand() *cobra.Command {
 
 # List archived workflows that have both labels:
   argo archive list -l key1=value1,key2=value2
+
+# List archived workflows where a parameter was set, and the deploy step failed:
+  argo archive list --where spec.arguments.parameters.region=eu-west-1 --where status.nodes.deploy.phase=Failed
+
+# List archived workflows where any step has a message containing "timeout":
+  argo archive list --where 'status.nodes.*.message=*timeout*'
+
+# List archived workflows by any field of the workflow:
+  argo archive list --where spec.serviceAccountName=my-sa
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
@@ -47,7 +59,7 @@ func NewListCommand() *cobra.Command {
 				return err
 			}
 			namespace := client.Namespace(ctx)
-			workflows, err := listArchivedWorkflows(ctx, serviceClient, namespace, selector, chunkSize)
+			workflows, err := listArchivedWorkflows(ctx, serviceClient, namespace, selector, whereFieldSelector(where), chunkSize)
 			if err != nil {
 				return err
 			}
@@ -56,13 +68,35 @@ func NewListCommand() *cobra.Command {
 	}
 	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringArrayVar(&where, "where", []string{}, "Field of the workflow to filter on, as 'path=value' or 'path!=value', where '*' in the value matches anything. Parameters, and the phase and message of each step by name, are indexed. Can be repeated.")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	return command
 }
 
-func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace string, labelSelector string, chunkSize int64) (wfv1.Workflows, error) {
+// whereFieldSelector returns the field selector of the --where conditions, escaping their values
+func whereFieldSelector(where []string) string {
+	selectors := make([]string, len(where))
+	for i, w := range where {
+		path, value, ok := strings.Cut(w, "=")
+		if !ok {
+			selectors[i] = w
+			continue
+		}
+		op := "="
+		if p, found := strings.CutSuffix(path, "!"); found {
+			path, op = p, "!="
+		} else if v, found := strings.CutPrefix(value, "="); found {
+			value, op = v, "=="
+		}
+		selectors[i] = path + op + fields.EscapeValue(value)
+	}
+	return strings.Join(selectors, ",")
+}
+
+func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace, labelSelector, fieldSelector string, chunkSize int64) (wfv1.Workflows, error) {
 	listOpts := &metav1.ListOptions{
 		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
 		Limit:         chunkSize,
 	}
 	var workflows wfv1.Workflows
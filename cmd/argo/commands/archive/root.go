@@ -20,5 +20,8 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewListLabelValueCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewRetryCommand())
+	command.AddCommand(NewStatsCommand())
+	command.AddCommand(NewExportCommand())
+	command.AddCommand(NewImportCommand())
 	return command
 }
@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/common"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+type statsFlags struct {
+	allNamespaces bool                 // --all-namespaces
+	groupBy       common.EnumFlagValue // --group-by
+	labelKey      string               // --label-key
+	selector      string               // --selector
+	since         time.Duration        // --since
+	bucket        string               // --bucket
+	output        common.EnumFlagValue // --output
+}
+
+func NewStatsCommand() *cobra.Command {
+	flags := statsFlags{
+		groupBy: common.EnumFlagValue{AllowedValues: []string{"template", "namespace", "label"}, Value: "template"},
+		output:  common.EnumFlagValue{AllowedValues: []string{"wide", "json", "yaml"}},
+	}
+	command := &cobra.Command{
+		Use:   "stats",
+		Short: "show statistics of the workflows in the archive",
+		Long: `Show the number of runs by phase, success rate, duration percentiles and resources duration of archived workflows.
+
+There is a series of buckets for each template, namespace or label value, with a bucket for each period of time that workflows started in.`,
+		Example: `# Show the statistics of each workflow template over the last week:
+  argo archive stats
+
+# Show the daily statistics of each namespace over the last 30 days:
+  argo archive stats -A --group-by namespace --since 720h --bucket 24h
+
+# Show the hourly statistics of each team, for workflows with a label:
+  argo archive stats --group-by label --label-key team -l env=prod --since 24h --bucket 1h
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if flags.allNamespaces {
+				namespace = ""
+			}
+			now := time.Now()
+			stats, err := serviceClient.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.ArchivedWorkflowStatsRequest{
+				Namespace:     namespace,
+				GroupBy:       flags.groupBy.String(),
+				LabelKey:      flags.labelKey,
+				LabelSelector: flags.selector,
+				StartTime:     now.Add(-flags.since).Format(time.RFC3339),
+				EndTime:       now.Format(time.RFC3339),
+				Bucket:        flags.bucket,
+			})
+			if err != nil {
+				return err
+			}
+			return printStats(os.Stdout, stats, flags.output.String())
+		},
+	}
+	command.Flags().BoolVarP(&flags.allNamespaces, "all-namespaces", "A", false, "Show statistics of workflows from all namespaces")
+	command.Flags().Var(&flags.groupBy, "group-by", "What to show a series for. "+flags.groupBy.Usage())
+	command.Flags().StringVar(&flags.labelKey, "label-key", "", "Label whose values to show a series for, when grouping by label")
+	command.Flags().StringVarP(&flags.selector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().DurationVar(&flags.since, "since", 7*24*time.Hour, "Show statistics of workflows which started within this duration")
+	command.Flags().StringVar(&flags.bucket, "bucket", "", "Duration of each bucket, e.g. 24h, the default is a single bucket")
+	command.Flags().VarP(&flags.output, "output", "o", "Output format. "+flags.output.Usage())
+	return command
+}
+
+func printStats(out io.Writer, stats *workflowarchivepkg.ArchivedWorkflowStats, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(stats)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(out, string(data))
+	default:
+		seconds := func(s int64) string { return (time.Duration(s) * time.Second).String() }
+		w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "GROUP\tSTART\tTOTAL\tSUCCEEDED\tFAILED\tERROR\tSUCCESS RATE\tP50\tP90\tP99\tCPU\tMEMORY")
+		for _, series := range stats.Series {
+			for _, b := range series.Buckets {
+				start := ""
+				if b.StartTime != nil {
+					start = b.StartTime.Format(time.RFC3339)
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%.0f%%\t%s\t%s\t%s\t%s\t%s\n", series.Group, start, b.Total,
+					b.Phases[string(wfv1.WorkflowSucceeded)], b.Phases[string(wfv1.WorkflowFailed)], b.Phases[string(wfv1.WorkflowError)],
+					b.SuccessRate*100, seconds(b.DurationP50), seconds(b.DurationP90), seconds(b.DurationP99),
+					seconds(b.ResourcesDuration["cpu"]), seconds(b.ResourcesDuration["memory"]))
+			}
+		}
+		return w.Flush()
+	}
+	return nil
+}
@@ -105,6 +105,34 @@ func (m *mockArchivedWorkflowServiceClient) ListArchivedWorkflowLabelValues(ctx
 	return args.Get(0).(*wfv1.LabelValues), args.Error(1)
 }
 
+func (m *mockArchivedWorkflowServiceClient) GetArchivedWorkflowStats(ctx context.Context, in *workflowarchivepkg.ArchivedWorkflowStatsRequest, opts ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowStats, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*workflowarchivepkg.ArchivedWorkflowStats), args.Error(1)
+}
+
+func (m *mockArchivedWorkflowServiceClient) ExportArchivedWorkflows(ctx context.Context, in *workflowarchivepkg.ExportArchivedWorkflowsRequest, opts ...grpc.CallOption) (workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsClient, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsClient), args.Error(1)
+}
+
+func (m *mockArchivedWorkflowServiceClient) ExportArchivedWorkflowsToArtifactRepository(ctx context.Context, in *workflowarchivepkg.ExportArchivedWorkflowsRequest, opts ...grpc.CallOption) (*workflowarchivepkg.ExportArchivedWorkflowsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*workflowarchivepkg.ExportArchivedWorkflowsResponse), args.Error(1)
+}
+
+func (m *mockArchivedWorkflowServiceClient) ImportArchivedWorkflows(ctx context.Context, in *workflowarchivepkg.ImportArchivedWorkflowsRequest, opts ...grpc.CallOption) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*workflowarchivepkg.ImportArchivedWorkflowsResponse), args.Error(1)
+}
+
+func (m *mockArchivedWorkflowServiceClient) ImportArchivedWorkflowsFromArtifactRepository(ctx context.Context, in *workflowarchivepkg.ImportArchivedWorkflowsFromArtifactRepositoryRequest, opts ...grpc.CallOption) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*workflowarchivepkg.ImportArchivedWorkflowsResponse), args.Error(1)
+}
+
 func (m *mockArchivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *workflowarchivepkg.RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*wfv1.Workflow, error) {
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*wfv1.Workflow), args.Error(1)
@@ -0,0 +1,113 @@
+package artifact
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/common"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
+	"github.com/argoproj/argo-workflows/v4/util/humanize"
+)
+
+type lineageFlags struct {
+	allNamespaces bool                 // --all-namespaces
+	digest        string               // --digest
+	depth         int32                // --depth
+	output        common.EnumFlagValue // --output
+}
+
+func NewLineageCommand() *cobra.Command {
+	flags := lineageFlags{
+		output: common.EnumFlagValue{AllowedValues: []string{"wide", "json", "yaml"}},
+	}
+	command := &cobra.Command{
+		Use:   "lineage [KEY]",
+		Short: "show the workflows which produced and consumed an artifact",
+		Long: `Show the workflows which produced and consumed an artifact, and the workflows upstream and downstream of them.
+
+Lineage is recorded when workflows are archived, and requires "persistence.artifactLineage" to be enabled in the workflow controller configuration.`,
+		Example: `# Show the workflows which produced and consumed an artifact:
+  argo artifact lineage my-wf/my-wf-123/main.tgz
+
+# Show the lineage of an artifact in every namespace, following up to three workflows upstream and downstream:
+  argo artifact lineage my-wf/my-wf-123/main.tgz -A --depth 3
+
+# Show the lineage of a content-addressed artifact by its digest:
+  argo artifact lineage --digest sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var key string
+			if len(args) == 1 {
+				key = args[0]
+			}
+			if key == "" && flags.digest == "" {
+				return fmt.Errorf("a key or --digest is required")
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArtifactLineageServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if flags.allNamespaces {
+				namespace = ""
+			}
+			graph, err := serviceClient.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{
+				Namespace: namespace,
+				Key:       key,
+				Digest:    flags.digest,
+				Depth:     flags.depth,
+			})
+			if err != nil {
+				return err
+			}
+			return printLineage(os.Stdout, graph, flags.output.String())
+		},
+	}
+	command.Flags().BoolVarP(&flags.allNamespaces, "all-namespaces", "A", false, "Show lineage from all namespaces")
+	command.Flags().StringVar(&flags.digest, "digest", "", "Digest of the artifact, e.g. sha256:abc...")
+	command.Flags().Int32Var(&flags.depth, "depth", 1, "Number of workflows to follow upstream and downstream of the direct producers and consumers")
+	command.Flags().VarP(&flags.output, "output", "o", "Output format. "+flags.output.Usage())
+	return command
+}
+
+func printLineage(out io.Writer, graph *artifactlineagepkg.ArtifactLineageGraph, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(graph)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(out, string(data))
+	default:
+		w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "NAMESPACE\tWORKFLOW\tNODE\tDIRECTION\tARTIFACT\tKEY\tAGE")
+		for _, e := range graph.Edges {
+			age := ""
+			if e.CreatedAt != nil {
+				age = humanize.RelativeDurationShort(e.CreatedAt.Time, time.Now())
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.Namespace, e.WorkflowName, e.NodeID, e.Direction, e.ArtifactName, e.Key, age)
+		}
+		return w.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package artifact
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewArtifactCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "artifact",
+		Short: "search artifacts across workflows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewLineageCommand())
+	return command
+}
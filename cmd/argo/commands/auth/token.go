@@ -9,7 +9,7 @@ import (
 )
 
 func NewTokenCommand() *cobra.Command {
-	return &cobra.Command{
+	command := &cobra.Command{
 		Use:   "token",
 		Short: "Print the auth token",
 		Example: `
@@ -18,6 +18,9 @@ func NewTokenCommand() *cobra.Command {
 
 # Save the token to a variable and send a request directly to the Argo API
   TOKEN=$(argo auth token) && curl -H "Authorization: Bearer $TOKEN" https://<ARGO_SERVER>/api/v1/userinfo
+
+# Create a scoped API token for a service account, see "argo auth token create"
+  argo auth token create --service-account deployer --verbs create --workflow-templates deploy-app
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -29,4 +32,8 @@ func NewTokenCommand() *cobra.Command {
 			return nil
 		},
 	}
+	command.AddCommand(NewTokenCreateCommand())
+	command.AddCommand(NewTokenListCommand())
+	command.AddCommand(NewTokenRevokeCommand())
+	return command
 }
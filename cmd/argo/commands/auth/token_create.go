@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/common"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+)
+
+type tokenCreateFlags struct {
+	serviceAccount    string               // --service-account
+	namespaces        []string             // --namespaces
+	verbs             []string             // --verbs
+	workflowTemplates []string             // --workflow-templates
+	expiresIn         string               // --expires-in
+	output            common.EnumFlagValue // --output
+}
+
+func NewTokenCreateCommand() *cobra.Command {
+	flags := tokenCreateFlags{
+		output: common.EnumFlagValue{AllowedValues: []string{"json", "yaml"}},
+	}
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "create an API token for a service account",
+		Long: `Create an API token for a service account, which the Argo Server accepts instead of a Kubernetes token.
+
+The token has the permissions of the service account, restricted to the namespaces, verbs and workflow templates of the token. You must be allowed to create tokens for the service account. The token is only printed once.`,
+		Example: `# Create a token that may only submit the deploy-app workflow template in the prod namespace:
+  argo auth token create --service-account deployer -n prod --verbs create --workflow-templates deploy-app
+
+# Create a read-only token which expires in a week:
+  argo auth token create --service-account viewer --verbs get,list,watch --expires-in 168h
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewAPITokenServiceClient()
+			if err != nil {
+				return err
+			}
+			resp, err := serviceClient.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{
+				Namespace:         client.Namespace(ctx),
+				ServiceAccount:    flags.serviceAccount,
+				Namespaces:        flags.namespaces,
+				Verbs:             flags.verbs,
+				WorkflowTemplates: flags.workflowTemplates,
+				ExpiresIn:         flags.expiresIn,
+			})
+			if err != nil {
+				return err
+			}
+			switch flags.output.String() {
+			case "json":
+				data, err := json.MarshalIndent(resp, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+			case "yaml":
+				data, err := yaml.Marshal(resp)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			default:
+				_, _ = fmt.Fprintf(os.Stderr, "Created API token %s, it expires at %s\n", resp.Token.Id, resp.Token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+				fmt.Println(resp.Authorization)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&flags.serviceAccount, "service-account", "", "Service account of the token, in the namespace")
+	command.Flags().StringSliceVar(&flags.namespaces, "namespaces", nil, "Namespaces the token may access, all namespaces the service account may access if empty")
+	command.Flags().StringSliceVar(&flags.verbs, "verbs", nil, "Verbs the token may use, one of get, list, watch, create, update or delete, all verbs if empty")
+	command.Flags().StringSliceVar(&flags.workflowTemplates, "workflow-templates", nil, "Workflow templates the token may access, e.g. submit, any workflow template if empty")
+	command.Flags().StringVar(&flags.expiresIn, "expires-in", "", "How long until the token expires, e.g. 24h (default 720h)")
+	command.Flags().VarP(&flags.output, "output", "o", "Output format. "+flags.output.Usage())
+	_ = command.MarkFlagRequired("service-account")
+	return command
+}
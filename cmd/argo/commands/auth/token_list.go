@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v4/util/humanize"
+)
+
+func NewTokenListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "list the API tokens of the service accounts in the namespace",
+		Example: `# List the API tokens of the service accounts in the prod namespace:
+  argo auth token list -n prod
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewAPITokenServiceClient()
+			if err != nil {
+				return err
+			}
+			list, err := serviceClient.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{Namespace: client.Namespace(ctx)})
+			if err != nil {
+				return err
+			}
+			return printTokens(os.Stdout, list.Items, time.Now())
+		},
+	}
+}
+
+func printTokens(out io.Writer, tokens []*apitokenpkg.APIToken, now time.Time) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tSERVICE ACCOUNT\tNAMESPACES\tVERBS\tWORKFLOW TEMPLATES\tEXPIRES")
+	for _, t := range tokens {
+		expires := ""
+		if t.ExpiresAt != nil {
+			if t.ExpiresAt.Time.After(now) {
+				expires = humanize.RelativeDurationShort(now, t.ExpiresAt.Time)
+			} else {
+				expires = "expired"
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", t.Id, t.ServiceAccount, orAll(t.Namespaces), orAll(t.Verbs), orAll(t.WorkflowTemplates), expires)
+	}
+	return w.Flush()
+}
+
+func orAll(values []string) string {
+	if len(values) == 0 {
+		return "*"
+	}
+	return strings.Join(values, ",")
+}
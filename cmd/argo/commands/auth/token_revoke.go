@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+)
+
+func NewTokenRevokeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke ID...",
+		Short: "revoke API tokens",
+		Example: `# Revoke an API token of a service account in the prod namespace:
+  argo auth token revoke -n prod 3f2a9c1e8b7d6a50
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewAPITokenServiceClient()
+			if err != nil {
+				return err
+			}
+			for _, id := range args {
+				if _, err := serviceClient.RevokeAPIToken(ctx, &apitokenpkg.RevokeAPITokenRequest{Namespace: client.Namespace(ctx), Id: id}); err != nil {
+					return err
+				}
+				fmt.Printf("API token %s revoked\n", id)
+			}
+			return nil
+		},
+	}
+}
@@ -133,6 +133,8 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.GCS.String())
 				case art.Azure != nil:
 					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.Azure.String())
+				case art.OCI != nil:
+					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.OCI.String())
 				}
 			}
 		}
@@ -235,7 +237,7 @@ func isBoundaryNode(node wfv1.NodeType) bool {
 }
 
 func isNonBoundaryParentNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry)
+	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry) || (node == wfv1.NodeTypeLoop)
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
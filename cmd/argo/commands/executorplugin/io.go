@@ -70,6 +70,12 @@ func saveReadme(pluginDir string, plug *spec.Plugin) (string, error) {
 
 * Needs: {{index .Annotations "workflows.argoproj.io/version"}}
 * Image: {{.Spec.Sidecar.Container.Image}}
+{{- with .Spec.Version}}
+* Version: {{.}}
+{{- end}}
+{{- with .Spec.Capabilities}}
+* Capabilities:{{range .}} {{.}}{{end}}
+{{- end}}
 
 {{index .Annotations "workflows.argoproj.io/description"}}
 
@@ -0,0 +1,175 @@
+package executorplugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	cmdcommon "github.com/argoproj/argo-workflows/v4/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/util/plugin"
+)
+
+// pluginStatus is an installed executor plugin, and the status of its sidecars in the running agent pods
+type pluginStatus struct {
+	namespace    string
+	name         string
+	version      string
+	capabilities []string
+	status       string
+	ready        int
+	agents       int
+}
+
+func NewListCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		output        = cmdcommon.EnumFlagValue{
+			AllowedValues: []string{"wide", "name"},
+		}
+	)
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list installed executor plugins and their status",
+		Long: `List the executor plugins installed as config maps, and the status of their sidecars in the running agent pods.
+
+A plugin is Invalid if its config map cannot be loaded, Installed if no running agent pod has it, Ready if its sidecar is ready in every running agent pod which has it, and NotReady otherwise.
+
+This command uses your kubeconfig, as executor plugins are installed with kubectl.`,
+		Example: `# List the executor plugins in the current namespace:
+  argo executor-plugin list
+
+# List the executor plugins in all namespaces, with how many agent pods' sidecars are ready:
+  argo executor-plugin list -A -o wide
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			restConfig, err := client.GetConfig().ClientConfig()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if allNamespaces {
+				namespace = ""
+			}
+			plugins, err := listPlugins(ctx, kubeClient, namespace)
+			if err != nil {
+				return err
+			}
+			switch output.String() {
+			case "", "wide":
+				printTable(plugins, allNamespaces, output.String() == "wide")
+			case "name":
+				for _, p := range plugins {
+					fmt.Println(p.name)
+				}
+			default:
+				return fmt.Errorf("unknown output mode: %s", output.String())
+			}
+			return nil
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show executor plugins from all namespaces")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+// listPlugins returns the executor plugins installed in the namespace, or all namespaces if it is empty, and the status
+// of their sidecars in the running agent pods in the same namespaces
+func listPlugins(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]pluginStatus, error) {
+	configMaps, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapExecutorPlugin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyComponent + "=agent",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var plugins []pluginStatus
+	for _, cm := range configMaps.Items {
+		p, err := plugin.FromConfigMap(&cm)
+		if err != nil {
+			plugins = append(plugins, pluginStatus{
+				namespace: cm.Namespace,
+				name:      strings.TrimSuffix(cm.Name, "-executor-plugin"),
+				status:    fmt.Sprintf("Invalid: %v", err),
+			})
+			continue
+		}
+		s := pluginStatus{
+			namespace:    cm.Namespace,
+			name:         p.Name,
+			version:      p.Spec.Version,
+			capabilities: p.Spec.Capabilities,
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != apiv1.PodRunning {
+				continue
+			}
+			for _, c := range pod.Status.ContainerStatuses {
+				if c.Name != p.Spec.Sidecar.Container.Name {
+					continue
+				}
+				s.agents++
+				if c.Ready {
+					s.ready++
+				}
+			}
+		}
+		switch {
+		case s.agents == 0:
+			s.status = "Installed"
+		case s.ready == s.agents:
+			s.status = "Ready"
+		default:
+			s.status = "NotReady"
+		}
+		plugins = append(plugins, s)
+	}
+	slices.SortFunc(plugins, func(a, b pluginStatus) int {
+		return strings.Compare(a.namespace+"/"+a.name, b.namespace+"/"+b.name)
+	})
+	return plugins, nil
+}
+
+func printTable(plugins []pluginStatus, allNamespaces, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if allNamespaces {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	_, _ = fmt.Fprint(w, "NAME\tVERSION\tCAPABILITIES\tSTATUS")
+	if wide {
+		_, _ = fmt.Fprint(w, "\tAGENTS")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, p := range plugins {
+		if allNamespaces {
+			_, _ = fmt.Fprintf(w, "%s\t", p.namespace)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s", p.name, p.version, strings.Join(p.capabilities, ","), p.status)
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%d/%d", p.ready, p.agents)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,62 @@
+package executorplugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+)
+
+func TestListPlugins(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	configMap := func(name string, data map[string]string) *apiv1.ConfigMap {
+		return &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-executor-plugin",
+				Namespace: "argo",
+				Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapExecutorPlugin},
+			},
+			Data: data,
+		}
+	}
+	container := func(name string) string {
+		return "{'name': '" + name + "', 'ports': [{}], 'resources': {'requests': {'cpu': '100m'}, 'limits': {'cpu': '100m'}}, 'securityContext': {}}"
+	}
+	agent := func(name string, statuses ...apiv1.ContainerStatus) *apiv1.Pod {
+		return &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argo",
+				Labels:    map[string]string{common.LabelKeyComponent: "agent"},
+			},
+			Status: apiv1.PodStatus{Phase: apiv1.PodRunning, ContainerStatuses: statuses},
+		}
+	}
+	kubeClient := fake.NewClientset(
+		configMap("hello", map[string]string{
+			"version":           "v1.0.0",
+			"capabilities":      "hello",
+			"sidecar.container": container("hello"),
+		}),
+		configMap("goodbye", map[string]string{"sidecar.container": container("goodbye")}),
+		configMap("idle", map[string]string{"sidecar.container": container("idle")}),
+		configMap("broken", map[string]string{}),
+		agent("agent-1", apiv1.ContainerStatus{Name: "hello", Ready: true}, apiv1.ContainerStatus{Name: "goodbye", Ready: true}),
+		agent("agent-2", apiv1.ContainerStatus{Name: "hello", Ready: true}, apiv1.ContainerStatus{Name: "goodbye"}),
+	)
+
+	plugins, err := listPlugins(ctx, kubeClient, "argo")
+	require.NoError(t, err)
+	assert.Equal(t, []pluginStatus{
+		{namespace: "argo", name: "broken", status: "Invalid: sidecar is invalid: at least one port is mandatory"},
+		{namespace: "argo", name: "goodbye", status: "NotReady", ready: 1, agents: 2},
+		{namespace: "argo", name: "hello", version: "v1.0.0", capabilities: []string{"hello"}, status: "Ready", ready: 2, agents: 2},
+		{namespace: "argo", name: "idle", status: "Installed"},
+	}, plugins)
+}
@@ -14,6 +14,7 @@ func NewRootCommand() *cobra.Command {
 	}
 
 	command.AddCommand(NewBuildCommand())
+	command.AddCommand(NewListCommand())
 
 	return command
 }
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflow"
+)
+
+type rejectOps struct {
+	nodeFieldSelector string // --node-field-selector
+	message           string // --message
+}
+
+func NewRejectCommand() *cobra.Command {
+	var rejectArgs rejectOps
+
+	command := &cobra.Command{
+		Use:   "reject WORKFLOW1 WORKFLOW2...",
+		Short: "reject the approval gates of zero or more workflows",
+		Long: `Reject the approval gates of zero or more workflows, which fails them.
+
+You must be one of the approvers of the gates, and be allowed to update the workflows.`,
+		Example: `# Reject the approval gates of a workflow:
+
+  argo reject my-wf -m "the change window is closed"
+
+# Reject an approval gate by node field selector:
+
+  argo reject my-wf --node-field-selector displayName=approve
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selector, err := fields.ParseSelector(rejectArgs.nodeFieldSelector)
+			if err != nil {
+				return fmt.Errorf("unable to parse node field selector '%s': %w", rejectArgs.nodeFieldSelector, err)
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			namespace := client.Namespace(ctx)
+			for _, wfName := range args {
+				_, err := serviceClient.RejectWorkflow(ctx, &workflowpkg.WorkflowRejectRequest{
+					Name:              wfName,
+					Namespace:         namespace,
+					NodeFieldSelector: selector.String(),
+					Message:           rejectArgs.message,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to reject %s: %w", wfName, err)
+				}
+				fmt.Printf("workflow %s rejected\n", wfName)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&rejectArgs.nodeFieldSelector, "node-field-selector", "", "selector of the approval gates to reject, eg: --node-field-selector displayName=approve")
+	command.Flags().StringVarP(&rejectArgs.message, "message", "m", "", "reason for rejecting, recorded on the node")
+	return command
+}
@@ -17,7 +17,6 @@ import (
 
 	"github.com/argoproj/argo-workflows/v4"
 	argoexecex "github.com/argoproj/argo-workflows/v4/cmd/argoexec/executor"
-	executorplugins "github.com/argoproj/argo-workflows/v4/pkg/plugins/executor"
 	"github.com/argoproj/argo-workflows/v4/util/env"
 	"github.com/argoproj/argo-workflows/v4/util/logs"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
@@ -83,6 +82,35 @@ func getPluginAddresses(ctx context.Context) []string {
 	return addresses
 }
 
+// getPluginVersions returns the version of each plugin, it is empty if the controller did not set them, e.g. because
+// it is older than the agent
+func getPluginVersions(ctx context.Context) []string {
+	var versions []string
+	value := os.Getenv(common.EnvVarPluginVersions)
+	if value == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), &versions); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithFatal().Error(ctx, "Failed to unmarshal plugin versions")
+		os.Exit(1)
+	}
+	return versions
+}
+
+// getPluginCapabilities returns the capabilities of each plugin, it is empty if the controller did not set them
+func getPluginCapabilities(ctx context.Context) [][]string {
+	var capabilities [][]string
+	value := os.Getenv(common.EnvVarPluginCapabilities)
+	if value == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), &capabilities); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithFatal().Error(ctx, "Failed to unmarshal plugin capabilities")
+		os.Exit(1)
+	}
+	return capabilities
+}
+
 func NewAgentMainCommand() *cobra.Command {
 	return &cobra.Command{
 		Use: "main",
@@ -126,7 +154,9 @@ func initAgentExecutor(ctx context.Context) *executor.AgentExecutor {
 
 	addresses := getPluginAddresses(ctx)
 	names := getPluginNames(ctx)
-	var plugins []executorplugins.TemplateExecutor
+	versions := getPluginVersions(ctx)
+	capabilities := getPluginCapabilities(ctx)
+	var plugins []*executor.Plugin
 	for i, address := range addresses {
 		name := names[i]
 		filename := tokenFilename(name)
@@ -138,7 +168,14 @@ func initAgentExecutor(ctx context.Context) *executor.AgentExecutor {
 			logger.WithError(err).WithFatal().Error(ctx, "Failed to read token file")
 			os.Exit(1)
 		}
-		plugins = append(plugins, rpc.New(address, string(data)))
+		plug := &executor.Plugin{TemplateExecutor: rpc.New(address, string(data)), Name: name}
+		if i < len(versions) {
+			plug.Version = versions[i]
+		}
+		if i < len(capabilities) {
+			plug.Capabilities = capabilities[i]
+		}
+		plugins = append(plugins, plug)
 	}
 
 	taskWorkers := env.LookupEnvIntOr(ctx, common.EnvAgentTaskWorkers, 16)
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+)
+
+func TestGetPluginVersionsAndCapabilities(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Unset", func(t *testing.T) {
+		t.Setenv(common.EnvVarPluginVersions, "")
+		t.Setenv(common.EnvVarPluginCapabilities, "")
+		assert.Empty(t, getPluginVersions(ctx))
+		assert.Empty(t, getPluginCapabilities(ctx))
+	})
+	t.Run("Set", func(t *testing.T) {
+		t.Setenv(common.EnvVarPluginVersions, `["v1"]`)
+		t.Setenv(common.EnvVarPluginCapabilities, `[["hello"]]`)
+		assert.Equal(t, []string{"v1"}, getPluginVersions(ctx))
+		assert.Equal(t, [][]string{{"hello"}}, getPluginCapabilities(ctx))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/argoproj/argo-workflows/v4/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v4/cmd/argoexec/executor"
+	argoerrs "github.com/argoproj/argo-workflows/v4/errors"
+	wf "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow"
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	workflow "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned"
 	wfv1alpha1 "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -21,6 +24,7 @@ import (
 	"github.com/argoproj/argo-workflows/v4/util/retry"
 	waitutil "github.com/argoproj/argo-workflows/v4/util/wait"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts"
+	artifactcommon "github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
 )
 
@@ -75,6 +79,7 @@ func deleteArtifacts(ctx context.Context, labelSelector string, artifactGCTaskIn
 	}
 
 	for _, task := range taskList.Items {
+		workflowUID := workflowUIDOf(task)
 		task.Status.ArtifactResultsByNode = make(map[string]v1alpha1.ArtifactResultNodeStatus)
 		for nodeName, artifactNodeSpec := range task.Spec.ArtifactsByNode {
 			var archiveLocation *v1alpha1.ArtifactLocation
@@ -99,7 +104,7 @@ func deleteArtifacts(ctx context.Context, labelSelector string, artifactGCTaskIn
 				}
 
 				err = waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
-					err = drv.Delete(ctx, &artifact)
+					err = deleteArtifact(ctx, drv, &artifact, workflowUID)
 					if err != nil {
 						errString := err.Error()
 						artResultNodeStatus.ArtifactResults[artifact.Name] = v1alpha1.ArtifactResult{Name: artifact.Name, Success: false, Error: &errString}
@@ -125,6 +130,58 @@ func deleteArtifacts(ctx context.Context, labelSelector string, artifactGCTaskIn
 	return nil
 }
 
+// workflowUIDOf returns the UID of the workflow which owns the task
+func workflowUIDOf(task v1alpha1.WorkflowArtifactGCTask) types.UID {
+	for _, ref := range task.OwnerReferences {
+		if ref.Kind == wf.WorkflowKind {
+			return ref.UID
+		}
+	}
+	return ""
+}
+
+// deleteArtifact deletes the artifact. Content-addressed artifacts can be shared by several workflows, each of which
+// records a reference to them, so only the workflow's reference is deleted, and then the artifact if no workflow
+// references it any more.
+func deleteArtifact(ctx context.Context, drv artifactcommon.ArtifactDriver, artifact *v1alpha1.Artifact, workflowUID types.UID) error {
+	if artifact.Digest == "" {
+		return drv.Delete(ctx, artifact)
+	}
+	if workflowUID == "" {
+		return fmt.Errorf("the workflow which references content-addressed artifact %q is not known", artifact.Name)
+	}
+	key, err := artifact.GetKey()
+	if err != nil {
+		return err
+	}
+	refsKey, err := v1alpha1.ContentAddressedRefsKey(key, artifact.Digest)
+	if err != nil {
+		return err
+	}
+	refArt := artifact.DeepCopy()
+	if err := refArt.SetKey(path.Join(refsKey, string(workflowUID))); err != nil {
+		return err
+	}
+	if err := drv.Delete(ctx, refArt); err != nil {
+		return err
+	}
+	refsArt := artifact.DeepCopy()
+	if err := refsArt.SetKey(refsKey); err != nil {
+		return err
+	}
+	refs, err := drv.ListObjects(ctx, refsArt)
+	// some drivers, e.g. S3, return not found when listing a prefix with no objects
+	if err != nil && !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return fmt.Errorf("failed to list the references to content-addressed artifact %q: %w", artifact.Name, err)
+	}
+	if len(refs) > 0 {
+		logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"artifact": artifact.Name, "key": key, "references": len(refs)}).
+			Info(ctx, "Content-addressed artifact is referenced by other workflows, keeping it")
+		return nil
+	}
+	return drv.Delete(ctx, artifact)
+}
+
 type resources struct {
 	Files map[string][]byte
 }
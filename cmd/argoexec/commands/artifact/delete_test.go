@@ -0,0 +1,108 @@
+package artifact
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoerrs "github.com/argoproj/argo-workflows/v4/errors"
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	artifactcommon "github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+// fakeArtifactDriver stores artifacts in memory, keyed by their S3 key. Like S3, listing a prefix with no objects is
+// not found.
+type fakeArtifactDriver struct {
+	artifactcommon.ArtifactDriver
+	objects map[string]bool
+	listErr error
+}
+
+func (d *fakeArtifactDriver) Delete(_ context.Context, art *v1alpha1.Artifact) error {
+	delete(d.objects, art.S3.Key)
+	return nil
+}
+
+func (d *fakeArtifactDriver) ListObjects(_ context.Context, art *v1alpha1.Artifact) ([]string, error) {
+	if d.listErr != nil {
+		return nil, d.listErr
+	}
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, art.S3.Key+"/") {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, "no key found of name "+art.S3.Key)
+	}
+	return keys, nil
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	const key = "cas/sha256/abc/my-art.tgz"
+	newArtifact := func(key, digest string) *v1alpha1.Artifact {
+		return &v1alpha1.Artifact{Name: "my-art", Digest: digest, ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: key}}}
+	}
+
+	t.Run("NotContentAddressed", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{"my-wf/my-pod/my-art.tgz": true}}
+		require.NoError(t, deleteArtifact(ctx, drv, newArtifact("my-wf/my-pod/my-art.tgz", ""), ""))
+		assert.Empty(t, drv.objects)
+	})
+	t.Run("Shared", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{
+			key:                                     true,
+			"cas/sha256/abc/.refs/my-art.tgz/uid-1": true,
+			"cas/sha256/abc/.refs/my-art.tgz/uid-2": true,
+		}}
+		require.NoError(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), "uid-1"))
+		assert.Equal(t, map[string]bool{key: true, "cas/sha256/abc/.refs/my-art.tgz/uid-2": true}, drv.objects)
+
+		require.NoError(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), "uid-2"))
+		assert.Empty(t, drv.objects)
+	})
+	t.Run("OtherArtifactInDigest", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{
+			key:                                       true,
+			"cas/sha256/abc/.refs/my-art.tgz/uid-1":   true,
+			"cas/sha256/abc/other.tgz":                true,
+			"cas/sha256/abc/.refs/other.tgz/uid-2":    true,
+			"cas/sha256/abc/.refs/my-art.tgz2/uid-3":  true,
+			"cas/sha256/abc/.refs/my-art.tgz2/uid-33": true,
+		}}
+		require.NoError(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), "uid-1"))
+		assert.NotContains(t, drv.objects, key)
+		assert.Contains(t, drv.objects, "cas/sha256/abc/other.tgz")
+	})
+	t.Run("LastReference", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{key: true, "cas/sha256/abc/.refs/my-art.tgz/uid-1": true}}
+		require.NoError(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), "uid-1"))
+		assert.Empty(t, drv.objects)
+	})
+	t.Run("ListError", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{key: true, "cas/sha256/abc/.refs/my-art.tgz/uid-1": true}, listErr: argoerrs.New(argoerrs.CodeBadRequest, "listing not supported")}
+		require.Error(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), "uid-1"))
+		assert.Contains(t, drv.objects, key)
+	})
+	t.Run("UnknownWorkflow", func(t *testing.T) {
+		drv := &fakeArtifactDriver{objects: map[string]bool{key: true}}
+		require.Error(t, deleteArtifact(ctx, drv, newArtifact(key, "sha256:abc"), ""))
+		assert.Contains(t, drv.objects, key)
+	})
+}
+
+func TestWorkflowUIDOf(t *testing.T) {
+	task := v1alpha1.WorkflowArtifactGCTask{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{
+		{Kind: "Pod", UID: "pod-uid"},
+		{Kind: "Workflow", UID: "wf-uid"},
+	}}}
+	assert.Equal(t, "wf-uid", string(workflowUIDOf(task)))
+	assert.Empty(t, workflowUIDOf(v1alpha1.WorkflowArtifactGCTask{}))
+}
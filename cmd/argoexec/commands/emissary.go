@@ -220,12 +220,7 @@ func runEmissary(ctx context.Context, containerName string, includeScriptOutput
 		}
 	}
 
-	backoff, err := template.GetRetryStrategy()
-	if err != nil {
-		return fmt.Errorf("failed to get retry strategy: %w", err)
-	}
-
-	cmdErr := retry.OnError(backoff, func(error) bool { return true }, func() error {
+	runCommand := func() error {
 		command, closer, err := startCommand(ctx, name, args, template, containerName, includeScriptOutput)
 		if err != nil {
 			return fmt.Errorf("failed to start command: %w", err)
@@ -268,7 +263,18 @@ func runEmissary(ctx context.Context, containerName string, includeScriptOutput
 		}
 
 		return osspecific.Wait(command.Process)
-	})
+	}
+
+	var cmdErr error
+	if ctr := template.ContainerSet.GetContainerNode(containerName); ctr != nil && ctr.RetryStrategy != nil {
+		cmdErr = retryContainer(ctx, ctr.RetryStrategy, runCommand)
+	} else {
+		backoff, err := template.GetRetryStrategy()
+		if err != nil {
+			return fmt.Errorf("failed to get retry strategy: %w", err)
+		}
+		cmdErr = retry.OnError(backoff, func(error) bool { return true }, runCommand)
+	}
 	logger.WithError(cmdErr).Info(ctx, "sub-process exited")
 
 	if os.Getenv("ARGO_DEBUG_PAUSE_AFTER") == "true" {
@@ -283,23 +289,18 @@ func runEmissary(ctx context.Context, containerName string, includeScriptOutput
 	exitCode = exitCodeFromErr(cmdErr, exitCode)
 
 	if containerName == common.MainContainerName {
-		for _, x := range template.Outputs.Parameters {
-			if x.ValueFrom != nil && x.ValueFrom.Path != "" {
-				if err := saveParameter(ctx, template, x.ValueFrom.Path); err != nil {
-					return err
-				}
-			}
-		}
-		for _, x := range template.Outputs.Artifacts {
-			if x.Path != "" {
-				if err := saveArtifact(ctx, template, x.Path); err != nil {
-					return err
-				}
-			}
+		if err := saveOutputs(ctx, template, containerName, &template.Outputs); err != nil {
+			return err
 		}
 	} else {
 		logger.Info(ctx, "not saving outputs - not main container")
 	}
+	// the container of a container set saves its own outputs, whether or not it is the main container
+	if ctr := template.ContainerSet.GetContainerNode(containerName); ctr != nil && ctr.Outputs != nil {
+		if err := saveOutputs(ctx, template, containerName, ctr.Outputs); err != nil {
+			return err
+		}
+	}
 
 	return cmdErr // this is the error returned from cmd.Wait(), which maybe an exitError
 }
@@ -688,7 +689,28 @@ func startCommand(ctx context.Context, name string, args []string, template *wfv
 	return command, closer, nil
 }
 
-func saveArtifact(ctx context.Context, template *wfv1.Template, srcPath string) error {
+// saveOutputs saves the output parameters and artifacts of the container to its outputs directory, from which the
+// wait container reads them
+func saveOutputs(ctx context.Context, template *wfv1.Template, containerName string, outputs *wfv1.Outputs) error {
+	for _, x := range outputs.Parameters {
+		if x.ValueFrom != nil && x.ValueFrom.Path != "" {
+			if err := saveParameter(ctx, template, containerName, x.ValueFrom.Path); err != nil {
+				return err
+			}
+		}
+	}
+	for _, x := range outputs.Artifacts {
+		// streams are uploaded by the wait container as they are written
+		if x.Path != "" && !x.Stream {
+			if err := saveArtifact(ctx, template, containerName, x.Path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func saveArtifact(ctx context.Context, template *wfv1.Template, containerName string, srcPath string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 
 	if common.FindOverlappingVolume(template, srcPath) != nil {
@@ -699,7 +721,7 @@ func saveArtifact(ctx context.Context, template *wfv1.Template, srcPath string)
 		logger.WithField("srcPath", srcPath).WithError(err).Warn(ctx, "cannot save artifact")
 		return nil
 	}
-	dstPath := filepath.Join(varRunArgo, "/outputs/artifacts/", strings.TrimSuffix(srcPath, "/")+".tgz")
+	dstPath := filepath.Join(varRunArgo, common.ContainerOutputsDir(containerName), "artifacts", strings.TrimSuffix(srcPath, "/")+".tgz")
 	logger.WithFields(logging.Fields{
 		"src": srcPath,
 		"dst": dstPath,
@@ -722,7 +744,7 @@ func saveArtifact(ctx context.Context, template *wfv1.Template, srcPath string)
 	return nil
 }
 
-func saveParameter(ctx context.Context, template *wfv1.Template, srcPath string) error {
+func saveParameter(ctx context.Context, template *wfv1.Template, containerName string, srcPath string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 
 	if common.FindOverlappingVolume(template, srcPath) != nil {
@@ -738,7 +760,7 @@ func saveParameter(ctx context.Context, template *wfv1.Template, srcPath string)
 		return fmt.Errorf("failed to open %s: %w", srcPath, err)
 	}
 	defer func() { _ = src.Close() }()
-	dstPath := varRunArgo + "/outputs/parameters/" + srcPath
+	dstPath := filepath.Join(varRunArgo, common.ContainerOutputsDir(containerName), "parameters", srcPath)
 	logger.WithFields(logging.Fields{
 		"src": srcPath,
 		"dst": dstPath,
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	argoerrors "github.com/argoproj/argo-workflows/v4/util/errors"
+	"github.com/argoproj/argo-workflows/v4/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v4/util/expr/env"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	varkeys "github.com/argoproj/argo-workflows/v4/util/variables/keys"
+)
+
+// retryContainer runs the command of a container of a container set until it succeeds, or the container's own
+// retryStrategy says it must not be retried: when its limit of retries is reached, its expression evaluates to false,
+// or the next retry would start after its backoff's maxDuration has passed.
+// It returns the error of the last attempt.
+func retryContainer(ctx context.Context, strategy *wfv1.ContainerRetryStrategy, run func() error) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	limit := strategy.GetLimit()
+	maxDuration, err := strategy.GetMaxDuration()
+	if err != nil {
+		return fmt.Errorf("failed to get retry strategy: %w", err)
+	}
+	started := time.Now()
+	for retries := 0; ; retries++ {
+		attemptStarted := time.Now()
+		cmdErr := run()
+		if cmdErr == nil {
+			return nil
+		}
+		if limit >= 0 && retries >= limit {
+			logger.WithField("retries", retries).Info(ctx, "no more retries left")
+			return cmdErr
+		}
+		if strategy.Expression != "" {
+			scope := retryScope(retries, cmdErr, time.Since(attemptStarted))
+			shouldRetry, err := argoexpr.EvalBool(strategy.Expression, env.GetFuncMap(scope))
+			if err != nil {
+				logger.WithError(err).Error(ctx, "failed to evaluate retryStrategy.expression")
+				return cmdErr
+			}
+			if !shouldRetry {
+				logger.Info(ctx, "retryStrategy.expression evaluated to false")
+				return cmdErr
+			}
+		}
+		delay, err := strategy.GetRetryDelay(retries)
+		if err != nil {
+			return fmt.Errorf("failed to get retry strategy: %w", err)
+		}
+		if maxDuration > 0 && time.Since(started)+delay > maxDuration {
+			logger.Info(ctx, "retry would exceed max duration limit")
+			return cmdErr
+		}
+		logger.WithError(cmdErr).WithFields(logging.Fields{"retries": retries, "delay": delay}).Info(ctx, "retrying container")
+		select {
+		case <-ctx.Done():
+			return cmdErr
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryScope returns the variables the expression of a container's retryStrategy is evaluated with, which are those of
+// a template's retryStrategy
+func retryScope(retries int, cmdErr error, duration time.Duration) map[string]any {
+	exitCode := "-1"
+	status := wfv1.NodeError
+	if _, ok := cmdErr.(argoerrors.Exited); ok {
+		exitCode = strconv.Itoa(exitCodeFromErr(cmdErr, -1))
+		status = wfv1.NodeFailed
+	}
+	return map[string]any{
+		varkeys.Retries.Template():             strconv.Itoa(retries),
+		varkeys.RetriesLastExitCode.Template(): exitCode,
+		varkeys.RetriesLastStatus.Template():   string(status),
+		varkeys.RetriesLastDuration.Template(): fmt.Sprint(duration.Seconds()),
+		varkeys.RetriesLastMessage.Template():  cmdErr.Error(),
+	}
+}
@@ -22,6 +22,8 @@ func TestEmissary(t *testing.T) {
 	tmp := t.TempDir()
 
 	varRunArgo = tmp
+	// containerSetRetryTest.sh marks its first run with this file in the working directory
+	t.Cleanup(func() { _ = os.Remove("test.txt") })
 
 	err := os.WriteFile(varRunArgo+"/template", []byte(`{}`), 0o600)
 	require.NoError(t, err)
@@ -202,13 +204,103 @@ func TestEmissary(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, string(data)) // data is tgz format
 	})
+	t.Run("RetryContainerFail", func(t *testing.T) {
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{
+				"name": "main",
+				"retryStrategy": {"limit": 0}
+			}
+		],
+		"retryStrategy": {"retries": 2}
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		_ = os.Remove("test.txt")
+		err = run("sh ./test/containerSetRetryTest.sh")
+		require.Error(t, err)
+	})
+	t.Run("RetryContainerSuccess", func(t *testing.T) {
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{
+				"name": "main",
+				"retryStrategy": {"limit": 1}
+			}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		_ = os.Remove("test.txt")
+		err = run("sh ./test/containerSetRetryTest.sh")
+		require.NoError(t, err)
+	})
+	t.Run("RetryContainerExpression", func(t *testing.T) {
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{
+				"name": "main",
+				"retryStrategy": {"limit": 1, "expression": "lastRetry.exitCode == \"2\""}
+			}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		_ = os.Remove("test.txt")
+		err = run("sh ./test/containerSetRetryTest.sh")
+		require.Error(t, err)
+	})
+	t.Run("ContainerOutputs", func(t *testing.T) {
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{
+				"name": "a",
+				"outputs": {
+					"parameters": [
+						{"name": "p", "valueFrom": {"path": "/tmp/container-parameter"}}
+					],
+					"artifacts": [
+						{"name": "a", "path": "/tmp/container-artifact"}
+					]
+				}
+			}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		err = runContainer("a", "echo hello > /tmp/container-parameter && echo hello > /tmp/container-artifact")
+		require.NoError(t, err)
+		var data []byte
+		data, err = os.ReadFile(varRunArgo + "/outputs/containers/a/parameters/tmp/container-parameter")
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "hello")
+		data, err = os.ReadFile(varRunArgo + "/outputs/containers/a/artifacts/tmp/container-artifact.tgz")
+		require.NoError(t, err)
+		assert.NotEmpty(t, string(data)) // data is tgz format
+	})
 }
 
 func run(script string) error {
+	return runContainer("main", script)
+}
+
+func runContainer(containerName, script string) error {
 	cmd := NewEmissaryCommand()
 	ctx, _, err := cmdutil.ContextWithLogger(cmd, string(logging.Info), string(logging.Text))
 	if err != nil {
 		return err
 	}
-	return runEmissary(ctx, "main", true, append([]string{"sh", "-c"}, script))
+	return runEmissary(ctx, containerName, true, append([]string{"sh", "-c"}, script))
 }
@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+// CloudEvents configures the CloudEvents emitted for workflow and node lifecycle transitions
+type CloudEvents struct {
+	// SinkURL is the URL of the HTTP sink that events are posted to in structured mode, events are not emitted if unset
+	SinkURL string `json:"sinkURL,omitempty"`
+	// Source is the source attribute of the events, defaults to the path of the workflow's collection, e.g.
+	// /apis/argoproj.io/v1alpha1/namespaces/argo/workflows
+	Source string `json:"source,omitempty"`
+	// Nodes controls whether events are emitted for node lifecycle transitions, as well as workflow ones
+	Nodes bool `json:"nodes,omitempty"`
+	// QueueSize is the number of events that can wait to be posted, further events are dropped, defaults to 1000
+	QueueSize int `json:"queueSize,omitempty"`
+	// Timeout is the timeout of posting an event, defaults to 10s
+	Timeout TTL `json:"timeout,omitempty"`
+}
+
+func (e *CloudEvents) IsEnabled() bool {
+	return e != nil && e.SinkURL != ""
+}
+
+func (e *CloudEvents) GetQueueSize() int {
+	if e.QueueSize > 0 {
+		return e.QueueSize
+	}
+	return 1000
+}
+
+func (e *CloudEvents) GetTimeout() time.Duration {
+	if e.Timeout > 0 {
+		return time.Duration(e.Timeout)
+	}
+	return 10 * time.Second
+}
@@ -32,6 +32,9 @@ type Config struct {
 	// WorkflowEvents configures how workflow events are emitted
 	WorkflowEvents WorkflowEvents `json:"workflowEvents,omitzero"`
 
+	// CloudEvents configures the CloudEvents emitted to an HTTP sink for workflow and node lifecycle transitions
+	CloudEvents *CloudEvents `json:"cloudEvents,omitempty"`
+
 	// Executor holds container customizations for the executor to use when running pods
 	Executor *apiv1.Container `json:"executor,omitempty"`
 
@@ -134,6 +137,10 @@ type Config struct {
 	// Note: when this is set to true, HTTP templates will not be reconciled and the controller will not attempt to create agent pods for them.
 	DisableAgentPodCreation bool `json:"disableAgentPodCreation,omitempty"`
 
+	// ArgoServerURL is the URL external systems use to reach the Argo Server, e.g. https://argo.example.com.
+	// It is required by HTTP templates which wait for a callback, as the base of the `http.callbackURL` variable.
+	ArgoServerURL string `json:"argoServerURL,omitempty"`
+
 	// InitlessPod configures an opt-in pod layout that omits the argoexec init container.
 	// The argoexec binary is delivered to the main container via a Kubernetes image volume
 	// (KEP-4639 — Beta in K8s 1.33 behind a feature gate, GA in 1.36), and a new
@@ -299,10 +306,13 @@ type KubeConfig struct {
 
 // DBConfig contains database configuration settings
 type DBConfig struct {
-	// PostgreSQL configuration for PostgreSQL database, don't use MySQL at the same time
+	// PostgreSQL configuration for PostgreSQL database, don't use MySQL or SQLite at the same time
 	PostgreSQL *PostgreSQLConfig `json:"postgresql,omitempty"`
-	// MySQL configuration for MySQL database, don't use PostgreSQL at the same time
+	// MySQL configuration for MySQL database, don't use PostgreSQL or SQLite at the same time
 	MySQL *MySQLConfig `json:"mysql,omitempty"`
+	// SQLite configuration for a file-backed SQLite database, for single-node installations.
+	// Don't use PostgreSQL or MySQL at the same time
+	SQLite *SQLiteConfig `json:"sqlite,omitempty"`
 	// Pooled connection settings for all types of database connections
 	ConnectionPool *ConnectionPool `json:"connectionPool,omitempty"`
 	// DBReconnectConfig are configuration options for database retries and reconnections
@@ -346,12 +356,36 @@ type PersistConfig struct {
 	ArchiveLabelSelector *metav1.LabelSelector `json:"archiveLabelSelector,omitempty"`
 	// ArchiveTTL is the time to live for archived Workflows
 	ArchiveTTL TTL `json:"archiveTTL,omitempty"`
+	// ArchiveTiering moves the workflows of old archived workflows to an artifact repository, leaving a stub in the
+	// database, so that the database stays small. Requires archive to be enabled.
+	ArchiveTiering *ArchiveTieringConfig `json:"archiveTiering,omitempty"`
+	// ArtifactLineage records which workflows produced and consumed each artifact when a workflow is archived,
+	// so that artifacts can be traced across workflows. Requires archive to be enabled.
+	ArtifactLineage bool `json:"artifactLineage,omitempty"`
 	// ClusterName is the name of the cluster (or technically controller) for the persistence database
 	ClusterName string `json:"clusterName,omitempty"`
 	// SkipMigration skips database migration even if needed
 	SkipMigration bool `json:"skipMigration,omitempty"`
 }
 
+// ArchiveTieringConfig configures the moving of the workflows of old archived workflows to an artifact repository
+type ArchiveTieringConfig struct {
+	// After is how long after they finished archived workflows are moved, e.g. 30d
+	After TTL `json:"after"`
+	// ArtifactRepository is where the workflows are moved to, the default is the default artifact repository.
+	// Its secrets must be in the namespace of the controller and Argo Server.
+	ArtifactRepository *wfv1.ArtifactRepository `json:"artifactRepository,omitempty"`
+	// KeyPrefix is the prefix of the keys of the workflows in the artifact repository, the default is "archived-workflows"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+func (c ArchiveTieringConfig) GetKeyPrefix() string {
+	if c.KeyPrefix != "" {
+		return c.KeyPrefix
+	}
+	return "archived-workflows"
+}
+
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
 	if c.ArchiveLabelSelector == nil {
 		return labels.Everything(), nil
@@ -461,6 +495,15 @@ type MySQLConfig struct {
 	Options map[string]string `json:"options,omitempty"`
 }
 
+// SQLiteConfig contains SQLite-specific database configuration
+type SQLiteConfig struct {
+	// Path is the path of the database file, which is created if it does not exist.
+	// The file must be on a volume shared by the controller and the Argo Server, so they must run on the same node
+	Path string `json:"path"`
+	// TableName is the name of the table to use, must be set
+	TableName string `json:"tableName,omitempty"`
+}
+
 // MetricModifier are modifiers for an individual named metric to change their behaviour
 type MetricModifier struct {
 	// Disabled disables the emission of this metric completely
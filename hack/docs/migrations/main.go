@@ -10,7 +10,7 @@ import (
 	syncdb "github.com/argoproj/argo-workflows/v4/util/sync/db"
 )
 
-var dbTypes = []sqldb.DBType{sqldb.MySQL, sqldb.Postgres}
+var dbTypes = []sqldb.DBType{sqldb.MySQL, sqldb.Postgres, sqldb.SQLite}
 
 type migrationSection struct {
 	title   string
@@ -52,6 +52,7 @@ See [Workflow Archive](workflow-archive.md) and [Synchronization](synchronizatio
 
 Each migration is numbered as a ` + "`Step`" + `. When Argo Workflows runs the automatic migration at controller startup, it records the highest applied step number in a version table (` + "`schema_history`" + ` for the archive database,` + "`sync_schema_history`" + ` for the sync database) and only runs steps with a higher number on subsequent starts.
 Steps may be missing where the step does nothing for the database type.
+SQLite databases are created at a later step, which creates the whole schema as it is at that step, so the steps before it are missing.
 This means steps must never be re-ordered or removed — a new schema change is always appended as a new step.
 
 If you run these statements yourself (for example, because you set ` + "`skipMigration: true`" + ` or want to manually create the schema), you are responsible for tracking which steps your database is already at.
@@ -92,6 +93,8 @@ func dbTypeTitle(dt sqldb.DBType) string {
 		return "MySQL"
 	case sqldb.Postgres:
 		return "PostgreSQL"
+	case sqldb.SQLite:
+		return "SQLite"
 	default:
 		return string(dt)
 	}
@@ -99,8 +102,14 @@ func dbTypeTitle(dt sqldb.DBType) string {
 
 func writeChange(sb *strings.Builder, index int, dbType sqldb.DBType, change sqldb.Change) {
 	switch c := change.(type) {
+	case nil:
+		return
 	case sqldb.AnsiSQLChange:
 		writeSQLBlock(sb, index, string(c))
+	case sqldb.ChangeList:
+		for _, item := range c {
+			writeChange(sb, index, dbType, item)
+		}
 	case sqldb.TypedChange:
 		variant, ok := c.Changes[dbType]
 		if !ok {
@@ -0,0 +1,188 @@
+// Package archivefile reads and writes files of archived workflows, which are used to export workflows from the
+// archive and import them into another.
+package archivefile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+// Format is the format of a file of archived workflows
+type Format string
+
+const (
+	// NDJSON is newline-delimited JSON, with a workflow on each line
+	NDJSON Format = "ndjson"
+	// Parquet is an Apache Parquet file, with a row for each workflow. The workflow column has the workflow as JSON,
+	// the other columns are copies of its fields, so that the file can be queried without parsing the JSON.
+	Parquet Format = "parquet"
+)
+
+// Formats is the formats which can be read and written
+var Formats = []string{string(NDJSON), string(Parquet)}
+
+// parquetRowsPerRowGroup bounds the number of rows buffered in memory when writing Parquet
+const parquetRowsPerRowGroup = 1000
+
+// ParseFormat returns the format, which is NDJSON if it is empty
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case NDJSON, "":
+		return NDJSON, nil
+	case Parquet:
+		return Parquet, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, must be one of ndjson or parquet", s)
+	}
+}
+
+// Writer writes workflows to a file, it must be closed to write any buffered workflows
+type Writer interface {
+	Write(wf *wfv1.Workflow) error
+	Close() error
+}
+
+// NewWriter returns a writer of workflows in the format, closing it does not close w
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case NDJSON:
+		buf := bufio.NewWriter(w)
+		return &ndjsonWriter{buf, json.NewEncoder(buf)}, nil
+	case Parquet:
+		return &parquetWriter{parquet.NewGenericWriter[parquetRow](w, parquet.MaxRowsPerRowGroup(parquetRowsPerRowGroup))}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// Read calls f with each workflow read from r in the format. Reading Parquet needs random access, so r is read into
+// memory unless it is a file.
+func Read(r io.Reader, format Format, f func(wf *wfv1.Workflow) error) error {
+	switch format {
+	case NDJSON:
+		return readNDJSON(r, f)
+	case Parquet:
+		return readParquet(r, f)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type ndjsonWriter struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(wf *wfv1.Workflow) error {
+	// the encoder ends each workflow with a newline
+	return w.encoder.Encode(wf)
+}
+
+func (w *ndjsonWriter) Close() error {
+	return w.buf.Flush()
+}
+
+func readNDJSON(r io.Reader, f func(wf *wfv1.Workflow) error) error {
+	decoder := json.NewDecoder(r)
+	for {
+		wf := &wfv1.Workflow{}
+		err := decoder.Decode(wf)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read workflow: %w", err)
+		}
+		if err := f(wf); err != nil {
+			return err
+		}
+	}
+}
+
+type parquetRow struct {
+	UID        string    `parquet:"uid"`
+	Name       string    `parquet:"name"`
+	Namespace  string    `parquet:"namespace,dict"`
+	Phase      string    `parquet:"phase,dict"`
+	StartedAt  time.Time `parquet:"started_at,timestamp(millisecond)"`
+	FinishedAt time.Time `parquet:"finished_at,timestamp(millisecond)"`
+	Workflow   string    `parquet:"workflow,zstd"`
+}
+
+type parquetWriter struct {
+	writer *parquet.GenericWriter[parquetRow]
+}
+
+func (w *parquetWriter) Write(wf *wfv1.Workflow) error {
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write([]parquetRow{{
+		UID:        string(wf.UID),
+		Name:       wf.Name,
+		Namespace:  wf.Namespace,
+		Phase:      string(wf.Status.Phase),
+		StartedAt:  wf.Status.StartedAt.Time,
+		FinishedAt: wf.Status.FinishedAt.Time,
+		Workflow:   string(data),
+	}})
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.writer.Close()
+}
+
+func readParquet(r io.Reader, f func(wf *wfv1.Workflow) error) error {
+	var input io.ReaderAt
+	var size int64
+	if file, ok := r.(*os.File); ok {
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		input, size = file, info.Size()
+	} else {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		input, size = bytes.NewReader(data), int64(len(data))
+	}
+	// the generic reader panics if the file is not valid, so it is opened first to return the error
+	if _, err := parquet.OpenFile(input, size); err != nil {
+		return fmt.Errorf("failed to read Parquet: %w", err)
+	}
+	reader := parquet.NewGenericReader[parquetRow](input)
+	defer reader.Close()
+	rows := make([]parquetRow, 100)
+	for {
+		n, err := reader.Read(rows)
+		for _, row := range rows[:n] {
+			wf := &wfv1.Workflow{}
+			if err := json.Unmarshal([]byte(row.Workflow), wf); err != nil {
+				return fmt.Errorf("failed to read workflow %s: %w", row.UID, err)
+			}
+			if err := f(wf); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read Parquet: %w", err)
+		}
+	}
+}
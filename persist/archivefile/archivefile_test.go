@@ -0,0 +1,94 @@
+package archivefile
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+func testWorkflows() []*wfv1.Workflow {
+	startedAt := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	return []*wfv1.Workflow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid", Labels: map[string]string{"foo": "bar"}},
+			Status: wfv1.WorkflowStatus{
+				Phase:     wfv1.WorkflowSucceeded,
+				StartedAt: startedAt,
+				Nodes:     wfv1.Nodes{"my-wf": {ID: "my-wf", Name: "my-wf", Phase: wfv1.NodeSucceeded}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-wf", Namespace: "other-ns", UID: "other-uid"},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, Message: "line\nbreak"},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for s, want := range map[string]Format{"": NDJSON, "ndjson": NDJSON, "parquet": Parquet} {
+		got, err := ParseFormat(s)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := ParseFormat("csv")
+	require.EqualError(t, err, `unknown format "csv", must be one of ndjson or parquet`)
+}
+
+func TestReadWrite(t *testing.T) {
+	for _, format := range []Format{NDJSON, Parquet} {
+		t.Run(string(format), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := NewWriter(buf, format)
+			require.NoError(t, err)
+			for _, wf := range testWorkflows() {
+				require.NoError(t, w.Write(wf))
+			}
+			require.NoError(t, w.Close())
+
+			read := func(t *testing.T, r func(f func(wf *wfv1.Workflow) error) error) {
+				var got []*wfv1.Workflow
+				require.NoError(t, r(func(wf *wfv1.Workflow) error {
+					got = append(got, wf)
+					return nil
+				}))
+				require.Len(t, got, 2)
+				for i, want := range testWorkflows() {
+					assert.Equal(t, want.UID, got[i].UID)
+					assert.Equal(t, want.Labels, got[i].Labels)
+					assert.Equal(t, want.Status.Message, got[i].Status.Message)
+					assert.Equal(t, want.Status.Nodes, got[i].Status.Nodes)
+					assert.True(t, want.Status.StartedAt.Equal(&got[i].Status.StartedAt))
+				}
+			}
+			t.Run("Reader", func(t *testing.T) {
+				read(t, func(f func(wf *wfv1.Workflow) error) error {
+					return Read(bytes.NewReader(buf.Bytes()), format, f)
+				})
+			})
+			t.Run("File", func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "workflows")
+				require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+				file, err := os.Open(path)
+				require.NoError(t, err)
+				defer file.Close()
+				read(t, func(f func(wf *wfv1.Workflow) error) error {
+					return Read(file, format, f)
+				})
+			})
+		})
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	ignore := func(*wfv1.Workflow) error { return nil }
+	require.ErrorContains(t, Read(bytes.NewBufferString("{\"metadata\":"), NDJSON, ignore), "failed to read workflow")
+	require.ErrorContains(t, Read(bytes.NewBufferString("not parquet"), Parquet, ignore), "failed to read Parquet")
+}
@@ -0,0 +1,111 @@
+// Package archivestore stores the workflows of old archived workflows in an artifact repository, for archive tiering.
+package archivestore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+type store struct {
+	location         *wfv1.ArtifactLocation
+	keyPrefix        string
+	resources        resources
+	artDriverFactory artifacts.NewDriverFunc
+}
+
+// New returns the workflow store for archive tiering, or nil if archive tiering is not configured. Secrets of the
+// artifact repository are read from the namespace.
+func New(kubeClient kubernetes.Interface, namespace string, persistence *config.PersistConfig, defaultArtifactRepository *wfv1.ArtifactRepository) (sqldb.WorkflowStore, error) {
+	if persistence == nil || persistence.ArchiveTiering == nil {
+		return nil, nil
+	}
+	tiering := persistence.ArchiveTiering
+	repo := tiering.ArtifactRepository
+	if repo == nil {
+		repo = defaultArtifactRepository
+	}
+	location := repo.ToArtifactLocation()
+	if location == nil || !location.HasLocation() {
+		return nil, fmt.Errorf("archive tiering needs an artifact repository")
+	}
+	return &store{
+		location:         location,
+		keyPrefix:        tiering.GetKeyPrefix(),
+		resources:        resources{kubeClient, namespace},
+		artDriverFactory: artifacts.NewDriver,
+	}, nil
+}
+
+func (s *store) Save(ctx context.Context, key string, data []byte) error {
+	art, driver, err := s.driver(ctx, key)
+	if err != nil {
+		return err
+	}
+	return driver.SaveStream(ctx, bytes.NewReader(data), art)
+}
+
+func (s *store) Load(ctx context.Context, key string) ([]byte, error) {
+	art, driver, err := s.driver(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := driver.OpenStream(ctx, art)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	return io.ReadAll(stream)
+}
+
+func (s *store) Delete(ctx context.Context, key string) error {
+	art, driver, err := s.driver(ctx, key)
+	if err != nil {
+		return err
+	}
+	return driver.Delete(ctx, art)
+}
+
+func (s *store) driver(ctx context.Context, key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	art := &wfv1.Artifact{ArtifactLocation: *s.location.DeepCopy()}
+	if err := art.SetKey(path.Join(s.keyPrefix, key)); err != nil {
+		return nil, nil, err
+	}
+	driver, err := s.artDriverFactory(ctx, art, s.resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	return art, driver, nil
+}
+
+type resources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r resources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r resources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -0,0 +1,159 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+const apiTokenTableName = "argo_api_tokens"
+
+// APITokenRecord is an API token issued by the Argo Server. Only the hash of the token's secret is recorded.
+type APITokenRecord struct {
+	ID string `json:"id"`
+	// Namespace is the namespace of the service account
+	Namespace      string `json:"namespace"`
+	ServiceAccount string `json:"serviceAccount"`
+	APITokenScope
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// APITokenScope restricts the requests an API token can make, empty fields allow everything
+type APITokenScope struct {
+	Namespaces        []string `json:"namespaces,omitempty"`
+	Verbs             []string `json:"verbs,omitempty"`
+	WorkflowTemplates []string `json:"workflowTemplates,omitempty"`
+}
+
+type APITokenRepo interface {
+	CreateToken(ctx context.Context, token *APITokenRecord) error
+	// GetToken returns the token, or nil if there is no token with the ID
+	GetToken(ctx context.Context, id string) (*APITokenRecord, error)
+	// ListTokens lists the tokens of the service accounts in the namespace, or of all namespaces if it is empty
+	ListTokens(ctx context.Context, namespace string) ([]APITokenRecord, error)
+	DeleteToken(ctx context.Context, id string) error
+}
+
+type apiTokenRow struct {
+	ClusterName    string    `db:"clustername"`
+	ID             string    `db:"id"`
+	Namespace      string    `db:"namespace"`
+	ServiceAccount string    `db:"serviceaccount"`
+	Scope          string    `db:"scope"`
+	Hash           string    `db:"tokenhash"`
+	CreatedAt      time.Time `db:"createdat"`
+	ExpiresAt      time.Time `db:"expiresat"`
+}
+
+type apiTokenRepo struct {
+	sessionProxy *sqldb.SessionProxy
+	clusterName  string
+}
+
+// NewAPITokenRepo returns a new apiTokenRepo, which records tokens in the database
+func NewAPITokenRepo(sessionProxy *sqldb.SessionProxy, clusterName string) APITokenRepo {
+	return &apiTokenRepo{sessionProxy: sessionProxy, clusterName: clusterName}
+}
+
+func (r *apiTokenRepo) CreateToken(ctx context.Context, token *APITokenRecord) error {
+	scope, err := json.Marshal(token.APITokenScope)
+	if err != nil {
+		return err
+	}
+	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		// expired tokens are deleted when a token is created, so the table does not grow without limit
+		_, err := s.SQL().
+			DeleteFrom(apiTokenTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"expiresat <=": token.CreatedAt.UTC()}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = s.Collection(apiTokenTableName).Insert(&apiTokenRow{
+			ClusterName:    r.clusterName,
+			ID:             token.ID,
+			Namespace:      token.Namespace,
+			ServiceAccount: token.ServiceAccount,
+			Scope:          string(scope),
+			Hash:           token.Hash,
+			CreatedAt:      token.CreatedAt.UTC(),
+			ExpiresAt:      token.ExpiresAt.UTC(),
+		})
+		return err
+	})
+}
+
+func (r *apiTokenRepo) GetToken(ctx context.Context, id string) (*APITokenRecord, error) {
+	var rows []apiTokenRow
+	err := r.sessionProxy.With(ctx, func(s db.Session) error {
+		return s.SQL().
+			Select("*").
+			From(apiTokenTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"id": id}).
+			All(&rows)
+	})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0].toRecord()
+}
+
+func (r *apiTokenRepo) ListTokens(ctx context.Context, namespace string) ([]APITokenRecord, error) {
+	var rows []apiTokenRow
+	err := r.sessionProxy.With(ctx, func(s db.Session) error {
+		selector := s.SQL().
+			Select("*").
+			From(apiTokenTableName).
+			Where(db.Cond{"clustername": r.clusterName})
+		if namespace != "" {
+			selector = selector.And(db.Cond{"namespace": namespace})
+		}
+		return selector.OrderBy("-createdat").All(&rows)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]APITokenRecord, len(rows))
+	for i, row := range rows {
+		token, err := row.toRecord()
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = *token
+	}
+	return tokens, nil
+}
+
+func (r *apiTokenRepo) DeleteToken(ctx context.Context, id string) error {
+	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		_, err := s.SQL().
+			DeleteFrom(apiTokenTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"id": id}).
+			Exec()
+		return err
+	})
+}
+
+func (row apiTokenRow) toRecord() (*APITokenRecord, error) {
+	token := &APITokenRecord{
+		ID:             row.ID,
+		Namespace:      row.Namespace,
+		ServiceAccount: row.ServiceAccount,
+		Hash:           row.Hash,
+		CreatedAt:      row.CreatedAt,
+		ExpiresAt:      row.ExpiresAt,
+	}
+	if err := json.Unmarshal([]byte(row.Scope), &token.APITokenScope); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -0,0 +1,62 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestAPITokenRepo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	repo := NewAPITokenRepo(setupSQLiteTest(ctx, t), "test")
+	now := time.Now().UTC().Truncate(time.Second)
+	token := &APITokenRecord{
+		ID:             "my-id",
+		Namespace:      "my-ns",
+		ServiceAccount: "my-sa",
+		APITokenScope:  APITokenScope{Namespaces: []string{"prod"}, Verbs: []string{"create"}, WorkflowTemplates: []string{"deploy-app"}},
+		Hash:           "my-hash",
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(time.Hour),
+	}
+	require.NoError(t, repo.CreateToken(ctx, token))
+	require.NoError(t, repo.CreateToken(ctx, &APITokenRecord{ID: "other-id", Namespace: "other-ns", ServiceAccount: "my-sa", CreatedAt: now, ExpiresAt: now}))
+
+	got, err := repo.GetToken(ctx, "my-id")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, token.APITokenScope, got.APITokenScope)
+	assert.Equal(t, "my-hash", got.Hash)
+	assert.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+
+	got, err = repo.GetToken(ctx, "missing-id")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	tokens, err := repo.ListTokens(ctx, "my-ns")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "my-id", tokens[0].ID)
+	tokens, err = repo.ListTokens(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, tokens, 2)
+
+	require.NoError(t, repo.DeleteToken(ctx, "my-id"))
+	got, err = repo.GetToken(ctx, "my-id")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	t.Run("PruneExpired", func(t *testing.T) {
+		require.NoError(t, repo.CreateToken(ctx, &APITokenRecord{ID: "new-id", Namespace: "my-ns", ServiceAccount: "my-sa", CreatedAt: now.Add(time.Second), ExpiresAt: now.Add(time.Hour)}))
+		got, err := repo.GetToken(ctx, "other-id")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		got, err = repo.GetToken(ctx, "new-id")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+}
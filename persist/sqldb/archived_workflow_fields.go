@@ -0,0 +1,177 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/upper/db/v4"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/selection"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+const (
+	archiveFieldsTableName = archiveTableName + "_fields"
+	// the sizes of the name and value columns of argo_archived_workflows_fields
+	maxFieldNameLength   = 256
+	maxFieldValueLength  = 255
+	parameterFieldPrefix = "spec.arguments.parameters."
+	nodeFieldPrefix      = "status.nodes."
+)
+
+// likeEscaper escapes the characters of a pattern which LIKE would otherwise treat as wildcards, with the escape
+// character of matchCondition
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// archivedWorkflowFields returns the fields of the workflow which are recorded in argo_archived_workflows_fields, so
+// that they can be searched with indexes: the values of its parameters, and the phases and messages of its nodes by
+// display name. Nodes with the same display name, such as retries, can have several values of a field.
+func archivedWorkflowFields(t sqldb.DBType, wf *wfv1.Workflow) map[string][]string {
+	values := map[string][]string{}
+	seen := map[string]bool{}
+	add := func(name, value string) {
+		value = truncateFieldValue(value)
+		key := fieldKey(t, name, value)
+		if len(name) > maxFieldNameLength || seen[key] {
+			return
+		}
+		seen[key] = true
+		values[name] = append(values[name], value)
+	}
+	for _, p := range wf.Spec.Arguments.Parameters {
+		if p.Value != nil {
+			add(parameterFieldPrefix+p.Name, p.Value.String())
+		}
+	}
+	for _, n := range wf.Status.Nodes {
+		if n.DisplayName == "" {
+			continue
+		}
+		add(nodeFieldPrefix+n.DisplayName+".phase", string(n.Phase))
+		if n.Message != "" {
+			add(nodeFieldPrefix+n.DisplayName+".message", n.Message)
+		}
+	}
+	return values
+}
+
+// fieldKey returns the key under which the database considers fields duplicates of each other. MySQL's default
+// collations compare case-insensitively, and MariaDB's ignore trailing spaces, whereas Postgres and SQLite compare
+// exactly.
+func fieldKey(t sqldb.DBType, name, value string) string {
+	if t == sqldb.MySQL {
+		return strings.ToLower(strings.TrimRight(name, " ") + "=" + strings.TrimRight(value, " "))
+	}
+	return name + "=" + value
+}
+
+// insertArchivedWorkflowFields records the fields of the archived workflow in argo_archived_workflows_fields
+func insertArchivedWorkflowFields(sess db.Session, t sqldb.DBType, clusterName, uid string, wf *wfv1.Workflow) error {
+	fields := archivedWorkflowFields(t, wf)
+	if len(fields) == 0 {
+		return nil
+	}
+	// workflows can have many nodes, so the rows are inserted in batches to keep within the limits on the number of
+	// arguments of a statement
+	batch := sess.SQL().
+		InsertInto(archiveFieldsTableName).
+		Columns("clustername", "uid", "name", "value").
+		Batch(500)
+	for name, values := range fields {
+		for _, value := range values {
+			batch.Values(clusterName, uid, name, value)
+		}
+	}
+	batch.Done()
+	return batch.Wait()
+}
+
+func truncateFieldValue(value string) string {
+	if runes := []rune(value); len(runes) > maxFieldValueLength {
+		return string(runes[:maxFieldValueLength])
+	}
+	return value
+}
+
+// isIndexedField returns true if the field is recorded in argo_archived_workflows_fields
+func isIndexedField(field string) bool {
+	if name, ok := strings.CutPrefix(field, parameterFieldPrefix); ok {
+		// other paths, such as "spec.arguments.parameters.0.value", are in the workflow column
+		return !strings.Contains(name, ".")
+	}
+	return strings.HasPrefix(field, nodeFieldPrefix) && (strings.HasSuffix(field, ".phase") || strings.HasSuffix(field, ".message"))
+}
+
+func fieldsClause(selector db.Selector, t sqldb.DBType, requirements fields.Requirements, tableName string) (db.Selector, error) {
+	for _, r := range requirements {
+		cond, err := fieldRequirementToCondition(t, r, tableName)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.And(cond)
+	}
+	return selector, nil
+}
+
+// fieldRequirementToCondition returns the condition for a requirement on a field. Indexed fields are looked up in
+// argo_archived_workflows_fields, any other field is extracted from the workflow column, which is not indexed.
+// A "*" in the value, or in the node name of an indexed field, matches any characters, case-insensitively.
+func fieldRequirementToCondition(t sqldb.DBType, r fields.Requirement, tableName string) (*db.RawExpr, error) {
+	not := ""
+	switch r.Operator {
+	case selection.Equals, selection.DoubleEquals:
+	case selection.NotEquals:
+		not = "not "
+	default:
+		return nil, fmt.Errorf("operation %v is not supported", r.Operator)
+	}
+	if isIndexedField(r.Field) {
+		// only the start of long values is recorded, so a value at the limit equals the start of any longer value
+		if !strings.Contains(r.Value, "*") && len([]rune(r.Value)) >= maxFieldValueLength {
+			return nil, fmt.Errorf("the value of field %s has %d or more characters, use a * to match values by their start", r.Field, maxFieldValueLength)
+		}
+		nameCond, nameArg := matchCondition("name", r.Field)
+		valueCond, valueArg := matchCondition("value", r.Value)
+		return db.Raw(fmt.Sprintf("%sexists (select 1 from %s where clustername = %s.clustername and uid = %s.uid and %s and %s)",
+			not, archiveFieldsTableName, tableName, tableName, nameCond, valueCond), nameArg, valueArg), nil
+	}
+	expr, args := jsonPathExpr(t, strings.Split(r.Field, "."))
+	// a field which is not set does not equal any value
+	valueCond, valueArg := matchCondition(fmt.Sprintf("coalesce(%s, '')", expr), r.Value)
+	return db.Raw(fmt.Sprintf("%s(%s)", not, valueCond), append(args, valueArg)...), nil
+}
+
+// matchCondition returns the condition that the expression matches the pattern, where only a "*" is a wildcard
+func matchCondition(expr, pattern string) (string, any) {
+	if strings.Contains(pattern, "*") {
+		return fmt.Sprintf("lower(%s) like ? escape '!'", expr), strings.ReplaceAll(likeEscaper.Replace(strings.ToLower(pattern)), "*", "%")
+	}
+	return expr + " = ?", pattern
+}
+
+// jsonPathExpr returns the expression of the text of a JSON path of the workflow column, numeric elements of the
+// path are array indexes
+func jsonPathExpr(t sqldb.DBType, path []string) (string, []any) {
+	if t == sqldb.Postgres {
+		args := make([]any, len(path))
+		for i, p := range path {
+			args[i] = p
+		}
+		return fmt.Sprintf("json_extract_path_text(workflow, %s)", strings.TrimSuffix(strings.Repeat("?, ", len(path)), ", ")), args
+	}
+	jsonPath := "$"
+	for _, p := range path {
+		if _, err := strconv.Atoi(p); err == nil {
+			jsonPath += "[" + p + "]"
+		} else {
+			jsonPath += `."` + strings.ReplaceAll(p, `"`, `\"`) + `"`
+		}
+	}
+	if t == sqldb.SQLite {
+		return "cast(json_extract(workflow, ?) as text)", []any{jsonPath}
+	}
+	return "JSON_UNQUOTE(JSON_EXTRACT(workflow, ?))", []any{jsonPath}
+}
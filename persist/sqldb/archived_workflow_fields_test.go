@@ -0,0 +1,118 @@
+package sqldb
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	usqldb "github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+func TestSQLiteWorkflowArchiveFieldSelectors(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy := setupSQLiteTest(ctx, t)
+	archive := NewWorkflowArchive(proxy, "test", "", instanceid.NewService(""), nil)
+
+	now := time.Now().Truncate(time.Second)
+	archiveWorkflow := func(uid, region string, deployPhase wfv1.NodePhase, deployMessage string) {
+		wf := newArchivedWorkflow(uid, uid, now, map[string]string{})
+		wf.Spec.Arguments.Parameters = []wfv1.Parameter{{Name: "region", Value: wfv1.AnyStringPtr(region)}}
+		wf.Spec.ServiceAccountName = uid + "-sa"
+		wf.Status.Nodes = wfv1.Nodes{
+			uid:             {ID: uid, DisplayName: uid, Phase: wfv1.NodeSucceeded},
+			uid + "-deploy": {ID: uid + "-deploy", DisplayName: "deploy", Phase: deployPhase, Message: deployMessage},
+			// a retry of the same step
+			uid + "-deploy-1": {ID: uid + "-deploy-1", DisplayName: "deploy", Phase: deployPhase, Message: strings.ToUpper(deployMessage)},
+		}
+		require.NoError(t, archive.ArchiveWorkflow(ctx, wf))
+	}
+	archiveWorkflow("eu-failed", "eu-west-1", wfv1.NodeFailed, "connection timeout, retrying")
+	archiveWorkflow("eu-succeeded", "eu-west-1", wfv1.NodeSucceeded, "")
+	archiveWorkflow("us-failed", "us-east-1", wfv1.NodeFailed, "exit code 1")
+	// archiving again replaces the fields
+	archiveWorkflow("us-failed", "us-east-1", wfv1.NodeFailed, "exit code 1")
+
+	list := func(t *testing.T, selector string) []string {
+		t.Helper()
+		options, err := sutils.BuildArchivedListOptions(metav1.ListOptions{FieldSelector: selector}, "", "", "")
+		require.NoError(t, err)
+		wfs, err := archive.ListWorkflows(ctx, options)
+		require.NoError(t, err)
+		count, err := archive.CountWorkflows(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(wfs)), count)
+		var names []string
+		for _, wf := range wfs {
+			names = append(names, wf.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	for selector, expected := range map[string][]string{
+		"spec.arguments.parameters.region=eu-west-1":                                      {"eu-failed", "eu-succeeded"},
+		"spec.arguments.parameters.region=eu-west-1,status.nodes.deploy.phase=Failed":     {"eu-failed"},
+		"spec.arguments.parameters.region!=eu-west-1":                                     {"us-failed"},
+		"status.nodes.deploy.phase!=Failed":                                               {"eu-succeeded"},
+		`status.nodes.*.message=*timeout\, retry*`:                                        {"eu-failed"},
+		"status.nodes.deploy.message=exit code 1":                                         {"us-failed"},
+		"spec.serviceAccountName=us-failed-sa":                                            {"us-failed"},
+		"spec.arguments.parameters.0.value=eu-*":                                          {"eu-failed", "eu-succeeded"},
+		"spec.serviceAccountName!=us-failed-sa,status.nodes.eu-succeeded.phase=Succeeded": {"eu-succeeded"},
+		"spec.unknownField=foo":                                                           nil,
+		// values which only differ by case are both recorded, as SQLite compares exactly
+		"status.nodes.deploy.message=EXIT CODE 1": {"us-failed"},
+		// LIKE wildcards in the value are matched literally
+		"spec.arguments.parameters.region=eu_*": nil,
+		"spec.arguments.parameters.region=%*":   nil,
+	} {
+		t.Run(selector, func(t *testing.T) {
+			assert.Equal(t, expected, list(t, selector))
+		})
+	}
+
+	t.Run("LongValue", func(t *testing.T) {
+		options, err := sutils.BuildArchivedListOptions(metav1.ListOptions{FieldSelector: "status.nodes.deploy.message=" + strings.Repeat("x", maxFieldValueLength)}, "", "", "")
+		require.NoError(t, err)
+		_, err = archive.ListWorkflows(ctx, options)
+		require.ErrorContains(t, err, "has 255 or more characters")
+	})
+	t.Run("Backfill", func(t *testing.T) {
+		// as if the workflows were archived before the fields were recorded
+		_, err := proxy.Session().SQL().DeleteFrom(archiveFieldsTableName).Exec()
+		require.NoError(t, err)
+		assert.Empty(t, list(t, "status.nodes.deploy.phase=Failed"))
+		require.NoError(t, backfillArchivedWorkflowFields{dbType: proxy.DBType()}.Apply(ctx, proxy.Session()))
+		assert.Equal(t, []string{"eu-failed", "us-failed"}, list(t, "status.nodes.deploy.phase=Failed"))
+	})
+
+	require.NoError(t, archive.DeleteWorkflow(ctx, "us-failed"))
+	var remaining []struct {
+		UID string `db:"uid"`
+	}
+	require.NoError(t, proxy.Session().SQL().Select("uid").From(archiveFieldsTableName).Where(db.Cond{"uid": "us-failed"}).All(&remaining))
+	assert.Empty(t, remaining, "fields are deleted with the workflow")
+}
+
+func Test_archivedWorkflowFields(t *testing.T) {
+	wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+		"a": {ID: "a", DisplayName: "deploy", Phase: wfv1.NodeFailed, Message: "exit code 1"},
+		"b": {ID: "b", DisplayName: "deploy", Phase: wfv1.NodeFailed, Message: "EXIT CODE 1 "},
+	}}}
+	for dbType, messages := range map[usqldb.DBType]int{usqldb.MySQL: 1, usqldb.Postgres: 2, usqldb.SQLite: 2} {
+		t.Run(string(dbType), func(t *testing.T) {
+			fields := archivedWorkflowFields(dbType, wf)
+			assert.Equal(t, []string{"Failed"}, fields["status.nodes.deploy.phase"])
+			assert.Len(t, fields["status.nodes.deploy.message"], messages)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/upper/db/v4"
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+)
+
+// WorkflowStatsGroupBy is what each series of workflow statistics is for
+type WorkflowStatsGroupBy string
+
+const (
+	// WorkflowStatsByTemplate groups workflows by the name of the workflow template or cluster workflow template
+	// they were submitted from, workflows which were not submitted from a template are left out
+	WorkflowStatsByTemplate WorkflowStatsGroupBy = "template"
+	// WorkflowStatsByNamespace groups workflows by their namespace
+	WorkflowStatsByNamespace WorkflowStatsGroupBy = "namespace"
+	// WorkflowStatsByLabel groups workflows by the value of a label, workflows without the label are left out
+	WorkflowStatsByLabel WorkflowStatsGroupBy = "label"
+)
+
+// WorkflowStatsOptions selects the archived workflows to compute statistics for, and how to group them
+type WorkflowStatsOptions struct {
+	Namespace         string
+	GroupBy           WorkflowStatsGroupBy
+	LabelKey          string
+	LabelRequirements labels.Requirements
+	// StartTime and EndTime are the range of the times the workflows started at, the end is exclusive
+	StartTime time.Time
+	EndTime   time.Time
+	// Bucket is the duration of each bucket of the series, starting at StartTime
+	Bucket time.Duration
+}
+
+// Validate returns an error if the options are not valid
+func (o WorkflowStatsOptions) Validate() error {
+	switch o.GroupBy {
+	case WorkflowStatsByTemplate, WorkflowStatsByNamespace, "":
+	case WorkflowStatsByLabel:
+		if o.LabelKey == "" {
+			return fmt.Errorf("a label key is required to group by label")
+		}
+	default:
+		return fmt.Errorf("cannot group by %q, must be one of template, namespace or label", o.GroupBy)
+	}
+	if o.Bucket <= 0 {
+		return fmt.Errorf("bucket must be positive")
+	}
+	return nil
+}
+
+// WorkflowStatsBucket is the statistics of the workflows of a group which started in a bucket of time.
+// Buckets without any workflows are left out.
+type WorkflowStatsBucket struct {
+	Group string `db:"grp"`
+	// Bucket is the index of the bucket, counting from the start time
+	Bucket    int64     `db:"bucket"`
+	StartTime time.Time `db:"-"`
+	Total     int64     `db:"total"`
+	Succeeded int64     `db:"succeeded"`
+	Failed    int64     `db:"failed"`
+	Errored   int64     `db:"errored"`
+	// Duration percentiles, in seconds
+	DurationP50 int64 `db:"duration_p50"`
+	DurationP90 int64 `db:"duration_p90"`
+	DurationP99 int64 `db:"duration_p99"`
+	// Resources duration totals, in seconds
+	CPU    int64 `db:"cpu"`
+	Memory int64 `db:"memory"`
+}
+
+// Phases returns the number of workflows which finished in each phase
+func (b WorkflowStatsBucket) Phases() map[wfv1.WorkflowPhase]int64 {
+	return map[wfv1.WorkflowPhase]int64{
+		wfv1.WorkflowSucceeded: b.Succeeded,
+		wfv1.WorkflowFailed:    b.Failed,
+		wfv1.WorkflowError:     b.Errored,
+	}
+}
+
+// SuccessRate returns the fraction of the workflows which succeeded
+func (b WorkflowStatsBucket) SuccessRate() float64 {
+	if b.Total == 0 {
+		return 0
+	}
+	return float64(b.Succeeded) / float64(b.Total)
+}
+
+// WorkflowStats returns the statistics of the archived workflows for each group and bucket, ordered by group and bucket.
+// Everything is aggregated by the database, the percentiles are the nearest rank, computed with window functions,
+// which need PostgreSQL, MySQL 8.0, MariaDB 10.2 or SQLite 3.25 or later.
+func (r *workflowArchive) WorkflowStats(ctx context.Context, options WorkflowStatsOptions) ([]WorkflowStatsBucket, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	group, groupArgs := statsGroupExpr(options)
+	var buckets []WorkflowStatsBucket
+	err := r.sessionProxy.With(ctx, func(s db.Session) error {
+		runs := s.SQL().
+			Select(
+				db.Raw(group+" as grp", groupArgs...),
+				db.Raw(statsBucketExpr(r.dbType)+" as bucket", options.StartTime, int64(options.Bucket.Seconds())),
+				"phase",
+				db.Raw(statsDurationExpr(r.dbType)+" as duration"),
+				db.Raw(statsResourceDurationExpr(r.dbType, "cpu")+" as cpu"),
+				db.Raw(statsResourceDurationExpr(r.dbType, "memory")+" as memory"),
+			).
+			From(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(namespaceEqual(options.Namespace)).
+			And(db.Cond{"startedat >=": options.StartTime}).
+			And(db.Cond{"startedat <": options.EndTime})
+		runs, err := labelsClause(runs, r.dbType, options.LabelRequirements, archiveTableName, archiveLabelsTableName, true)
+		if err != nil {
+			return err
+		}
+		return s.SQL().
+			Iterator(`with runs as ?, ranked as (
+  select grp, bucket, phase, duration, cpu, memory,
+    row_number() over (partition by grp, bucket order by duration) as rn,
+    count(*) over (partition by grp, bucket) as n
+  from runs
+  where grp is not null
+)
+select grp, bucket, count(*) as total,
+  sum(case when phase = 'Succeeded' then 1 else 0 end) as succeeded,
+  sum(case when phase = 'Failed' then 1 else 0 end) as failed,
+  sum(case when phase = 'Error' then 1 else 0 end) as errored,
+  `+statsPercentileExpr(50)+` as duration_p50,
+  `+statsPercentileExpr(90)+` as duration_p90,
+  `+statsPercentileExpr(99)+` as duration_p99,
+  sum(cpu) as cpu,
+  sum(memory) as memory
+from ranked
+group by grp, bucket
+order by grp, bucket`, runs).
+			All(&buckets)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range buckets {
+		buckets[i].StartTime = options.StartTime.Add(time.Duration(buckets[i].Bucket) * options.Bucket)
+	}
+	return buckets, nil
+}
+
+// statsGroupExpr returns the expression of the group of a workflow, which is null if it has no group
+func statsGroupExpr(options WorkflowStatsOptions) (string, []any) {
+	keys := []any{options.LabelKey}
+	switch options.GroupBy {
+	case WorkflowStatsByNamespace:
+		return "namespace", nil
+	case WorkflowStatsByTemplate, "":
+		keys = []any{common.LabelKeyWorkflowTemplate, common.LabelKeyClusterWorkflowTemplate}
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")
+	return fmt.Sprintf("(select max(l.value) from %s l where l.clustername = %s.clustername and l.uid = %s.uid and l.name in (%s))",
+		archiveLabelsTableName, archiveTableName, archiveTableName, placeholders), keys
+}
+
+// statsBucketExpr returns the expression of the index of the bucket a workflow started in, given the start time and
+// bucket seconds as arguments
+func statsBucketExpr(t sqldb.DBType) string {
+	switch t {
+	case sqldb.Postgres:
+		return "cast(floor(extract(epoch from (startedat - cast(? as timestamp))) / ?) as bigint)"
+	case sqldb.SQLite:
+		// workflows start after the start time, so integer division rounds down
+		return "(cast(strftime('%s', startedat) as integer) - cast(strftime('%s', ?) as integer)) / ?"
+	default:
+		return "floor(timestampdiff(second, ?, startedat) / ?)"
+	}
+}
+
+// statsDurationExpr returns the expression of the duration of a workflow in seconds
+func statsDurationExpr(t sqldb.DBType) string {
+	switch t {
+	case sqldb.Postgres:
+		return "cast(extract(epoch from (finishedat - startedat)) as bigint)"
+	case sqldb.SQLite:
+		return "cast(strftime('%s', finishedat) as integer) - cast(strftime('%s', startedat) as integer)"
+	default:
+		return "timestampdiff(second, startedat, finishedat)"
+	}
+}
+
+// statsResourceDurationExpr returns the expression of the duration of a resource used by a workflow in seconds
+func statsResourceDurationExpr(t sqldb.DBType, resource string) string {
+	switch t {
+	case sqldb.Postgres:
+		return fmt.Sprintf("coalesce(cast(workflow->'status'->'resourcesDuration'->>'%s' as bigint), 0)", resource)
+	case sqldb.SQLite:
+		return fmt.Sprintf("coalesce(json_extract(workflow, '$.status.resourcesDuration.%s'), 0)", resource)
+	default:
+		return fmt.Sprintf("coalesce(cast(JSON_EXTRACT(workflow, '$.status.resourcesDuration.%s') as signed), 0)", resource)
+	}
+}
+
+// statsPercentileExpr returns the aggregate expression of the nearest rank percentile of the durations, which is the
+// duration of the first workflow whose rank is at least the percentile of the number of workflows
+func statsPercentileExpr(percentile int) string {
+	return fmt.Sprintf("max(case when rn * 100 >= %d * n and (rn - 1) * 100 < %d * n then duration end)", percentile, percentile)
+}
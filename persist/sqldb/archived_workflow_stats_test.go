@@ -0,0 +1,83 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+)
+
+func TestSQLiteWorkflowStats(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy := setupSQLiteTest(ctx, t)
+	archive := NewWorkflowArchive(proxy, "test", "", instanceid.NewService(""), nil)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	archiveWorkflow := func(uid string, startedAt time.Duration, phase wfv1.WorkflowPhase, duration time.Duration, wfLabels map[string]string) {
+		wf := newArchivedWorkflow(uid, uid, start.Add(startedAt), wfLabels)
+		wf.Status.Phase = phase
+		wf.Status.FinishedAt = metav1.NewTime(wf.Status.StartedAt.Add(duration))
+		require.NoError(t, archive.ArchiveWorkflow(ctx, wf))
+	}
+	archiveWorkflow("a1", 10*time.Minute, wfv1.WorkflowSucceeded, time.Minute, map[string]string{common.LabelKeyWorkflowTemplate: "tpl-a", "env": "prod"})
+	archiveWorkflow("a2", 20*time.Minute, wfv1.WorkflowFailed, 5*time.Minute, map[string]string{common.LabelKeyWorkflowTemplate: "tpl-a", "env": "test"})
+	archiveWorkflow("a3", 130*time.Minute, wfv1.WorkflowSucceeded, time.Minute, map[string]string{common.LabelKeyWorkflowTemplate: "tpl-a"})
+	archiveWorkflow("b1", 30*time.Minute, wfv1.WorkflowError, time.Minute, map[string]string{common.LabelKeyClusterWorkflowTemplate: "tpl-b"})
+	archiveWorkflow("c1", 40*time.Minute, wfv1.WorkflowSucceeded, 2*time.Minute, map[string]string{"env": "prod"})
+	// started before the time range
+	archiveWorkflow("a0", -time.Minute, wfv1.WorkflowSucceeded, time.Minute, map[string]string{common.LabelKeyWorkflowTemplate: "tpl-a"})
+
+	options := WorkflowStatsOptions{Namespace: "default", StartTime: start, EndTime: start.Add(3 * time.Hour), Bucket: time.Hour}
+
+	t.Run("Template", func(t *testing.T) {
+		buckets, err := archive.WorkflowStats(ctx, options)
+		require.NoError(t, err)
+		require.Len(t, buckets, 3)
+		assert.Equal(t, WorkflowStatsBucket{
+			Group: "tpl-a", StartTime: start, Total: 2, Succeeded: 1, Failed: 1,
+			DurationP50: 60, DurationP90: 300, DurationP99: 300, CPU: 2,
+		}, buckets[0])
+		assert.InDelta(t, 0.5, buckets[0].SuccessRate(), 0.001)
+		assert.Equal(t, "tpl-a", buckets[1].Group)
+		assert.Equal(t, int64(2), buckets[1].Bucket)
+		assert.Equal(t, start.Add(2*time.Hour), buckets[1].StartTime)
+		assert.Equal(t, "tpl-b", buckets[2].Group)
+		assert.Equal(t, int64(1), buckets[2].Errored)
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		options := options
+		options.GroupBy = WorkflowStatsByNamespace
+		options.Bucket = 3 * time.Hour
+		buckets, err := archive.WorkflowStats(ctx, options)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.Equal(t, "default", buckets[0].Group)
+		assert.Equal(t, int64(5), buckets[0].Total)
+		assert.Equal(t, int64(60), buckets[0].DurationP50)
+		assert.Equal(t, int64(300), buckets[0].DurationP90)
+	})
+	t.Run("Label", func(t *testing.T) {
+		options := options
+		options.GroupBy = WorkflowStatsByLabel
+		options.LabelKey = "env"
+		options.LabelRequirements = mustParseRequirements(t, "env!=test")
+		buckets, err := archive.WorkflowStats(ctx, options)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.Equal(t, "prod", buckets[0].Group)
+		assert.Equal(t, int64(2), buckets[0].Total)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		options := options
+		options.GroupBy = WorkflowStatsByLabel
+		_, err := archive.WorkflowStats(ctx, options)
+		require.EqualError(t, err, "a label key is required to group by label")
+	})
+}
@@ -0,0 +1,183 @@
+package sqldb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+// tierBatchSize is the number of archived workflows moved to the workflow store at a time
+const tierBatchSize = 100
+
+// WorkflowStore stores the workflows of archived workflows outside of the database, such as in an artifact repository
+type WorkflowStore interface {
+	Save(ctx context.Context, key string, data []byte) error
+	Load(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type archivedWorkflowTieringRecord struct {
+	UID       string `db:"uid"`
+	Namespace string `db:"namespace"`
+	Workflow  string `db:"workflow"`
+}
+
+// TierWorkflows moves the workflows of archived workflows which finished more than age ago to the workflow store,
+// replacing them with stubs, which have the fields that are listed and searched. GetWorkflow loads the workflow from the
+// store again.
+func (r *workflowArchive) TierWorkflows(ctx context.Context, age time.Duration) error {
+	if r.workflowStore == nil {
+		return fmt.Errorf("archive tiering is not configured")
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	tiered := 0
+	for {
+		var records []archivedWorkflowTieringRecord
+		err := r.sessionProxy.With(ctx, func(s db.Session) error {
+			return s.SQL().
+				Select("uid", "namespace", "workflow").
+				From(archiveTableName).
+				Where(r.clusterManagedNamespaceAndInstanceID()).
+				And(db.Cond{"tieredkey IS": nil}).
+				And(r.dbType.OlderThan("finishedat", age)).
+				Limit(tierBatchSize).
+				All(&records)
+		})
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := r.tierWorkflow(ctx, record); err != nil {
+				return fmt.Errorf("failed to tier archived workflow %s: %w", record.UID, err)
+			}
+			tiered++
+		}
+		if len(records) < tierBatchSize {
+			logger.WithField("tiered", tiered).Info(ctx, "Moved archived workflows to the workflow store")
+			return nil
+		}
+	}
+}
+
+func (r *workflowArchive) tierWorkflow(ctx context.Context, record archivedWorkflowTieringRecord) error {
+	workflow := record.Workflow
+	if r.dbType == sqldb.Postgres {
+		workflow = strings.ReplaceAll(workflow, postgresNullReplacement, "\\u0000")
+	}
+	wf := &wfv1.Workflow{}
+	if err := json.Unmarshal([]byte(workflow), wf); err != nil {
+		return err
+	}
+	data, err := gzipData([]byte(workflow))
+	if err != nil {
+		return err
+	}
+	// the key is the same each time, so if the update fails the workflow is overwritten the next time
+	key := fmt.Sprintf("%s/%s/%s.json.gz", r.clusterName, record.Namespace, record.UID)
+	if err := r.workflowStore.Save(ctx, key, data); err != nil {
+		return err
+	}
+	stub, err := json.Marshal(tieredWorkflowStub(wf))
+	if err != nil {
+		return err
+	}
+	if r.dbType == sqldb.Postgres {
+		stub = bytes.ReplaceAll(stub, []byte("\\u0000"), []byte(postgresNullReplacement))
+	}
+	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		_, err := s.SQL().
+			Update(archiveTableName).
+			Set("workflow", string(stub)).
+			Set("tieredkey", key).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": record.UID}).
+			Exec()
+		return err
+	})
+}
+
+// tieredWorkflowStub returns the stub which replaces the workflow in the database when it is moved to the workflow store.
+// It has the fields which are extracted from the workflow column when listing archived workflows.
+func tieredWorkflowStub(wf *wfv1.Workflow) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		TypeMeta:   wf.TypeMeta,
+		ObjectMeta: wf.ObjectMeta,
+		Spec: wfv1.WorkflowSpec{
+			Arguments:           wf.Spec.Arguments,
+			Suspend:             wf.Spec.Suspend,
+			WorkflowTemplateRef: wf.Spec.WorkflowTemplateRef,
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:             wf.Status.Phase,
+			StartedAt:         wf.Status.StartedAt,
+			FinishedAt:        wf.Status.FinishedAt,
+			EstimatedDuration: wf.Status.EstimatedDuration,
+			Progress:          wf.Status.Progress,
+			Message:           wf.Status.Message,
+			ResourcesDuration: wf.Status.ResourcesDuration,
+		},
+	}
+}
+
+// loadTieredWorkflow returns the JSON of the workflow with the key in the workflow store
+func (r *workflowArchive) loadTieredWorkflow(ctx context.Context, key string) (string, error) {
+	if r.workflowStore == nil {
+		return "", fmt.Errorf("the archived workflow was moved to %s, but archive tiering is not configured", key)
+	}
+	data, err := r.workflowStore.Load(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to load archived workflow from %s: %w", key, err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	workflow, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(workflow), nil
+}
+
+// deleteTieredWorkflows deletes the workflows of the selected archived workflows from the workflow store
+func (r *workflowArchive) deleteTieredWorkflows(ctx context.Context, s db.Session, cond db.LogicalExpr) error {
+	if r.workflowStore == nil {
+		return nil
+	}
+	var keys []struct {
+		Key string `db:"tieredkey"`
+	}
+	if err := s.SQL().Select("tieredkey").From(archiveTableName).Where(cond).And(db.Cond{"tieredkey IS NOT": nil}).All(&keys); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := r.workflowStore.Delete(ctx, k.Key); err != nil {
+			return fmt.Errorf("failed to delete archived workflow from %s: %w", k.Key, err)
+		}
+	}
+	return nil
+}
+
+func gzipData(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
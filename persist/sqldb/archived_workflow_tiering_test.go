@@ -0,0 +1,98 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+type memoryWorkflowStore map[string][]byte
+
+func (s memoryWorkflowStore) Save(_ context.Context, key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+func (s memoryWorkflowStore) Load(_ context.Context, key string) ([]byte, error) {
+	data, ok := s[key]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", key)
+	}
+	return data, nil
+}
+
+func (s memoryWorkflowStore) Delete(_ context.Context, key string) error {
+	delete(s, key)
+	return nil
+}
+
+func TestSQLiteWorkflowArchiveTiering(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy := setupSQLiteTest(ctx, t)
+	store := memoryWorkflowStore{}
+	archive := NewWorkflowArchive(proxy, "test", "", instanceid.NewService(""), store)
+
+	now := time.Now().Truncate(time.Second)
+	for _, wf := range []*wfv1.Workflow{
+		newArchivedWorkflow("old-wf", "old-uid", now.Add(-48*time.Hour), map[string]string{"env": "prod"}),
+		newArchivedWorkflow("new-wf", "new-uid", now, map[string]string{"env": "test"}),
+	} {
+		wf.Status.Nodes = wfv1.Nodes{wf.Name: {ID: wf.Name, DisplayName: wf.Name, Phase: wfv1.NodeSucceeded}}
+		require.NoError(t, archive.ArchiveWorkflow(ctx, wf))
+	}
+
+	require.NoError(t, archive.TierWorkflows(ctx, 24*time.Hour))
+	require.Len(t, store, 1)
+	require.Contains(t, store, "test/default/old-uid.json.gz")
+	require.NoError(t, archive.TierWorkflows(ctx, 24*time.Hour), "tiering is idempotent")
+	require.Len(t, store, 1)
+
+	var record archivedWorkflowRecord
+	require.NoError(t, proxy.Session().SQL().Select("workflow", "tieredkey").From(archiveTableName).Where(db.Cond{"uid": "old-uid"}).One(&record))
+	require.NotNil(t, record.TieredKey)
+	assert.NotContains(t, record.Workflow, "nodes", "the database has a stub")
+
+	t.Run("GetWorkflow", func(t *testing.T) {
+		wf, err := archive.GetWorkflow(ctx, "old-uid", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "old-wf", wf.Name)
+		assert.Contains(t, wf.Status.Nodes, "old-wf", "the workflow is loaded from the store")
+		wf, err = archive.GetWorkflow(ctx, "", "default", "new-wf")
+		require.NoError(t, err)
+		assert.Contains(t, wf.Status.Nodes, "new-wf")
+	})
+	t.Run("ListWorkflows", func(t *testing.T) {
+		options := sutils.ListOptions{Namespace: "default", LabelRequirements: mustParseRequirements(t, "env=prod")}
+		wfs, err := archive.ListWorkflows(ctx, options)
+		require.NoError(t, err)
+		require.Len(t, wfs, 1)
+		wf := wfs[0]
+		assert.Equal(t, "old-wf", wf.Name)
+		assert.Equal(t, wfv1.Progress("1/1"), wf.Status.Progress)
+		assert.Equal(t, "completed", wf.Status.Message)
+		assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[0].Value.String())
+	})
+	t.Run("DeleteWorkflow", func(t *testing.T) {
+		require.NoError(t, archive.DeleteWorkflow(ctx, "old-uid"))
+		assert.Empty(t, store, "the workflow is deleted from the store")
+	})
+	t.Run("DeleteExpiredWorkflows", func(t *testing.T) {
+		require.NoError(t, archive.ArchiveWorkflow(ctx, newArchivedWorkflow("expired-wf", "expired-uid", now.Add(-72*time.Hour), map[string]string{})))
+		require.NoError(t, archive.TierWorkflows(ctx, 24*time.Hour))
+		require.Len(t, store, 1)
+		require.NoError(t, archive.DeleteExpiredWorkflows(ctx, 48*time.Hour))
+		assert.Empty(t, store)
+		_, err := archive.GetWorkflow(ctx, "new-uid", "", "")
+		require.NoError(t, err, "workflows which have not expired are kept")
+	})
+}
@@ -0,0 +1,201 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+const artifactLineageTableName = "argo_artifact_lineage"
+
+const (
+	// ArtifactLineageInput is the direction of an edge from an artifact to the node that consumed it
+	ArtifactLineageInput = "input"
+	// ArtifactLineageOutput is the direction of an edge from a node to the artifact it produced
+	ArtifactLineageOutput = "output"
+)
+
+// ArtifactLineageRecord is an edge between a workflow node and an artifact it consumed or produced
+type ArtifactLineageRecord struct {
+	ClusterName  string `db:"clustername"`
+	InstanceID   string `db:"instanceid"`
+	UID          string `db:"uid"`
+	Namespace    string `db:"namespace"`
+	WorkflowName string `db:"workflowname"`
+	NodeID       string `db:"nodeid"`
+	Direction    string `db:"direction"`
+	Name         string `db:"name"`
+	// Why is this called "artifactkey" not "key"? Key is an SQL reserved word.
+	Key       string    `db:"artifactkey"`
+	Digest    string    `db:"digest"`
+	CreatedAt time.Time `db:"createdat"`
+}
+
+// ArtifactLineageFilter selects artifact lineage records, empty fields match everything
+type ArtifactLineageFilter struct {
+	Namespace    string
+	Key          string
+	Digest       string
+	WorkflowUID  string
+	WorkflowName string
+	NodeID       string
+	Direction    string
+	Limit        int
+}
+
+type ArtifactLineageRepo interface {
+	IsEnabled() bool
+	// RecordWorkflow replaces the lineage records of the workflow with the artifacts its pods consumed and produced
+	RecordWorkflow(ctx context.Context, wf *wfv1.Workflow) error
+	// ListRecords lists records matching the filter, with the most recently recorded at the beginning
+	ListRecords(ctx context.Context, filter ArtifactLineageFilter) ([]ArtifactLineageRecord, error)
+	DeleteExpiredRecords(ctx context.Context, ttl time.Duration) error
+}
+
+type artifactLineageRepo struct {
+	sessionProxy      *sqldb.SessionProxy
+	clusterName       string
+	managedNamespace  string
+	instanceIDService instanceid.Service
+}
+
+// NewArtifactLineageRepo returns a new artifactLineageRepo
+func NewArtifactLineageRepo(sessionProxy *sqldb.SessionProxy, clusterName, managedNamespace string, instanceIDService instanceid.Service) ArtifactLineageRepo {
+	return &artifactLineageRepo{sessionProxy: sessionProxy, clusterName: clusterName, managedNamespace: managedNamespace, instanceIDService: instanceIDService}
+}
+
+func (r *artifactLineageRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *artifactLineageRepo) RecordWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
+	records := ArtifactLineageRecords(wf)
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"uid": wf.UID, "records": len(records)}).Debug(ctx, "Recording artifact lineage")
+	return r.sessionProxy.TxWith(ctx, func(s *sqldb.SessionProxy) error {
+		sess := s.Session()
+		_, err := sess.SQL().
+			DeleteFrom(artifactLineageTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		batch := sess.SQL().
+			InsertInto(artifactLineageTableName).
+			Columns("clustername", "instanceid", "uid", "namespace", "workflowname", "nodeid", "direction", "name", "artifactkey", "digest").
+			Batch(len(records))
+		for _, record := range records {
+			batch.Values(r.clusterName, r.instanceIDService.InstanceID(), record.UID, record.Namespace, record.WorkflowName, record.NodeID, record.Direction, record.Name, record.Key, record.Digest)
+		}
+		batch.Done()
+		return batch.Wait()
+	}, nil)
+}
+
+func (r *artifactLineageRepo) ListRecords(ctx context.Context, filter ArtifactLineageFilter) ([]ArtifactLineageRecord, error) {
+	var records []ArtifactLineageRecord
+	err := r.sessionProxy.With(ctx, func(s db.Session) error {
+		cond := db.Cond{}
+		for column, value := range map[string]string{
+			"artifactkey":  filter.Key,
+			"digest":       filter.Digest,
+			"uid":          filter.WorkflowUID,
+			"workflowname": filter.WorkflowName,
+			"nodeid":       filter.NodeID,
+			"direction":    filter.Direction,
+		} {
+			if value != "" {
+				cond[column] = value
+			}
+		}
+		query := s.SQL().
+			SelectFrom(artifactLineageTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(namespaceEqual(filter.Namespace)).
+			And(cond).
+			OrderBy("-createdat", "uid", "nodeid")
+		if filter.Limit > 0 {
+			query = query.Limit(filter.Limit)
+		}
+		return query.All(&records)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (r *artifactLineageRepo) DeleteExpiredRecords(ctx context.Context, ttl time.Duration) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		rs, err := s.SQL().
+			DeleteFrom(artifactLineageTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(r.sessionProxy.DBType().OlderThan("createdat", ttl)).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		logger.WithFields(logging.Fields{"rowsAffected": rowsAffected}).Info(ctx, "Deleted artifact lineage records")
+		return nil
+	})
+}
+
+func (r *artifactLineageRepo) clusterManagedNamespaceAndInstanceID() *db.AndExpr {
+	return db.And(
+		db.Cond{"clustername": r.clusterName},
+		namespaceEqual(r.managedNamespace),
+		db.Cond{"instanceid": r.instanceIDService.InstanceID()},
+	)
+}
+
+// ArtifactLineageRecords returns the artifacts consumed and produced by the pods of the workflow.
+// Only pod nodes are considered, as the inputs and outputs of steps and DAG nodes are references to their children's.
+// Artifacts without a key, such as raw or git artifacts, cannot be traced and are omitted.
+func ArtifactLineageRecords(wf *wfv1.Workflow) []ArtifactLineageRecord {
+	var records []ArtifactLineageRecord
+	add := func(node wfv1.NodeStatus, direction string, artifacts wfv1.Artifacts) {
+		for _, a := range artifacts {
+			key, err := a.GetKey()
+			if err != nil || key == "" {
+				continue
+			}
+			records = append(records, ArtifactLineageRecord{
+				UID:          string(wf.UID),
+				Namespace:    wf.Namespace,
+				WorkflowName: wf.Name,
+				NodeID:       node.ID,
+				Direction:    direction,
+				Name:         a.Name,
+				Key:          key,
+				Digest:       a.Digest,
+			})
+		}
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod {
+			continue
+		}
+		if node.Inputs != nil {
+			add(node, ArtifactLineageInput, node.Inputs.Artifacts)
+		}
+		if node.Outputs != nil {
+			add(node, ArtifactLineageOutput, node.Outputs.Artifacts)
+		}
+	}
+	return records
+}
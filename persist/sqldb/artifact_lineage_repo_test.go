@@ -0,0 +1,87 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestArtifactLineageRecords(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: my-uid
+status:
+  nodes:
+    my-wf:
+      id: my-wf
+      type: Steps
+      outputs:
+        artifacts:
+          - name: out
+            s3:
+              key: my-wf/out.tgz
+    my-wf-1:
+      id: my-wf-1
+      type: Pod
+      inputs:
+        artifacts:
+          - name: in
+            s3:
+              key: upstream/out.tgz
+          - name: raw
+            raw:
+              data: hello
+      outputs:
+        artifacts:
+          - name: out
+            digest: sha256:abc
+            s3:
+              key: my-wf/out.tgz
+`)
+	records := ArtifactLineageRecords(wf)
+	assert.ElementsMatch(t, []ArtifactLineageRecord{
+		{UID: "my-uid", Namespace: "my-ns", WorkflowName: "my-wf", NodeID: "my-wf-1", Direction: ArtifactLineageInput, Name: "in", Key: "upstream/out.tgz"},
+		{UID: "my-uid", Namespace: "my-ns", WorkflowName: "my-wf", NodeID: "my-wf-1", Direction: ArtifactLineageOutput, Name: "out", Key: "my-wf/out.tgz", Digest: "sha256:abc"},
+	}, records)
+}
+
+func TestSQLiteArtifactLineageRepo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy := setupSQLiteTest(ctx, t)
+	repo := NewArtifactLineageRepo(proxy, "test", "", instanceid.NewService(""))
+
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: default
+  uid: my-uid
+status:
+  nodes:
+    my-wf:
+      id: my-wf
+      type: Pod
+      outputs:
+        artifacts:
+          - name: out
+            s3:
+              key: my-wf/out.tgz
+`)
+	require.NoError(t, repo.RecordWorkflow(ctx, wf))
+	records, err := repo.ListRecords(ctx, ArtifactLineageFilter{Key: "my-wf/out.tgz"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "my-wf", records[0].WorkflowName)
+
+	require.NoError(t, repo.DeleteExpiredRecords(ctx, time.Hour))
+	records, err = repo.ListRecords(ctx, ArtifactLineageFilter{})
+	require.NoError(t, err)
+	assert.Len(t, records, 1, "records are kept until they expire")
+}
@@ -0,0 +1,73 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+// backfillArchivedWorkflowFieldsPageSize is the number of archived workflows read at once, as an archive can have too
+// many workflows to read them all
+const backfillArchivedWorkflowFieldsPageSize = 100
+
+// backfillArchivedWorkflowFields records the fields of the workflows which were archived before
+// argo_archived_workflows_fields was created, so that searches on those fields find them too
+type backfillArchivedWorkflowFields struct {
+	dbType sqldb.DBType
+}
+
+func (s backfillArchivedWorkflowFields) String() string {
+	return "backfillArchivedWorkflowFields{}"
+}
+
+// Apply records the fields of each archived workflow which has none. The workflow of a workflow which archive tiering
+// moved to the artifact repository is a stub, which has its parameters but not its nodes, so only its parameters are
+// recorded.
+func (s backfillArchivedWorkflowFields) Apply(ctx context.Context, session db.Session) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	logger.Info(ctx, "Backfill archived workflow fields")
+	lastUID := ""
+	for {
+		var rows []struct {
+			ClusterName string `db:"clustername"`
+			UID         string `db:"uid"`
+			Workflow    string `db:"workflow"`
+		}
+		err := session.SQL().
+			Select("clustername", "uid", "workflow").
+			From(archiveTableName).
+			Where(db.Cond{"uid >": lastUID}).
+			And(db.Raw(fmt.Sprintf("not exists (select 1 from %s f where f.clustername = %s.clustername and f.uid = %s.uid)", archiveFieldsTableName, archiveTableName, archiveTableName))).
+			OrderBy("uid").
+			Limit(backfillArchivedWorkflowFieldsPageSize).
+			All(&rows)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for _, row := range rows {
+			workflow := row.Workflow
+			if s.dbType == sqldb.Postgres {
+				workflow = strings.ReplaceAll(workflow, postgresNullReplacement, "\\u0000")
+			}
+			wf := &wfv1.Workflow{}
+			if err := json.Unmarshal([]byte(workflow), wf); err != nil {
+				logger.WithError(err).WithField("uid", row.UID).Warn(ctx, "Failed to unmarshal archived workflow, its fields are not back-filled")
+				continue
+			}
+			if err := insertArchivedWorkflowFields(session, s.dbType, row.ClusterName, row.UID, wf); err != nil {
+				return err
+			}
+		}
+		lastUID = rows[len(rows)-1].UID
+	}
+}
@@ -0,0 +1,141 @@
+package sqldb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+const eventDeduplicationTableName = "argo_event_deduplication"
+
+// EventDeduplicationRepo records the keys of the events of workflow event bindings, so that duplicate events can be
+// dropped
+type EventDeduplicationRepo interface {
+	// Record records the key of an event of the binding for the window, it returns false if the key is already
+	// recorded, i.e. the event is a duplicate
+	Record(ctx context.Context, namespace, binding, key string, window time.Duration) (bool, error)
+	// Delete deletes the key of an event of the binding, so that the event is no longer a duplicate, e.g. because it
+	// failed to be dispatched
+	Delete(ctx context.Context, namespace, binding, key string) error
+}
+
+type eventDeduplicationRepo struct {
+	sessionProxy *sqldb.SessionProxy
+	clusterName  string
+}
+
+// NewEventDeduplicationRepo returns a new eventDeduplicationRepo, which records keys in the database, so that they are
+// shared by all replicas of the Argo Server
+func NewEventDeduplicationRepo(sessionProxy *sqldb.SessionProxy, clusterName string) EventDeduplicationRepo {
+	return &eventDeduplicationRepo{sessionProxy: sessionProxy, clusterName: clusterName}
+}
+
+func (r *eventDeduplicationRepo) Record(ctx context.Context, namespace, binding, key string, window time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	// keys can be long, so a hash of the key is recorded
+	hash := hashEventDeduplicationKey(key)
+	recorded := false
+	err := r.sessionProxy.With(ctx, func(s db.Session) error {
+		_, err := s.SQL().
+			DeleteFrom(eventDeduplicationTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"namespace": namespace}).
+			And(db.Cond{"binding": binding}).
+			And(db.Cond{"expiresat <=": now}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = s.SQL().
+			InsertInto(eventDeduplicationTableName).
+			Values(map[string]any{
+				"clustername": r.clusterName,
+				"namespace":   namespace,
+				"binding":     binding,
+				"dedupkey":    hash,
+				"expiresat":   now.Add(window),
+			}).
+			Exec()
+		if err == nil {
+			recorded = true
+			return nil
+		}
+		// the insert fails if the key is already recorded, possibly by another replica at the same time
+		var existing []struct {
+			Key string `db:"dedupkey"`
+		}
+		existsErr := s.SQL().
+			Select("dedupkey").
+			From(eventDeduplicationTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"namespace": namespace}).
+			And(db.Cond{"binding": binding}).
+			And(db.Cond{"dedupkey": hash}).
+			All(&existing)
+		if existsErr != nil || len(existing) == 0 {
+			return err
+		}
+		return nil
+	})
+	return recorded, err
+}
+
+func (r *eventDeduplicationRepo) Delete(ctx context.Context, namespace, binding, key string) error {
+	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		_, err := s.SQL().
+			DeleteFrom(eventDeduplicationTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"namespace": namespace}).
+			And(db.Cond{"binding": binding}).
+			And(db.Cond{"dedupkey": hashEventDeduplicationKey(key)}).
+			Exec()
+		return err
+	})
+}
+
+func hashEventDeduplicationKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+type memoryEventDeduplicationRepo struct {
+	mu sync.Mutex
+	// expiresAt is the time each key expires, by namespace, binding and key
+	expiresAt map[[3]string]time.Time
+}
+
+// NewMemoryEventDeduplicationRepo returns an EventDeduplicationRepo which records keys in memory, it is used when
+// there is no database, so keys are not shared by replicas of the Argo Server
+func NewMemoryEventDeduplicationRepo() EventDeduplicationRepo {
+	return &memoryEventDeduplicationRepo{expiresAt: map[[3]string]time.Time{}}
+}
+
+func (r *memoryEventDeduplicationRepo) Record(ctx context.Context, namespace, binding, key string, window time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for k, expiresAt := range r.expiresAt {
+		if !expiresAt.After(now) {
+			delete(r.expiresAt, k)
+		}
+	}
+	k := [3]string{namespace, binding, key}
+	if _, ok := r.expiresAt[k]; ok {
+		return false, nil
+	}
+	r.expiresAt[k] = now.Add(window)
+	return true, nil
+}
+
+func (r *memoryEventDeduplicationRepo) Delete(ctx context.Context, namespace, binding, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.expiresAt, [3]string{namespace, binding, key})
+	return nil
+}
@@ -0,0 +1,39 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestEventDeduplicationRepo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for name, repo := range map[string]EventDeduplicationRepo{
+		"SQLite": NewEventDeduplicationRepo(setupSQLiteTest(ctx, t), "test"),
+		"Memory": NewMemoryEventDeduplicationRepo(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			record := func(binding, key string, window time.Duration) bool {
+				t.Helper()
+				recorded, err := repo.Record(ctx, "my-ns", binding, key, window)
+				require.NoError(t, err)
+				return recorded
+			}
+			assert.True(t, record("my-wfeb", "my-key", time.Hour))
+			assert.False(t, record("my-wfeb", "my-key", time.Hour), "a duplicate within the window")
+			assert.True(t, record("my-wfeb", "other-key", time.Hour))
+			assert.True(t, record("other-wfeb", "my-key", time.Hour), "keys are per binding")
+
+			assert.True(t, record("my-wfeb", "expiring-key", time.Millisecond))
+			time.Sleep(10 * time.Millisecond)
+			assert.True(t, record("my-wfeb", "expiring-key", time.Hour), "the window has passed")
+
+			require.NoError(t, repo.Delete(ctx, "my-ns", "my-wfeb", "my-key"))
+			assert.True(t, record("my-wfeb", "my-key", time.Hour), "the key was deleted")
+		})
+	}
+}
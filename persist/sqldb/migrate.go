@@ -12,7 +12,62 @@ const (
 	versionTable = "schema_history"
 )
 
+// sqliteBaselineVersion is the version at which SQLite databases are created, by sqliteBaseline
+const sqliteBaselineVersion = 68
+
+// sqliteBaseline creates the schema as it is at sqliteBaselineVersion. SQLite can neither alter columns nor change
+// primary keys, so SQLite databases are created at that version rather than applying the changes before it.
+func sqliteBaseline(tableName string) sqldb.Change {
+	return sqldb.ChangeList{
+		sqldb.AnsiSQLChange(`create table if not exists ` + tableName + ` (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    version varchar(64) not null,
+    nodes text not null,
+    updatedat timestamp not null default current_timestamp,
+    primary key (clustername, uid, version)
+)`),
+		sqldb.AnsiSQLChange(`create index ` + tableName + `_i1 on ` + tableName + ` (clustername,namespace,updatedat)`),
+		sqldb.AnsiSQLChange(`create table if not exists argo_archived_workflows (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    instanceid varchar(64) not null,
+    name varchar(256) not null,
+    namespace varchar(256) not null,
+    phase varchar(25) not null,
+    workflow text not null,
+    startedat timestamp not null default CURRENT_TIMESTAMP,
+    finishedat timestamp not null default CURRENT_TIMESTAMP,
+    creationtimestamp timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, uid)
+)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i1 on argo_archived_workflows (clustername, instanceid, namespace, startedat DESC)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername, startedat)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (creationtimestamp)`),
+		sqldb.AnsiSQLChange(`create table if not exists argo_archived_workflows_labels (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(317) not null,
+    value varchar(63) not null,
+    primary key (clustername, uid, name),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_labels_i1 on argo_archived_workflows_labels (name,value)`),
+	}
+}
+
 func MigrateChanges(clusterName, tableName string, dbType sqldb.DBType) []sqldb.Change {
+	changes := migrateChanges(clusterName, tableName, dbType)
+	if dbType == sqldb.SQLite {
+		return sqldb.Baseline(changes, sqliteBaselineVersion, sqliteBaseline(tableName))
+	}
+	return changes
+}
+
+func migrateChanges(clusterName, tableName string, dbType sqldb.DBType) []sqldb.Change {
 	return []sqldb.Change{
 		sqldb.AnsiSQLChange(`create table if not exists ` + tableName + ` (
     id varchar(128) ,
@@ -231,6 +286,71 @@ func MigrateChanges(clusterName, tableName string, dbType sqldb.DBType) []sqldb.
 			sqldb.Postgres: sqldb.AnsiSQLChange(`drop index argo_archived_workflows_i1`),
 		}),
 		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i1 on argo_archived_workflows (clustername, instanceid, namespace, startedat DESC)`),
+		// argo_artifact_lineage records which pods consumed (input) and produced (output) each artifact, so an artifact
+		// can be traced across workflows. There is no foreign key to argo_archived_workflows, as lineage outlives
+		// the deletion of an archived workflow until it expires.
+		sqldb.AnsiSQLChange(`create table if not exists argo_artifact_lineage (
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    workflowname varchar(256) not null,
+    nodeid varchar(256) not null,
+    direction varchar(8) not null,
+    name varchar(256) not null,
+    artifactkey varchar(1024) not null,
+    digest varchar(128) not null,
+    createdat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, uid, nodeid, direction, name)
+)`),
+		// MySQL limits index keys to 3072 bytes, so only a prefix of the artifact key is indexed
+		sqldb.ByType(dbType, sqldb.TypedChanges{
+			sqldb.MySQL:    sqldb.AnsiSQLChange(`create index argo_artifact_lineage_i1 on argo_artifact_lineage (clustername, artifactkey(512))`),
+			sqldb.Postgres: sqldb.AnsiSQLChange(`create index argo_artifact_lineage_i1 on argo_artifact_lineage (clustername, artifactkey)`),
+			sqldb.SQLite:   sqldb.AnsiSQLChange(`create index argo_artifact_lineage_i1 on argo_artifact_lineage (clustername, artifactkey)`),
+		}),
+		sqldb.AnsiSQLChange(`create index argo_artifact_lineage_i2 on argo_artifact_lineage (clustername, digest)`),
+		sqldb.AnsiSQLChange(`create index argo_artifact_lineage_i3 on argo_artifact_lineage (clustername, instanceid, createdat)`),
+		// argo_archived_workflows_fields records the fields of archived workflows which can be searched with field
+		// selectors, but are not columns of argo_archived_workflows: parameter values, and node phases and messages by
+		// node display name. Values are truncated so that the primary key fits in MySQL's 3072 byte limit.
+		sqldb.AnsiSQLChange(`create table if not exists argo_archived_workflows_fields (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(256) not null,
+    value varchar(255) not null,
+    primary key (clustername, uid, name, value),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_fields_i1 on argo_archived_workflows_fields (clustername, name, value)`),
+		// tieredkey is the key in the artifact repository of the workflow of an archived workflow which archive tiering
+		// moved there, the workflow column then has a stub of the workflow
+		sqldb.AnsiSQLChange(`alter table argo_archived_workflows add column tieredkey varchar(1024) null`),
+		// argo_event_deduplication records the keys of the events of workflow event bindings until their window has
+		// passed, the key is a SHA-256 hash, so that the primary key fits in MySQL's 3072 byte limit
+		sqldb.AnsiSQLChange(`create table if not exists argo_event_deduplication (
+    clustername varchar(64) not null,
+    namespace varchar(256) not null,
+    binding varchar(253) not null,
+    dedupkey varchar(64) not null,
+    expiresat timestamp not null,
+    primary key (clustername, namespace, binding, dedupkey)
+)`),
+		// argo_api_tokens records the API tokens issued by the Argo Server, tokenhash is the SHA-256 hash of the
+		// token's secret, scope is the JSON encoded namespaces, verbs and workflow templates the token is restricted to
+		sqldb.AnsiSQLChange(`create table if not exists argo_api_tokens (
+    clustername varchar(64) not null,
+    id varchar(64) not null,
+    namespace varchar(256) not null,
+    serviceaccount varchar(253) not null,
+    scope text not null,
+    tokenhash varchar(64) not null,
+    createdat timestamp not null,
+    expiresat timestamp not null,
+    primary key (clustername, id)
+)`),
+		// record the fields of the workflows which were archived before argo_archived_workflows_fields was created
+		backfillArchivedWorkflowFields{dbType: dbType},
 	}
 }
 
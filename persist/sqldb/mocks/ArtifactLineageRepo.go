@@ -0,0 +1,267 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewArtifactLineageRepo creates a new instance of ArtifactLineageRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewArtifactLineageRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ArtifactLineageRepo {
+	mock := &ArtifactLineageRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// ArtifactLineageRepo is an autogenerated mock type for the ArtifactLineageRepo type
+type ArtifactLineageRepo struct {
+	mock.Mock
+}
+
+type ArtifactLineageRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ArtifactLineageRepo) EXPECT() *ArtifactLineageRepo_Expecter {
+	return &ArtifactLineageRepo_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpiredRecords provides a mock function for the type ArtifactLineageRepo
+func (_mock *ArtifactLineageRepo) DeleteExpiredRecords(ctx context.Context, ttl time.Duration) error {
+	ret := _mock.Called(ctx, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredRecords")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
+		r0 = returnFunc(ctx, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArtifactLineageRepo_DeleteExpiredRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredRecords'
+type ArtifactLineageRepo_DeleteExpiredRecords_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ttl time.Duration
+func (_e *ArtifactLineageRepo_Expecter) DeleteExpiredRecords(ctx interface{}, ttl interface{}) *ArtifactLineageRepo_DeleteExpiredRecords_Call {
+	return &ArtifactLineageRepo_DeleteExpiredRecords_Call{Call: _e.mock.On("DeleteExpiredRecords", ctx, ttl)}
+}
+
+func (_c *ArtifactLineageRepo_DeleteExpiredRecords_Call) Run(run func(ctx context.Context, ttl time.Duration)) *ArtifactLineageRepo_DeleteExpiredRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_DeleteExpiredRecords_Call) Return(err error) *ArtifactLineageRepo_DeleteExpiredRecords_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_DeleteExpiredRecords_Call) RunAndReturn(run func(ctx context.Context, ttl time.Duration) error) *ArtifactLineageRepo_DeleteExpiredRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEnabled provides a mock function for the type ArtifactLineageRepo
+func (_mock *ArtifactLineageRepo) IsEnabled() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEnabled")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// ArtifactLineageRepo_IsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnabled'
+type ArtifactLineageRepo_IsEnabled_Call struct {
+	*mock.Call
+}
+
+// IsEnabled is a helper method to define mock.On call
+func (_e *ArtifactLineageRepo_Expecter) IsEnabled() *ArtifactLineageRepo_IsEnabled_Call {
+	return &ArtifactLineageRepo_IsEnabled_Call{Call: _e.mock.On("IsEnabled")}
+}
+
+func (_c *ArtifactLineageRepo_IsEnabled_Call) Run(run func()) *ArtifactLineageRepo_IsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_IsEnabled_Call) Return(b bool) *ArtifactLineageRepo_IsEnabled_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_IsEnabled_Call) RunAndReturn(run func() bool) *ArtifactLineageRepo_IsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecords provides a mock function for the type ArtifactLineageRepo
+func (_mock *ArtifactLineageRepo) ListRecords(ctx context.Context, filter sqldb.ArtifactLineageFilter) ([]sqldb.ArtifactLineageRecord, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecords")
+	}
+
+	var r0 []sqldb.ArtifactLineageRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sqldb.ArtifactLineageFilter) ([]sqldb.ArtifactLineageRecord, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sqldb.ArtifactLineageFilter) []sqldb.ArtifactLineageRecord); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.ArtifactLineageRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sqldb.ArtifactLineageFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArtifactLineageRepo_ListRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecords'
+type ArtifactLineageRepo_ListRecords_Call struct {
+	*mock.Call
+}
+
+// ListRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter sqldb.ArtifactLineageFilter
+func (_e *ArtifactLineageRepo_Expecter) ListRecords(ctx interface{}, filter interface{}) *ArtifactLineageRepo_ListRecords_Call {
+	return &ArtifactLineageRepo_ListRecords_Call{Call: _e.mock.On("ListRecords", ctx, filter)}
+}
+
+func (_c *ArtifactLineageRepo_ListRecords_Call) Run(run func(ctx context.Context, filter sqldb.ArtifactLineageFilter)) *ArtifactLineageRepo_ListRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sqldb.ArtifactLineageFilter
+		if args[1] != nil {
+			arg1 = args[1].(sqldb.ArtifactLineageFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_ListRecords_Call) Return(artifactLineageRecords []sqldb.ArtifactLineageRecord, err error) *ArtifactLineageRepo_ListRecords_Call {
+	_c.Call.Return(artifactLineageRecords, err)
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_ListRecords_Call) RunAndReturn(run func(ctx context.Context, filter sqldb.ArtifactLineageFilter) ([]sqldb.ArtifactLineageRecord, error)) *ArtifactLineageRepo_ListRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordWorkflow provides a mock function for the type ArtifactLineageRepo
+func (_mock *ArtifactLineageRepo) RecordWorkflow(ctx context.Context, wf *v1alpha1.Workflow) error {
+	ret := _mock.Called(ctx, wf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWorkflow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Workflow) error); ok {
+		r0 = returnFunc(ctx, wf)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArtifactLineageRepo_RecordWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWorkflow'
+type ArtifactLineageRepo_RecordWorkflow_Call struct {
+	*mock.Call
+}
+
+// RecordWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wf *v1alpha1.Workflow
+func (_e *ArtifactLineageRepo_Expecter) RecordWorkflow(ctx interface{}, wf interface{}) *ArtifactLineageRepo_RecordWorkflow_Call {
+	return &ArtifactLineageRepo_RecordWorkflow_Call{Call: _e.mock.On("RecordWorkflow", ctx, wf)}
+}
+
+func (_c *ArtifactLineageRepo_RecordWorkflow_Call) Run(run func(ctx context.Context, wf *v1alpha1.Workflow)) *ArtifactLineageRepo_RecordWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.Workflow
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.Workflow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_RecordWorkflow_Call) Return(err error) *ArtifactLineageRepo_RecordWorkflow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArtifactLineageRepo_RecordWorkflow_Call) RunAndReturn(run func(ctx context.Context, wf *v1alpha1.Workflow) error) *ArtifactLineageRepo_RecordWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/server/utils"
 	mock "github.com/stretchr/testify/mock"
@@ -739,3 +740,128 @@ func (_c *WorkflowArchive_ListWorkflowsLabelValues_Call) RunAndReturn(run func(c
 	_c.Call.Return(run)
 	return _c
 }
+
+// TierWorkflows provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) TierWorkflows(ctx context.Context, age time.Duration) error {
+	ret := _mock.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TierWorkflows")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
+		r0 = returnFunc(ctx, age)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WorkflowArchive_TierWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TierWorkflows'
+type WorkflowArchive_TierWorkflows_Call struct {
+	*mock.Call
+}
+
+// TierWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - age time.Duration
+func (_e *WorkflowArchive_Expecter) TierWorkflows(ctx interface{}, age interface{}) *WorkflowArchive_TierWorkflows_Call {
+	return &WorkflowArchive_TierWorkflows_Call{Call: _e.mock.On("TierWorkflows", ctx, age)}
+}
+
+func (_c *WorkflowArchive_TierWorkflows_Call) Run(run func(ctx context.Context, age time.Duration)) *WorkflowArchive_TierWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_TierWorkflows_Call) Return(err error) *WorkflowArchive_TierWorkflows_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WorkflowArchive_TierWorkflows_Call) RunAndReturn(run func(ctx context.Context, age time.Duration) error) *WorkflowArchive_TierWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowStats provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) WorkflowStats(ctx context.Context, options sqldb.WorkflowStatsOptions) ([]sqldb.WorkflowStatsBucket, error) {
+	ret := _mock.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowStats")
+	}
+
+	var r0 []sqldb.WorkflowStatsBucket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sqldb.WorkflowStatsOptions) ([]sqldb.WorkflowStatsBucket, error)); ok {
+		return returnFunc(ctx, options)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sqldb.WorkflowStatsOptions) []sqldb.WorkflowStatsBucket); ok {
+		r0 = returnFunc(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.WorkflowStatsBucket)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sqldb.WorkflowStatsOptions) error); ok {
+		r1 = returnFunc(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_WorkflowStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowStats'
+type WorkflowArchive_WorkflowStats_Call struct {
+	*mock.Call
+}
+
+// WorkflowStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options sqldb.WorkflowStatsOptions
+func (_e *WorkflowArchive_Expecter) WorkflowStats(ctx interface{}, options interface{}) *WorkflowArchive_WorkflowStats_Call {
+	return &WorkflowArchive_WorkflowStats_Call{Call: _e.mock.On("WorkflowStats", ctx, options)}
+}
+
+func (_c *WorkflowArchive_WorkflowStats_Call) Run(run func(ctx context.Context, options sqldb.WorkflowStatsOptions)) *WorkflowArchive_WorkflowStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sqldb.WorkflowStatsOptions
+		if args[1] != nil {
+			arg1 = args[1].(sqldb.WorkflowStatsOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_WorkflowStats_Call) Return(workflowStatsBuckets []sqldb.WorkflowStatsBucket, err error) *WorkflowArchive_WorkflowStats_Call {
+	_c.Call.Return(workflowStatsBuckets, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_WorkflowStats_Call) RunAndReturn(run func(ctx context.Context, options sqldb.WorkflowStatsOptions) ([]sqldb.WorkflowStatsBucket, error)) *WorkflowArchive_WorkflowStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,29 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+var NullArtifactLineageRepo ArtifactLineageRepo = &nullArtifactLineageRepo{}
+
+type nullArtifactLineageRepo struct{}
+
+func (r *nullArtifactLineageRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullArtifactLineageRepo) RecordWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
+	return nil
+}
+
+func (r *nullArtifactLineageRepo) ListRecords(ctx context.Context, filter ArtifactLineageFilter) ([]ArtifactLineageRecord, error) {
+	return nil, fmt.Errorf("artifact lineage not supported")
+}
+
+func (r *nullArtifactLineageRepo) DeleteExpiredRecords(ctx context.Context, ttl time.Duration) error {
+	return nil
+}
@@ -58,3 +58,11 @@ func (r *nullWorkflowArchive) ListWorkflowsLabelKeys(ctx context.Context) (*wfv1
 func (r *nullWorkflowArchive) ListWorkflowsLabelValues(ctx context.Context, key string) (*wfv1.LabelValues, error) {
 	return &wfv1.LabelValues{}, nil
 }
+
+func (r *nullWorkflowArchive) WorkflowStats(ctx context.Context, options WorkflowStatsOptions) ([]WorkflowStatsBucket, error) {
+	return nil, fmt.Errorf("archived workflow statistics not supported")
+}
+
+func (r *nullWorkflowArchive) TierWorkflows(ctx context.Context, age time.Duration) error {
+	return nil
+}
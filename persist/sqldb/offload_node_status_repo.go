@@ -118,6 +118,10 @@ func isDuplicateKeyError(err error) bool {
 	if strings.Contains(err.Error(), "Duplicate entry") {
 		return true
 	}
+	// sqlite
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return true
+	}
 	return false
 }
 
@@ -237,5 +241,5 @@ func (wdc *nodeOffloadRepo) Delete(ctx context.Context, uid, version string) err
 }
 
 func (wdc *nodeOffloadRepo) oldOffload() string {
-	return fmt.Sprintf("updatedat < current_timestamp - interval '%d' second", int(wdc.ttl.Seconds()))
+	return wdc.sessionProxy.DBType().OlderThan("updatedat", wdc.ttl)
 }
@@ -46,6 +46,10 @@ func BuildArchivedWorkflowSelector(selector db.Selector, tableName, labelTableNa
 	if err != nil {
 		return nil, err
 	}
+	selector, err = fieldsClause(selector, t, options.FieldRequirements, tableName)
+	if err != nil {
+		return nil, err
+	}
 	if count {
 		return selector, nil
 	}
@@ -11,6 +11,8 @@ func GetTableName(persistConfig *config.PersistConfig) (string, error) {
 		tableName = persistConfig.PostgreSQL.TableName
 	} else if persistConfig.MySQL != nil {
 		tableName = persistConfig.MySQL.TableName
+	} else if persistConfig.SQLite != nil {
+		tableName = persistConfig.SQLite.TableName
 	}
 	if tableName == "" {
 		return "", errors.InternalError("TableName is empty")
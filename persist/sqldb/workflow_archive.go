@@ -59,6 +59,9 @@ type archivedWorkflowMetadata struct {
 type archivedWorkflowRecord struct {
 	archivedWorkflowMetadata
 	Workflow string `db:"workflow"`
+	// TieredKey is the key of the workflow in the workflow store, if it was moved there, the workflow column then has
+	// a stub of the workflow
+	TieredKey *string `db:"tieredkey,omitempty"`
 }
 
 type archivedWorkflowLabelRecord struct {
@@ -85,9 +88,12 @@ type WorkflowArchive interface {
 	GetWorkflowForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error)
 	DeleteWorkflow(ctx context.Context, uid string) error
 	DeleteExpiredWorkflows(ctx context.Context, ttl time.Duration) error
+	// TierWorkflows moves the workflows of archived workflows which finished more than age ago to the workflow store
+	TierWorkflows(ctx context.Context, age time.Duration) error
 	IsEnabled() bool
 	ListWorkflowsLabelKeys(ctx context.Context) (*wfv1.LabelKeys, error)
 	ListWorkflowsLabelValues(ctx context.Context, key string) (*wfv1.LabelValues, error)
+	WorkflowStats(ctx context.Context, options WorkflowStatsOptions) ([]WorkflowStatsBucket, error)
 }
 
 type workflowArchive struct {
@@ -96,15 +102,17 @@ type workflowArchive struct {
 	managedNamespace  string
 	instanceIDService instanceid.Service
 	dbType            sqldb.DBType
+	workflowStore     WorkflowStore
 }
 
 func (r *workflowArchive) IsEnabled() bool {
 	return true
 }
 
-// NewWorkflowArchive returns a new workflowArchive
-func NewWorkflowArchive(sessionProxy *sqldb.SessionProxy, clusterName, managedNamespace string, instanceIDService instanceid.Service) WorkflowArchive {
-	return &workflowArchive{sessionProxy: sessionProxy, clusterName: clusterName, managedNamespace: managedNamespace, instanceIDService: instanceIDService, dbType: sessionProxy.DBType()}
+// NewWorkflowArchive returns a new workflowArchive. The workflow store is where the workflows of old archived
+// workflows are moved to by archive tiering, it is nil if archive tiering is not configured.
+func NewWorkflowArchive(sessionProxy *sqldb.SessionProxy, clusterName, managedNamespace string, instanceIDService instanceid.Service, workflowStore WorkflowStore) WorkflowArchive {
+	return &workflowArchive{sessionProxy: sessionProxy, clusterName: clusterName, managedNamespace: managedNamespace, instanceIDService: instanceIDService, dbType: sessionProxy.DBType(), workflowStore: workflowStore}
 }
 
 func (r *workflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
@@ -171,7 +179,16 @@ func (r *workflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow
 				return err
 			}
 		}
-		return nil
+
+		_, err = sess.SQL().
+			DeleteFrom(archiveFieldsTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		return insertArchivedWorkflowFields(sess, r.dbType, r.clusterName, string(wf.UID), wf)
 	}, nil)
 }
 
@@ -244,6 +261,33 @@ func (r *workflowArchive) ListWorkflows(ctx context.Context, options sutils.List
 		}); err != nil {
 			return nil, err
 		}
+	case sqldb.SQLite:
+		if err := r.sessionProxy.With(ctx, func(s db.Session) error {
+			// SQLite's json_extract returns strings unquoted, and booleans as 0 or 1
+			selectQuery := s.SQL().Select("name", "namespace", "uid", "phase", "startedat", "finishedat", "creationtimestamp").
+				Columns(
+					db.Raw("coalesce(json_extract(workflow, '$.metadata.labels'), '{}') as labels"),
+					db.Raw("coalesce(json_extract(workflow, '$.metadata.annotations'), '{}') as annotations"),
+					db.Raw("coalesce(json_extract(workflow, '$.status.progress'), '') as progress"),
+					db.Raw("json_extract(workflow, '$.spec.suspend') as suspend"),
+					db.Raw("coalesce(json_extract(workflow, '$.spec.arguments'), '{}') as arguments"),
+					db.Raw("coalesce(json_extract(workflow, '$.status.message'), '') as message"),
+					db.Raw("coalesce(json_extract(workflow, '$.status.estimatedDuration'), 0) as estimatedduration"),
+					db.Raw("coalesce(json_extract(workflow, '$.status.resourcesDuration'), '{}') as resourcesduration"),
+				).
+				From(archiveTableName).
+				Where(r.clusterManagedNamespaceAndInstanceID())
+
+			var err error
+			selectQuery, err = BuildArchivedWorkflowSelector(selectQuery, archiveTableName, archiveLabelsTableName, r.dbType, options, false)
+			if err != nil {
+				return err
+			}
+
+			return selectQuery.All(&archivedWfs)
+		}); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unsupported db type %s", r.dbType)
 	}
@@ -304,7 +348,7 @@ func (r *workflowArchive) ListWorkflows(ctx context.Context, options sutils.List
 }
 
 func (r *workflowArchive) CountWorkflows(ctx context.Context, options sutils.ListOptions) (int64, error) {
-	if options.Limit > 0 && options.Offset > 0 {
+	if options.Limit > 0 && options.Offset > 0 && len(options.FieldRequirements) == 0 {
 		return r.countWorkflowsOptimized(ctx, options)
 	}
 
@@ -312,7 +356,7 @@ func (r *workflowArchive) CountWorkflows(ctx context.Context, options sutils.Lis
 	err := r.sessionProxy.With(ctx, func(s db.Session) error {
 		total := &archivedWorkflowCount{}
 
-		if len(options.LabelRequirements) == 0 {
+		if len(options.LabelRequirements) == 0 && len(options.FieldRequirements) == 0 {
 			selector := s.SQL().
 				Select(db.Raw("count(*) as total")).
 				From(archiveTableName).
@@ -491,7 +535,7 @@ func (r *workflowArchive) HasMoreWorkflows(ctx context.Context, options sutils.L
 			}
 		}
 
-		if len(options.LabelRequirements) > 0 {
+		if len(options.LabelRequirements) > 0 || len(options.FieldRequirements) > 0 {
 			var err error
 			selector, err = BuildArchivedWorkflowSelector(selector, archiveTableName, archiveLabelsTableName, r.dbType, options, false)
 			if err != nil {
@@ -611,7 +655,7 @@ func (r *workflowArchive) GetWorkflow(ctx context.Context, uid string, namespace
 		var err error
 		if uid != "" {
 			err = s.SQL().
-				Select("workflow").
+				Select("workflow", "tieredkey").
 				From(archiveTableName).
 				Where(r.clusterManagedNamespaceAndInstanceID()).
 				And(db.Cond{"uid": uid}).
@@ -637,7 +681,7 @@ func (r *workflowArchive) GetWorkflow(ctx context.Context, uid string, namespace
 				}).Debug(ctx, "returning latest of archived workflows")
 			}
 			err = s.SQL().
-				Select("workflow").
+				Select("workflow", "tieredkey").
 				From(archiveTableName).
 				Where(r.clusterManagedNamespaceAndInstanceID()).
 				And(namespaceEqual(namespace)).
@@ -653,7 +697,12 @@ func (r *workflowArchive) GetWorkflow(ctx context.Context, uid string, namespace
 			return err
 		}
 		var wf *wfv1.Workflow
-		if r.dbType == sqldb.Postgres {
+		if archivedWf.TieredKey != nil {
+			archivedWf.Workflow, err = r.loadTieredWorkflow(ctx, *archivedWf.TieredKey)
+			if err != nil {
+				return err
+			}
+		} else if r.dbType == sqldb.Postgres {
 			archivedWf.Workflow = strings.ReplaceAll(archivedWf.Workflow, postgresNullReplacement, "\\u0000")
 		}
 		if err = json.Unmarshal([]byte(archivedWf.Workflow), &wf); err != nil {
@@ -726,6 +775,9 @@ func (r *workflowArchive) GetWorkflowForEstimator(ctx context.Context, namespace
 func (r *workflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		if err := r.deleteTieredWorkflows(ctx, s, db.And(r.clusterManagedNamespaceAndInstanceID(), db.Cond{"uid": uid})); err != nil {
+			return err
+		}
 		rs, err := s.SQL().
 			DeleteFrom(archiveTableName).
 			Where(r.clusterManagedNamespaceAndInstanceID()).
@@ -746,10 +798,13 @@ func (r *workflowArchive) DeleteWorkflow(ctx context.Context, uid string) error
 func (r *workflowArchive) DeleteExpiredWorkflows(ctx context.Context, ttl time.Duration) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	return r.sessionProxy.With(ctx, func(s db.Session) error {
+		if err := r.deleteTieredWorkflows(ctx, s, db.And(r.clusterManagedNamespaceAndInstanceID(), db.Raw(r.dbType.OlderThan("finishedat", ttl)))); err != nil {
+			return err
+		}
 		rs, err := s.SQL().
 			DeleteFrom(archiveTableName).
 			Where(r.clusterManagedNamespaceAndInstanceID()).
-			And(fmt.Sprintf("finishedat < current_timestamp - interval '%d' second", int(ttl.Seconds()))).
+			And(r.dbType.OlderThan("finishedat", ttl)).
 			Exec()
 		if err != nil {
 			return err
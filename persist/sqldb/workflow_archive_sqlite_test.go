@@ -0,0 +1,70 @@
+package sqldb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	usqldb "github.com/argoproj/argo-workflows/v4/util/sqldb"
+)
+
+// setupSQLiteTest creates a SQLite database in a temporary file and runs migrations.
+func setupSQLiteTest(ctx context.Context, t *testing.T) *usqldb.SessionProxy {
+	t.Helper()
+	proxy, err := usqldb.NewSessionProxy(ctx, usqldb.SessionProxyConfig{
+		DBConfig: config.DBConfig{SQLite: &config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { proxy.Close() })
+	for range 2 {
+		require.NoError(t, Migrate(ctx, proxy.Session(), "test", "argo_workflows", proxy.DBType()), "migration is idempotent")
+	}
+	return proxy
+}
+
+func TestSQLiteWorkflowArchive(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	testWorkflowArchive(ctx, t, setupSQLiteTest(ctx, t))
+}
+
+func TestSQLiteOffloadNodeStatusRepo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy := setupSQLiteTest(ctx, t)
+	repo, err := NewOffloadNodeStatusRepo(ctx, logging.RequireLoggerFromContext(ctx), proxy, "test", "argo_workflows")
+	require.NoError(t, err)
+
+	nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{ID: "my-node", Phase: wfv1.NodeSucceeded}}
+	version, err := repo.Save(ctx, "my-uid", "default", nodes)
+	require.NoError(t, err)
+	_, err = repo.Save(ctx, "my-uid", "default", nodes)
+	require.NoError(t, err, "saving the same nodes again is ignored")
+
+	loaded, err := repo.Get(ctx, "my-uid", version)
+	require.NoError(t, err)
+	assert.Equal(t, nodes, loaded)
+
+	all, err := repo.List(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[UUIDVersion]wfv1.Nodes{{UID: "my-uid", Version: version}: nodes}, all)
+
+	old, err := repo.ListOldOffloads(ctx, "default")
+	require.NoError(t, err)
+	assert.Empty(t, old)
+	_, err = proxy.Session().SQL().Exec(`update argo_workflows set updatedat = ?`, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	old, err = repo.ListOldOffloads(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"my-uid": {version}}, old)
+
+	require.NoError(t, repo.Delete(ctx, "my-uid", version))
+	all, err = repo.List(ctx, "default")
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
@@ -1,13 +1,21 @@
 package sqldb
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	usqldb "github.com/argoproj/argo-workflows/v4/util/sqldb"
 )
 
 func Test_archivedWorkflowMetadata_argumentsUnmarshal(t *testing.T) {
@@ -42,3 +50,154 @@ func Test_archivedWorkflowMetadata_argumentsUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func newArchivedWorkflow(name, uid string, startedAt time.Time, wfLabels map[string]string) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			UID:               types.UID(uid),
+			CreationTimestamp: metav1.NewTime(startedAt),
+			Labels:            wfLabels,
+			Annotations:       map[string]string{"note": "integration-test"},
+		},
+		Spec: wfv1.WorkflowSpec{
+			Suspend: new(true),
+			Arguments: wfv1.Arguments{
+				Parameters: []wfv1.Parameter{
+					{Name: "msg", Value: wfv1.AnyStringPtr("hello")},
+				},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:             wfv1.WorkflowSucceeded,
+			StartedAt:         metav1.NewTime(startedAt),
+			FinishedAt:        metav1.NewTime(startedAt.Add(time.Minute)),
+			Progress:          "1/1",
+			Message:           "completed",
+			EstimatedDuration: wfv1.EstimatedDuration(30),
+			ResourcesDuration: wfv1.ResourcesDuration{"cpu": wfv1.NewResourceDuration(time.Second)},
+		},
+	}
+}
+
+// testWorkflowArchive runs the archive suite against a migrated database, so that every database type is covered by
+// the same tests
+func testWorkflowArchive(ctx context.Context, t *testing.T, proxy *usqldb.SessionProxy) {
+	archive := NewWorkflowArchive(proxy, "test", "", instanceid.NewService(""), nil)
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, archive.ArchiveWorkflow(ctx, newArchivedWorkflow("old-wf", "old-uid", now.Add(-48*time.Hour), map[string]string{"env": "prod", "size": "1"})))
+	require.NoError(t, archive.ArchiveWorkflow(ctx, newArchivedWorkflow("new-wf", "new-uid", now, map[string]string{"env": "test", "size": "3"})))
+	// archiving again replaces the workflow
+	require.NoError(t, archive.ArchiveWorkflow(ctx, newArchivedWorkflow("new-wf", "new-uid", now, map[string]string{"env": "test", "size": "3"})))
+
+	t.Run("ListWorkflows", func(t *testing.T) {
+		wfs, err := archive.ListWorkflows(ctx, sutils.ListOptions{Namespace: "default", Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, wfs, 2)
+		wf := wfs[0]
+		assert.Equal(t, "new-wf", wf.Name, "most recently started first")
+		assert.Equal(t, wfv1.WorkflowSucceeded, wf.Status.Phase)
+		assert.True(t, now.Equal(wf.Status.StartedAt.Time))
+		assert.Equal(t, wfv1.Progress("1/1"), wf.Status.Progress)
+		assert.Equal(t, "completed", wf.Status.Message)
+		assert.Equal(t, "test", wf.GetLabels()["env"])
+		assert.Equal(t, "integration-test", wf.GetAnnotations()["note"])
+		assert.Equal(t, new(true), wf.Spec.Suspend)
+		assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[0].Value.String())
+		assert.Equal(t, wfv1.EstimatedDuration(30), wf.Status.EstimatedDuration)
+		assert.Equal(t, wfv1.NewResourceDuration(time.Second), wf.Status.ResourcesDuration["cpu"])
+	})
+	t.Run("Filters", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			options sutils.ListOptions
+			want    []string
+		}{
+			"Name":         {sutils.ListOptions{Name: "old-wf"}, []string{"old-wf"}},
+			"NamePrefix":   {sutils.ListOptions{NamePrefix: "new"}, []string{"new-wf"}},
+			"NameContains": {sutils.ListOptions{Name: "w-w", NameFilter: "Contains"}, []string{"new-wf"}},
+			"Namespace":    {sutils.ListOptions{Namespace: "other"}, nil},
+			"MinStartedAt": {sutils.ListOptions{MinStartedAt: now.Add(-time.Hour)}, []string{"new-wf"}},
+			"MaxStartedAt": {sutils.ListOptions{MaxStartedAt: now.Add(-time.Hour)}, []string{"old-wf"}},
+			"Label":        {sutils.ListOptions{LabelRequirements: mustParseRequirements(t, "env=prod")}, []string{"old-wf"}},
+			"LabelIn":      {sutils.ListOptions{LabelRequirements: mustParseRequirements(t, "env in (prod,test)")}, []string{"new-wf", "old-wf"}},
+			"LabelNot":     {sutils.ListOptions{LabelRequirements: mustParseRequirements(t, "!env")}, nil},
+			"LabelGreater": {sutils.ListOptions{LabelRequirements: mustParseRequirements(t, "size>2")}, []string{"new-wf"}},
+			"Offset":       {sutils.ListOptions{Limit: 1, Offset: 1}, []string{"old-wf"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				wfs, err := archive.ListWorkflows(ctx, tt.options)
+				require.NoError(t, err)
+				var names []string
+				for _, wf := range wfs {
+					names = append(names, wf.Name)
+				}
+				assert.Equal(t, tt.want, names)
+				count, err := archive.CountWorkflows(ctx, tt.options)
+				require.NoError(t, err)
+				if tt.options.Limit == 0 {
+					assert.Equal(t, int64(len(tt.want)), count)
+				}
+			})
+		}
+	})
+	t.Run("HasMoreWorkflows", func(t *testing.T) {
+		hasMore, err := archive.HasMoreWorkflows(ctx, sutils.ListOptions{Limit: 1})
+		require.NoError(t, err)
+		assert.True(t, hasMore)
+		hasMore, err = archive.HasMoreWorkflows(ctx, sutils.ListOptions{Limit: 2})
+		require.NoError(t, err)
+		assert.False(t, hasMore)
+	})
+	t.Run("GetWorkflow", func(t *testing.T) {
+		wf, err := archive.GetWorkflow(ctx, "old-uid", "", "")
+		require.NoError(t, err)
+		require.NotNil(t, wf)
+		assert.Equal(t, "old-wf", wf.Name)
+		wf, err = archive.GetWorkflow(ctx, "", "default", "new-wf")
+		require.NoError(t, err)
+		require.NotNil(t, wf)
+		assert.Equal(t, types.UID("new-uid"), wf.UID)
+		wf, err = archive.GetWorkflow(ctx, "missing-uid", "", "")
+		require.NoError(t, err)
+		assert.Nil(t, wf)
+	})
+	t.Run("GetWorkflowForEstimator", func(t *testing.T) {
+		wf, err := archive.GetWorkflowForEstimator(ctx, "default", mustParseRequirements(t, "env=prod"))
+		require.NoError(t, err)
+		require.NotNil(t, wf)
+		assert.Equal(t, "old-wf", wf.Name)
+	})
+	t.Run("Labels", func(t *testing.T) {
+		keys, err := archive.ListWorkflowsLabelKeys(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"env", "size", "workflows.argoproj.io/workflow-archiving-status"}, keys.Items)
+		values, err := archive.ListWorkflowsLabelValues(ctx, "env")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"prod", "test"}, values.Items)
+	})
+	t.Run("DeleteExpiredWorkflows", func(t *testing.T) {
+		require.NoError(t, archive.DeleteExpiredWorkflows(ctx, 24*time.Hour))
+		wfs, err := archive.ListWorkflows(ctx, sutils.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wfs, 1)
+		assert.Equal(t, "new-wf", wfs[0].Name)
+	})
+	t.Run("DeleteWorkflow", func(t *testing.T) {
+		require.NoError(t, archive.DeleteWorkflow(ctx, "new-uid"))
+		count, err := archive.CountWorkflows(ctx, sutils.ListOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		values, err := archive.ListWorkflowsLabelValues(ctx, "env")
+		require.NoError(t, err)
+		assert.Empty(t, values.Items, "labels are deleted with their workflow")
+	})
+}
+
+func mustParseRequirements(t *testing.T, selector string) labels.Requirements {
+	t.Helper()
+	requirements, err := labels.ParseToRequirements(selector)
+	require.NoError(t, err)
+	return requirements
+}
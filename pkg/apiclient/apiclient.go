@@ -8,6 +8,8 @@ import (
 
 	"k8s.io/client-go/tools/clientcmd"
 
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/info"
@@ -27,6 +29,8 @@ type Client interface {
 	NewClusterWorkflowTemplateServiceClient() (clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient, error)
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
 	NewSyncServiceClient(ctx context.Context) (syncpkg.SyncServiceClient, error)
+	NewArtifactLineageServiceClient() (artifactlineagepkg.ArtifactLineageServiceClient, error)
+	NewAPITokenServiceClient() (apitokenpkg.APITokenServiceClient, error)
 }
 
 type Opts struct {
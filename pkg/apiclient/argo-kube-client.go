@@ -12,6 +12,8 @@ import (
 
 	"github.com/argoproj/argo-workflows/v4"
 	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/info"
@@ -112,7 +114,7 @@ func newArgoKubeClient(ctx context.Context, opts ArgoKubeOpts, clientConfig clie
 		Kubernetes: kubeClient,
 		Workflow:   wfClient,
 	}
-	gatekeeper, err := auth.NewGatekeeper(auth.Modes{auth.Server: true}, clients, restConfig, nil, auth.DefaultClientForAuthorization, "unused", "unused", false, nil)
+	gatekeeper, err := auth.NewGatekeeper(auth.Modes{auth.Server: true}, clients, restConfig, nil, auth.DefaultClientForAuthorization, "unused", "unused", false, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -200,6 +202,14 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 	return nil, ErrNoArgoServer
 }
 
+func (a *argoKubeClient) NewArtifactLineageServiceClient() (artifactlineagepkg.ArtifactLineageServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
+func (a *argoKubeClient) NewAPITokenServiceClient() (apitokenpkg.APITokenServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
 	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService, a.cwfTmplStore, nil)}}, nil
 }
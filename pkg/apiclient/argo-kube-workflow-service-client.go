@@ -80,6 +80,18 @@ func (c *argoKubeWorkflowServiceClient) StopWorkflow(ctx context.Context, req *w
 	return c.delegate.StopWorkflow(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	return c.delegate.ApproveWorkflow(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	return c.delegate.RejectWorkflow(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) CallbackWorkflow(ctx context.Context, req *workflowpkg.WorkflowCallbackRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCallbackResponse, error) {
+	return c.delegate.CallbackWorkflow(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) SetWorkflow(ctx context.Context, req *workflowpkg.WorkflowSetRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SetWorkflow(ctx, req)
 }
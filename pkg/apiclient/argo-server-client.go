@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/info"
@@ -67,6 +69,14 @@ func (a *argoServerClient) NewSyncServiceClient(_ context.Context) (syncpkg.Sync
 	return syncpkg.NewSyncServiceClient(a.ClientConn), nil
 }
 
+func (a *argoServerClient) NewArtifactLineageServiceClient() (artifactlineagepkg.ArtifactLineageServiceClient, error) {
+	return artifactlineagepkg.NewArtifactLineageServiceClient(a.ClientConn), nil
+}
+
+func (a *argoServerClient) NewAPITokenServiceClient() (apitokenpkg.APITokenServiceClient, error) {
+	return apitokenpkg.NewAPITokenServiceClient(a.ClientConn), nil
+}
+
 func newClientConn(opts ArgoServerOpts) (*grpc.ClientConn, error) {
 	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
 	if opts.Secure {
@@ -66,6 +66,21 @@ func (c *errorTranslatingWorkflowServiceClient) SuspendWorkflow(ctx context.Cont
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	workflow, err := c.delegate.ApproveWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	workflow, err := c.delegate.RejectWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) CallbackWorkflow(ctx context.Context, req *workflowpkg.WorkflowCallbackRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCallbackResponse, error) {
+	response, err := c.delegate.CallbackWorkflow(ctx, req)
+	return response, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) SetWorkflow(ctx context.Context, req *workflowpkg.WorkflowSetRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	workflow, err := c.delegate.SetWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"net/url"
 
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/http1"
@@ -47,6 +49,14 @@ func (h httpClient) NewSyncServiceClient(_ context.Context) (syncpkg.SyncService
 	return http1.SyncServiceClient(h), nil
 }
 
+func (h httpClient) NewArtifactLineageServiceClient() (artifactlineagepkg.ArtifactLineageServiceClient, error) {
+	return http1.ArtifactLineageServiceClient(h), nil
+}
+
+func (h httpClient) NewAPITokenServiceClient() (apitokenpkg.APITokenServiceClient, error) {
+	return http1.APITokenServiceClient(h), nil
+}
+
 func newHTTP1Client(ctx context.Context, opts ArgoServerOpts, auth string, proxy func(*http.Request) (*url.URL, error)) (context.Context, Client, error) {
 	facade, err := http1.NewFacade(http1.FacadeConfig{
 		BaseURL:            opts.GetURL(),
@@ -0,0 +1,26 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+)
+
+type APITokenServiceClient = Facade
+
+func (h APITokenServiceClient) CreateAPIToken(ctx context.Context, in *apitokenpkg.CreateAPITokenRequest, _ ...grpc.CallOption) (*apitokenpkg.CreateAPITokenResponse, error) {
+	out := &apitokenpkg.CreateAPITokenResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/api-tokens/{namespace}")
+}
+
+func (h APITokenServiceClient) ListAPITokens(ctx context.Context, in *apitokenpkg.ListAPITokensRequest, _ ...grpc.CallOption) (*apitokenpkg.APITokenList, error) {
+	out := &apitokenpkg.APITokenList{}
+	return out, h.Get(ctx, in, out, "/api/v1/api-tokens/{namespace}")
+}
+
+func (h APITokenServiceClient) RevokeAPIToken(ctx context.Context, in *apitokenpkg.RevokeAPITokenRequest, _ ...grpc.CallOption) (*apitokenpkg.RevokeAPITokenResponse, error) {
+	out := &apitokenpkg.RevokeAPITokenResponse{}
+	return out, h.Delete(ctx, in, out, "/api/v1/api-tokens/{namespace}/{id}")
+}
@@ -47,6 +47,11 @@ func (h ArchivedWorkflowsServiceClient) ListArchivedWorkflowLabelValues(ctx cont
 	return out, h.Get(ctx, in, out, "/api/v1/archived-workflows-label-values")
 }
 
+func (h ArchivedWorkflowsServiceClient) GetArchivedWorkflowStats(ctx context.Context, in *workflowarchivepkg.ArchivedWorkflowStatsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowStats, error) {
+	out := &workflowarchivepkg.ArchivedWorkflowStats{}
+	return out, h.Get(ctx, in, out, "/api/v1/archived-workflows-stats")
+}
+
 func (h ArchivedWorkflowsServiceClient) RetryArchivedWorkflow(ctx context.Context, in *workflowarchivepkg.RetryArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(ctx, in, out, "/api/v1/archived-workflows/{uid}/retry")
@@ -56,3 +61,26 @@ func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(ctx context.Con
 	out := &wfv1.Workflow{}
 	return out, h.Put(ctx, in, out, "/api/v1/archived-workflows/{uid}/resubmit")
 }
+
+func (h ArchivedWorkflowsServiceClient) ExportArchivedWorkflows(ctx context.Context, in *workflowarchivepkg.ExportArchivedWorkflowsRequest, _ ...grpc.CallOption) (workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsClient, error) {
+	reader, err := h.EventStreamReader(ctx, in, "/api/v1/archived-workflows-export")
+	if err != nil {
+		return nil, err
+	}
+	return exportArchivedWorkflowsClient{serverSentEventsClient{ctx, reader}}, nil
+}
+
+func (h ArchivedWorkflowsServiceClient) ExportArchivedWorkflowsToArtifactRepository(ctx context.Context, in *workflowarchivepkg.ExportArchivedWorkflowsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ExportArchivedWorkflowsResponse, error) {
+	out := &workflowarchivepkg.ExportArchivedWorkflowsResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/archived-workflows-export/artifact-repository")
+}
+
+func (h ArchivedWorkflowsServiceClient) ImportArchivedWorkflows(ctx context.Context, in *workflowarchivepkg.ImportArchivedWorkflowsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	out := &workflowarchivepkg.ImportArchivedWorkflowsResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/archived-workflows-import")
+}
+
+func (h ArchivedWorkflowsServiceClient) ImportArchivedWorkflowsFromArtifactRepository(ctx context.Context, in *workflowarchivepkg.ImportArchivedWorkflowsFromArtifactRepositoryRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	out := &workflowarchivepkg.ImportArchivedWorkflowsResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/archived-workflows-import/artifact-repository")
+}
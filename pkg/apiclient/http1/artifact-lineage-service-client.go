@@ -0,0 +1,21 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
+)
+
+type ArtifactLineageServiceClient = Facade
+
+func (h ArtifactLineageServiceClient) SearchArtifacts(ctx context.Context, in *artifactlineagepkg.SearchArtifactsRequest, _ ...grpc.CallOption) (*artifactlineagepkg.SearchArtifactsResponse, error) {
+	out := &artifactlineagepkg.SearchArtifactsResponse{}
+	return out, h.Get(ctx, in, out, "/api/v1/artifact-lineage")
+}
+
+func (h ArtifactLineageServiceClient) GetArtifactLineage(ctx context.Context, in *artifactlineagepkg.GetArtifactLineageRequest, _ ...grpc.CallOption) (*artifactlineagepkg.ArtifactLineageGraph, error) {
+	out := &artifactlineagepkg.ArtifactLineageGraph{}
+	return out, h.Get(ctx, in, out, "/api/v1/artifact-lineage/graph")
+}
@@ -85,6 +85,11 @@ func (h Facade) Delete(ctx context.Context, in, out any, path string) error {
 	return h.do(ctx, in, out, "DELETE", path)
 }
 
+// PostBody is Post for methods whose HTTP body is one field of the request, in is only used for the path parameters
+func (h Facade) PostBody(ctx context.Context, in, body, out any, path string) error {
+	return h.doWithBody(ctx, in, body, out, "POST", path)
+}
+
 func (h Facade) EventStreamReader(ctx context.Context, in any, path string) (*bufio.Reader, error) {
 	log := logging.RequireLoggerFromContext(ctx)
 	method := "GET"
@@ -121,11 +126,15 @@ func (h Facade) EventStreamReader(ctx context.Context, in any, path string) (*bu
 }
 
 func (h Facade) do(ctx context.Context, in any, out any, method string, path string) error {
+	return h.doWithBody(ctx, in, in, out, method, path)
+}
+
+func (h Facade) doWithBody(ctx context.Context, in, body, out any, method string, path string) error {
 	log := logging.RequireLoggerFromContext(ctx)
 	var data []byte
 	if method != "GET" && method != "DELETE" {
 		var err error
-		data, err = json.Marshal(in)
+		data, err = json.Marshal(body)
 		if err != nil {
 			return err
 		}
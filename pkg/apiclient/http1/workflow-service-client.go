@@ -77,6 +77,21 @@ func (h WorkflowServiceClient) StopWorkflow(ctx context.Context, in *workflowpkg
 	return out, h.Put(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/stop")
 }
 
+func (h WorkflowServiceClient) ApproveWorkflow(ctx context.Context, in *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/approve")
+}
+
+func (h WorkflowServiceClient) RejectWorkflow(ctx context.Context, in *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/reject")
+}
+
+func (h WorkflowServiceClient) CallbackWorkflow(ctx context.Context, in *workflowpkg.WorkflowCallbackRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCallbackResponse, error) {
+	out := &workflowpkg.WorkflowCallbackResponse{}
+	return out, h.PostBody(ctx, in, in.Payload, out, "/api/v1/workflows/{namespace}/{name}/callback/{nodeId}")
+}
+
 func (h WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflowpkg.WorkflowSetRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/set")
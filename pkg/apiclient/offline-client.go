@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/info"
@@ -139,6 +141,14 @@ func (c *offlineClient) NewSyncServiceClient(_ context.Context) (syncpkg.SyncSer
 	return nil, ErrNoArgoServer
 }
 
+func (c *offlineClient) NewArtifactLineageServiceClient() (artifactlineagepkg.ArtifactLineageServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
+func (c *offlineClient) NewAPITokenServiceClient() (apitokenpkg.APITokenServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
 type offlineWorkflowTemplateNamespacedGetter struct {
 	namespace         string
 	workflowTemplates map[string]*wfv1.WorkflowTemplate
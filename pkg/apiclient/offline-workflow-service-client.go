@@ -70,6 +70,18 @@ func (o OfflineWorkflowServiceClient) SetWorkflow(context.Context, *workflowpkg.
 	return nil, ErrOffline
 }
 
+func (o OfflineWorkflowServiceClient) ApproveWorkflow(context.Context, *workflowpkg.WorkflowApproveRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) RejectWorkflow(context.Context, *workflowpkg.WorkflowRejectRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) CallbackWorkflow(context.Context, *workflowpkg.WorkflowCallbackRequest, ...grpc.CallOption) (*workflowpkg.WorkflowCallbackResponse, error) {
+	return nil, ErrOffline
+}
+
 func (o OfflineWorkflowServiceClient) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	err := validate.Workflow(ctx, o.namespacedWorkflowTemplateGetterMap.GetNamespaceGetter(req.Namespace), o.clusterWorkflowTemplateGetter, req.Workflow, nil, validate.Opts{Lint: true})
 	if err != nil {
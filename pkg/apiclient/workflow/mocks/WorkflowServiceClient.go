@@ -40,6 +40,180 @@ func (_m *WorkflowServiceClient) EXPECT() *WorkflowServiceClient_Expecter {
 	return &WorkflowServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// ApproveWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ApproveWorkflow(ctx context.Context, in *workflow.WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveWorkflow")
+	}
+
+	var r0 *v1alpha1.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowApproveRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowApproveRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowApproveRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_ApproveWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveWorkflow'
+type WorkflowServiceClient_ApproveWorkflow_Call struct {
+	*mock.Call
+}
+
+// ApproveWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowApproveRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) ApproveWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ApproveWorkflow_Call {
+	return &WorkflowServiceClient_ApproveWorkflow_Call{Call: _e.mock.On("ApproveWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_ApproveWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowApproveRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ApproveWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowApproveRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowApproveRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ApproveWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_ApproveWorkflow_Call {
+	_c.Call.Return(workflow1, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ApproveWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_ApproveWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CallbackWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) CallbackWorkflow(ctx context.Context, in *workflow.WorkflowCallbackRequest, opts ...grpc.CallOption) (*workflow.WorkflowCallbackResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallbackWorkflow")
+	}
+
+	var r0 *workflow.WorkflowCallbackResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowCallbackRequest, ...grpc.CallOption) (*workflow.WorkflowCallbackResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowCallbackRequest, ...grpc.CallOption) *workflow.WorkflowCallbackResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowCallbackResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowCallbackRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_CallbackWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallbackWorkflow'
+type WorkflowServiceClient_CallbackWorkflow_Call struct {
+	*mock.Call
+}
+
+// CallbackWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowCallbackRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) CallbackWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_CallbackWorkflow_Call {
+	return &WorkflowServiceClient_CallbackWorkflow_Call{Call: _e.mock.On("CallbackWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_CallbackWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowCallbackRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_CallbackWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowCallbackRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowCallbackRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_CallbackWorkflow_Call) Return(workflowCallbackResponse *workflow.WorkflowCallbackResponse, err error) *WorkflowServiceClient_CallbackWorkflow_Call {
+	_c.Call.Return(workflowCallbackResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_CallbackWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowCallbackRequest, opts ...grpc.CallOption) (*workflow.WorkflowCallbackResponse, error)) *WorkflowServiceClient_CallbackWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorkflow provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) CreateWorkflow(ctx context.Context, in *workflow.WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
@@ -562,6 +736,93 @@ func (_c *WorkflowServiceClient_PodLogs_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// RejectWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) RejectWorkflow(ctx context.Context, in *workflow.WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectWorkflow")
+	}
+
+	var r0 *v1alpha1.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRejectRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRejectRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowRejectRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_RejectWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectWorkflow'
+type WorkflowServiceClient_RejectWorkflow_Call struct {
+	*mock.Call
+}
+
+// RejectWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowRejectRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) RejectWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_RejectWorkflow_Call {
+	return &WorkflowServiceClient_RejectWorkflow_Call{Call: _e.mock.On("RejectWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_RejectWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowRejectRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_RejectWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowRejectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowRejectRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_RejectWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_RejectWorkflow_Call {
+	_c.Call.Return(workflow1, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_RejectWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_RejectWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResubmitWorkflow provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) ResubmitWorkflow(ctx context.Context, in *workflow.WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
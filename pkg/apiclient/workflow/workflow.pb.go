@@ -711,6 +711,269 @@ func (m *WorkflowSetRequest) GetOutputParameters() string {
 	return ""
 }
 
+type WorkflowApproveRequest struct {
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector string `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	// parameters are the output parameters of the approval gate to supply
+	Parameters           map[string]string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Message              string            `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WorkflowApproveRequest) Reset()         { *m = WorkflowApproveRequest{} }
+func (m *WorkflowApproveRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowApproveRequest) ProtoMessage()    {}
+func (*WorkflowApproveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{9}
+}
+func (m *WorkflowApproveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowApproveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowApproveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowApproveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowApproveRequest.Merge(m, src)
+}
+func (m *WorkflowApproveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowApproveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowApproveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowApproveRequest proto.InternalMessageInfo
+
+func (m *WorkflowApproveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *WorkflowApproveRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type WorkflowRejectRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowRejectRequest) Reset()         { *m = WorkflowRejectRequest{} }
+func (m *WorkflowRejectRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowRejectRequest) ProtoMessage()    {}
+func (*WorkflowRejectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{10}
+}
+func (m *WorkflowRejectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowRejectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowRejectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowRejectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowRejectRequest.Merge(m, src)
+}
+func (m *WorkflowRejectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowRejectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowRejectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowRejectRequest proto.InternalMessageInfo
+
+func (m *WorkflowRejectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type WorkflowCallbackRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// nodeId is the ID of the HTTP node waiting for the callback
+	NodeId string `protobuf:"bytes,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// The body of the callback can be any data.
+	Payload              *v1alpha1.Item `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WorkflowCallbackRequest) Reset()         { *m = WorkflowCallbackRequest{} }
+func (m *WorkflowCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCallbackRequest) ProtoMessage()    {}
+func (*WorkflowCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{11}
+}
+func (m *WorkflowCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCallbackRequest.Merge(m, src)
+}
+func (m *WorkflowCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCallbackRequest proto.InternalMessageInfo
+
+func (m *WorkflowCallbackRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowCallbackRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowCallbackRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *WorkflowCallbackRequest) GetPayload() *v1alpha1.Item {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type WorkflowCallbackResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowCallbackResponse) Reset()         { *m = WorkflowCallbackResponse{} }
+func (m *WorkflowCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCallbackResponse) ProtoMessage()    {}
+func (*WorkflowCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{12}
+}
+func (m *WorkflowCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCallbackResponse.Merge(m, src)
+}
+func (m *WorkflowCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCallbackResponse proto.InternalMessageInfo
+
 type WorkflowSuspendRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -723,7 +986,7 @@ func (m *WorkflowSuspendRequest) Reset()         { *m = WorkflowSuspendRequest{}
 func (m *WorkflowSuspendRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowSuspendRequest) ProtoMessage()    {}
 func (*WorkflowSuspendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{9}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{13}
 }
 func (m *WorkflowSuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +1045,7 @@ func (m *WorkflowLogRequest) Reset()         { *m = WorkflowLogRequest{} }
 func (m *WorkflowLogRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowLogRequest) ProtoMessage()    {}
 func (*WorkflowLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{10}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{14}
 }
 func (m *WorkflowLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,7 +1130,7 @@ func (m *WorkflowDeleteRequest) Reset()         { *m = WorkflowDeleteRequest{} }
 func (m *WorkflowDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDeleteRequest) ProtoMessage()    {}
 func (*WorkflowDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{11}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{15}
 }
 func (m *WorkflowDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -934,7 +1197,7 @@ func (m *WorkflowDeleteResponse) Reset()         { *m = WorkflowDeleteResponse{}
 func (m *WorkflowDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDeleteResponse) ProtoMessage()    {}
 func (*WorkflowDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{12}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{16}
 }
 func (m *WorkflowDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,7 +1239,7 @@ func (m *WatchWorkflowsRequest) Reset()         { *m = WatchWorkflowsRequest{} }
 func (m *WatchWorkflowsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchWorkflowsRequest) ProtoMessage()    {}
 func (*WatchWorkflowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{13}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{17}
 }
 func (m *WatchWorkflowsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1303,7 @@ func (m *WorkflowWatchEvent) Reset()         { *m = WorkflowWatchEvent{} }
 func (m *WorkflowWatchEvent) String() string { return proto.CompactTextString(m) }
 func (*WorkflowWatchEvent) ProtoMessage()    {}
 func (*WorkflowWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{14}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{18}
 }
 func (m *WorkflowWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1358,7 @@ func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
 func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchEventsRequest) ProtoMessage()    {}
 func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{15}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{19}
 }
 func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1150,7 +1413,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{16}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{20}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,7 +1468,7 @@ func (m *WorkflowLintRequest) Reset()         { *m = WorkflowLintRequest{} }
 func (m *WorkflowLintRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowLintRequest) ProtoMessage()    {}
 func (*WorkflowLintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{17}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{21}
 }
 func (m *WorkflowLintRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,7 +1525,7 @@ func (m *WorkflowSubmitRequest) Reset()         { *m = WorkflowSubmitRequest{} }
 func (m *WorkflowSubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowSubmitRequest) ProtoMessage()    {}
 func (*WorkflowSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{18}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{22}
 }
 func (m *WorkflowSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,6 +1592,11 @@ func init() {
 	proto.RegisterType((*WorkflowTerminateRequest)(nil), "workflow.WorkflowTerminateRequest")
 	proto.RegisterType((*WorkflowStopRequest)(nil), "workflow.WorkflowStopRequest")
 	proto.RegisterType((*WorkflowSetRequest)(nil), "workflow.WorkflowSetRequest")
+	proto.RegisterType((*WorkflowApproveRequest)(nil), "workflow.WorkflowApproveRequest")
+	proto.RegisterMapType((map[string]string)(nil), "workflow.WorkflowApproveRequest.ParametersEntry")
+	proto.RegisterType((*WorkflowRejectRequest)(nil), "workflow.WorkflowRejectRequest")
+	proto.RegisterType((*WorkflowCallbackRequest)(nil), "workflow.WorkflowCallbackRequest")
+	proto.RegisterType((*WorkflowCallbackResponse)(nil), "workflow.WorkflowCallbackResponse")
 	proto.RegisterType((*WorkflowSuspendRequest)(nil), "workflow.WorkflowSuspendRequest")
 	proto.RegisterType((*WorkflowLogRequest)(nil), "workflow.WorkflowLogRequest")
 	proto.RegisterType((*WorkflowDeleteRequest)(nil), "workflow.WorkflowDeleteRequest")
@@ -1346,101 +1614,114 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x8f, 0x14, 0x45,
-	0x1b, 0xc7, 0x53, 0xb3, 0xb0, 0xec, 0xd6, 0xfe, 0x00, 0xea, 0x05, 0xde, 0x79, 0x3b, 0xb0, 0x2c,
-	0xc5, 0x0b, 0x2e, 0x0b, 0xdb, 0xb3, 0xbf, 0x54, 0x34, 0x6a, 0x02, 0x2c, 0x10, 0x71, 0x83, 0x9b,
-	0x1e, 0x13, 0xa3, 0x17, 0xd3, 0xdb, 0xf3, 0x4c, 0x6f, 0xb3, 0x3d, 0x5d, 0x6d, 0x55, 0xcd, 0x90,
-	0x15, 0x31, 0xd1, 0x8b, 0x1e, 0x48, 0x3c, 0x78, 0xf4, 0x66, 0x62, 0xf4, 0x60, 0x34, 0x31, 0x31,
-	0x31, 0x9a, 0x18, 0x63, 0x3c, 0x78, 0x24, 0xe1, 0xea, 0xc1, 0x10, 0xff, 0x01, 0xff, 0x03, 0x53,
-	0xd5, 0xbf, 0x77, 0x86, 0xa1, 0xd9, 0x1d, 0x94, 0x5b, 0x57, 0x75, 0x75, 0x3d, 0x9f, 0xe7, 0x5b,
-	0x55, 0xcf, 0xf3, 0xd4, 0x0c, 0x3e, 0x15, 0x6e, 0xba, 0x35, 0x3b, 0xf4, 0x1c, 0xdf, 0x83, 0x40,
-	0xd6, 0x6e, 0x32, 0xbe, 0xd9, 0xf4, 0xd9, 0xcd, 0xf4, 0xc1, 0x0c, 0x39, 0x93, 0x8c, 0x8c, 0x24,
-	0x6d, 0x63, 0xcd, 0xf5, 0xe4, 0x46, 0x7b, 0xdd, 0x74, 0x58, 0xab, 0x66, 0x73, 0x97, 0x85, 0x9c,
-	0xdd, 0xd0, 0x0f, 0x73, 0xc9, 0x10, 0x51, 0xeb, 0x2c, 0xd7, 0xe2, 0x69, 0x45, 0x36, 0x63, 0x67,
-	0xc1, 0xf6, 0xc3, 0x0d, 0x7b, 0xa1, 0xe6, 0x42, 0x00, 0xdc, 0x96, 0xd0, 0x88, 0xe6, 0x36, 0x8e,
-	0xba, 0x8c, 0xb9, 0x3e, 0xa8, 0xe1, 0x35, 0x3b, 0x08, 0x98, 0xb4, 0xa5, 0xc7, 0x02, 0x11, 0xbf,
-	0xa5, 0x9b, 0xe7, 0x85, 0xe9, 0x31, 0xfd, 0xd6, 0x61, 0x1c, 0x6a, 0x9d, 0xee, 0x19, 0x96, 0xb3,
-	0x31, 0x2d, 0xdb, 0xd9, 0xf0, 0x02, 0xe0, 0x5b, 0x19, 0x41, 0x0b, 0xa4, 0xdd, 0xe3, 0x2b, 0xfa,
-	0x73, 0x05, 0x1f, 0x7e, 0x3d, 0xa6, 0xbb, 0xc4, 0xc1, 0x96, 0x60, 0xc1, 0xdb, 0x6d, 0x10, 0x92,
-	0x1c, 0xc5, 0xa3, 0x81, 0xdd, 0x02, 0x11, 0xda, 0x0e, 0x54, 0xd1, 0x34, 0x9a, 0x19, 0xb5, 0xb2,
-	0x0e, 0xd2, 0xc4, 0xa9, 0x1a, 0xd5, 0xca, 0x34, 0x9a, 0x19, 0x5b, 0xbc, 0x66, 0x66, 0xa2, 0x98,
-	0x89, 0x28, 0xfa, 0xe1, 0xad, 0x54, 0x14, 0xb3, 0xb3, 0x6c, 0x86, 0x9b, 0xae, 0xa9, 0x90, 0xcc,
-	0x54, 0xdd, 0x44, 0x14, 0x33, 0x01, 0xb1, 0xd2, 0xb9, 0x09, 0xc5, 0xd8, 0x0b, 0x84, 0xb4, 0x03,
-	0x07, 0x5e, 0x5e, 0xa9, 0x0e, 0x29, 0x8c, 0x8b, 0x95, 0x2a, 0xb2, 0x72, 0xbd, 0x84, 0xe2, 0x71,
-	0x01, 0xbc, 0x03, 0x7c, 0x85, 0x6f, 0x59, 0xed, 0xa0, 0xba, 0x67, 0x1a, 0xcd, 0x8c, 0x58, 0x85,
-	0x3e, 0xf2, 0x06, 0x9e, 0x70, 0xb4, 0x7b, 0xaf, 0x86, 0x5a, 0xd8, 0xea, 0x5e, 0x0d, 0xbd, 0x64,
-	0x46, 0xaa, 0x99, 0x79, 0xd5, 0x32, 0x44, 0xa5, 0x9a, 0xd9, 0x59, 0x30, 0x2f, 0xe5, 0x3f, 0xb5,
-	0x8a, 0x33, 0xd1, 0x5f, 0x10, 0x26, 0x09, 0xf9, 0x55, 0x90, 0x89, 0x7e, 0x04, 0xef, 0x51, 0x72,
-	0xc5, 0xd2, 0xe9, 0xe7, 0xa2, 0xa6, 0x95, 0xed, 0x9a, 0xae, 0x61, 0xec, 0x82, 0x4c, 0x00, 0x87,
-	0x34, 0xe0, 0x7c, 0x39, 0xc0, 0xab, 0xe9, 0x77, 0x56, 0x6e, 0x0e, 0x72, 0x04, 0x0f, 0x37, 0x3d,
-	0xf0, 0x1b, 0x42, 0x6b, 0x32, 0x6a, 0xc5, 0x2d, 0x72, 0x00, 0x0f, 0xb5, 0xbd, 0x86, 0xd6, 0x60,
-	0xd4, 0x52, 0x8f, 0xf4, 0x4e, 0x05, 0xff, 0x27, 0x71, 0x62, 0xd5, 0x13, 0xb2, 0xdc, 0x2e, 0xa8,
-	0xe3, 0x31, 0xdf, 0x13, 0x29, 0x72, 0xb4, 0x11, 0x16, 0xca, 0x21, 0xaf, 0x66, 0x1f, 0x5a, 0xf9,
-	0x59, 0x72, 0xd0, 0x43, 0x05, 0xe8, 0x29, 0x8c, 0x95, 0xe5, 0x2b, 0x9e, 0x2f, 0x81, 0xc7, 0x0e,
-	0xe5, 0x7a, 0xd4, 0x36, 0x88, 0x16, 0xa6, 0x71, 0xa1, 0xa9, 0x46, 0x44, 0xde, 0x15, 0xfa, 0xc8,
-	0x69, 0x3c, 0xd9, 0xf4, 0x02, 0x4f, 0x6c, 0x40, 0xe3, 0x22, 0x34, 0x19, 0x87, 0xea, 0xb0, 0x1e,
-	0xb5, 0xad, 0x97, 0x7e, 0x88, 0xf0, 0x7f, 0xd3, 0xdd, 0x08, 0xa2, 0xbd, 0xde, 0xf2, 0x76, 0xb1,
-	0xb0, 0x06, 0x1e, 0x69, 0x41, 0x8b, 0x79, 0xef, 0x40, 0x43, 0xfb, 0x34, 0x62, 0xa5, 0x6d, 0xe5,
-	0x55, 0x68, 0x73, 0xbb, 0x05, 0x12, 0xb8, 0xda, 0x95, 0x43, 0xca, 0xab, 0xac, 0x87, 0xfe, 0x8a,
-	0xf0, 0xa1, 0x8c, 0x44, 0xf2, 0xad, 0x9d, 0x63, 0x9c, 0xc3, 0x07, 0x39, 0x08, 0x69, 0x73, 0x59,
-	0x6f, 0x3b, 0x0e, 0x08, 0xd1, 0x6c, 0xfb, 0x31, 0x4f, 0xf7, 0x0b, 0x35, 0x3a, 0x60, 0x0d, 0xb8,
-	0xa2, 0xc4, 0xaf, 0x83, 0x0f, 0x8e, 0x64, 0x89, 0xea, 0xdd, 0x2f, 0x1e, 0xea, 0xc6, 0xcd, 0x2c,
-	0xcc, 0x28, 0x3d, 0x5b, 0xb0, 0x2b, 0x37, 0xba, 0xc1, 0x86, 0x1e, 0x00, 0x46, 0x57, 0x71, 0x35,
-	0x31, 0xfc, 0x1a, 0xf0, 0x96, 0x17, 0xe4, 0x42, 0xdc, 0x23, 0xdb, 0xa6, 0x1f, 0xa3, 0xec, 0x98,
-	0xd4, 0x25, 0x0b, 0xff, 0x21, 0x2f, 0x48, 0x15, 0xef, 0x6b, 0x81, 0x10, 0xb6, 0x0b, 0xf1, 0x12,
-	0x24, 0x4d, 0x7a, 0x37, 0x17, 0x7d, 0xea, 0xbb, 0x89, 0x3e, 0x03, 0x02, 0x22, 0x87, 0xf0, 0xde,
-	0x70, 0xc3, 0x16, 0x10, 0x9f, 0xbf, 0xa8, 0x41, 0x66, 0xf1, 0x01, 0xd6, 0x96, 0x61, 0x5b, 0xae,
-	0x65, 0xbb, 0x24, 0x3a, 0x7a, 0x5d, 0xfd, 0xf4, 0x1a, 0x3e, 0x92, 0x7a, 0xd4, 0x16, 0x21, 0x04,
-	0x8d, 0x9d, 0x2f, 0xd8, 0xbd, 0x9c, 0x3c, 0xab, 0xcc, 0xdd, 0xb9, 0x3c, 0x55, 0xbc, 0x2f, 0x64,
-	0x8d, 0xeb, 0xea, 0xa3, 0x48, 0x94, 0xa4, 0x49, 0x2e, 0x60, 0xec, 0x33, 0x37, 0x89, 0x81, 0x7b,
-	0x74, 0x0c, 0x3c, 0x91, 0x8b, 0x81, 0xa6, 0xca, 0xd8, 0x2a, 0xe2, 0xad, 0xb1, 0xc6, 0x6a, 0x3a,
-	0xd0, 0xca, 0x7d, 0xa4, 0x70, 0x5c, 0x0e, 0x61, 0x2c, 0x99, 0x7e, 0x56, 0x41, 0x43, 0x24, 0xcb,
-	0x10, 0x29, 0x95, 0xb6, 0xe9, 0x0f, 0x28, 0x3b, 0x4e, 0x2b, 0xe0, 0xc3, 0x2e, 0xb6, 0xb4, 0xca,
-	0x8c, 0x0d, 0x3d, 0x45, 0x31, 0xf1, 0x94, 0xcc, 0x8c, 0x2b, 0xf9, 0x4f, 0xad, 0xe2, 0x4c, 0x6a,
-	0x2b, 0x34, 0x19, 0x77, 0x20, 0xce, 0xc8, 0x51, 0x83, 0x56, 0xb3, 0xe5, 0x4d, 0xd8, 0x45, 0xc8,
-	0x02, 0x01, 0xf4, 0x33, 0xe5, 0x96, 0x2d, 0x9d, 0x8d, 0xe4, 0xbd, 0x78, 0xf2, 0xd2, 0x10, 0xbd,
-	0x93, 0xdb, 0x51, 0x1a, 0xf6, 0x72, 0x07, 0x02, 0x2d, 0xbc, 0xdc, 0x0a, 0x53, 0xe1, 0xd5, 0x33,
-	0x59, 0xc7, 0xc3, 0x6c, 0xfd, 0x06, 0x38, 0xf2, 0x31, 0x94, 0x48, 0xf1, 0xcc, 0x2a, 0x53, 0x91,
-	0x0c, 0xe3, 0x5f, 0x14, 0x8c, 0xbe, 0x84, 0x47, 0x56, 0x99, 0x7b, 0x39, 0x90, 0x7c, 0x4b, 0x9d,
-	0x16, 0x87, 0x05, 0x12, 0x02, 0x19, 0x1b, 0x4f, 0x9a, 0xf9, 0x73, 0x54, 0x29, 0x9c, 0x23, 0xfa,
-	0x29, 0xca, 0x97, 0x20, 0x81, 0x7c, 0xa2, 0x0a, 0x51, 0xfa, 0x57, 0xee, 0xc8, 0xd5, 0x0b, 0xf5,
-	0x40, 0x7f, 0x3e, 0x8a, 0xc7, 0x39, 0x08, 0xd6, 0xe6, 0x0e, 0xbc, 0xe2, 0x05, 0x8d, 0xd8, 0xe9,
-	0x42, 0x5f, 0x7e, 0x4c, 0x2e, 0xc0, 0x14, 0xfa, 0x08, 0xc7, 0x13, 0x51, 0x19, 0x52, 0x0c, 0x34,
-	0xab, 0xbb, 0x77, 0xb6, 0x9e, 0x4c, 0x2b, 0xac, 0xa2, 0x89, 0xc5, 0xdf, 0x0f, 0xe3, 0xfd, 0x59,
-	0x6e, 0xe1, 0x1d, 0xcf, 0x01, 0xf2, 0x05, 0xc2, 0x93, 0x51, 0x39, 0x9c, 0xbc, 0x21, 0xc7, 0xb3,
-	0x49, 0x7b, 0x5e, 0x25, 0x8c, 0x01, 0xae, 0x08, 0x9d, 0xf9, 0xe0, 0xde, 0x9f, 0x9f, 0x54, 0x28,
-	0x3d, 0xa6, 0x2f, 0x43, 0x9d, 0x85, 0x5a, 0x76, 0xe3, 0xba, 0x95, 0xaa, 0x7e, 0xfb, 0x79, 0x34,
-	0x4b, 0x3e, 0x47, 0x78, 0xec, 0x2a, 0xc8, 0x14, 0xf3, 0x68, 0x37, 0x66, 0x56, 0xae, 0x0f, 0x94,
-	0xf1, 0x9c, 0x66, 0x3c, 0x4d, 0xfe, 0xdf, 0x97, 0x31, 0x7a, 0xbe, 0xad, 0x38, 0x27, 0xd4, 0xa1,
-	0x4a, 0x83, 0x1e, 0x39, 0xd6, 0x4d, 0x9a, 0xab, 0xc9, 0x8d, 0xeb, 0x83, 0x43, 0x55, 0xd3, 0xd2,
-	0x53, 0x1a, 0xf7, 0x38, 0xe9, 0x2f, 0x29, 0x79, 0x0f, 0x4f, 0x16, 0x83, 0x73, 0x61, 0xe1, 0x7b,
-	0x85, 0x6d, 0xa3, 0x87, 0xe4, 0x59, 0xac, 0xa2, 0x67, 0xb5, 0xdd, 0x53, 0xe4, 0xe4, 0x76, 0xbb,
-	0x73, 0xa0, 0x63, 0x59, 0xde, 0xfa, 0x3c, 0x22, 0x02, 0x8f, 0xe5, 0x02, 0x5d, 0x61, 0x39, 0xbb,
-	0xe2, 0x9f, 0xf1, 0xbf, 0x5e, 0x09, 0x38, 0x32, 0x7b, 0x46, 0x9b, 0x3d, 0x49, 0x4e, 0x24, 0x66,
-	0x85, 0xe4, 0x60, 0xb7, 0x6a, 0x3d, 0x8d, 0xbe, 0x8f, 0xf0, 0x64, 0x94, 0xa5, 0xfa, 0x6d, 0xf7,
-	0x42, 0x0e, 0x36, 0xa6, 0x1f, 0x3c, 0x20, 0x4e, 0x74, 0xf1, 0x06, 0x99, 0x2d, 0xb7, 0x41, 0xbe,
-	0x45, 0x78, 0x42, 0x97, 0xfe, 0x29, 0xc2, 0x54, 0xb7, 0x85, 0xfc, 0xdd, 0x60, 0xa0, 0x9b, 0xf9,
-	0x69, 0xcd, 0x5a, 0x33, 0x66, 0xcb, 0xb0, 0xd6, 0xb8, 0xc2, 0x50, 0xa7, 0xef, 0x47, 0x84, 0x0f,
-	0x24, 0x37, 0xa7, 0x94, 0xfb, 0x44, 0x2f, 0xee, 0xc2, 0xed, 0x6a, 0xa0, 0xe8, 0xe7, 0x35, 0xfa,
-	0xa2, 0x31, 0x57, 0x12, 0x3d, 0x22, 0x51, 0xf4, 0xdf, 0x21, 0x3c, 0x19, 0xdd, 0x53, 0xfa, 0x2d,
-	0x7b, 0xe1, 0x26, 0x33, 0x50, 0xf2, 0x67, 0x34, 0xf9, 0xbc, 0x71, 0xb6, 0x34, 0x79, 0x0b, 0x14,
-	0xf7, 0xf7, 0x08, 0xef, 0x8f, 0x6b, 0xe6, 0x14, 0xbc, 0xc7, 0x76, 0x2c, 0x96, 0xd5, 0x03, 0x25,
-	0x7f, 0x56, 0x93, 0x2f, 0x18, 0xe7, 0x4a, 0x91, 0x8b, 0x08, 0x44, 0xa1, 0xff, 0x84, 0xf0, 0xc1,
-	0xf4, 0x86, 0x96, 0xc2, 0xd3, 0x6e, 0xf8, 0xed, 0xd7, 0xb8, 0x81, 0xe2, 0x3f, 0xa7, 0xf1, 0x97,
-	0x0c, 0xb3, 0x14, 0xbe, 0x4c, 0x50, 0x94, 0x03, 0xdf, 0x20, 0x3c, 0xae, 0xee, 0x84, 0x29, 0x7b,
-	0x8f, 0x30, 0x9e, 0xbb, 0x33, 0x0e, 0x14, 0x7b, 0x59, 0x63, 0x9b, 0xc6, 0x99, 0x72, 0xaa, 0x4b,
-	0x16, 0x2a, 0xe2, 0xaf, 0x10, 0x1e, 0xab, 0xf7, 0xcf, 0x90, 0xf5, 0xc7, 0x93, 0x21, 0x97, 0x34,
-	0xef, 0x9c, 0x31, 0x53, 0x8e, 0x17, 0xf4, 0xa1, 0xfc, 0x12, 0xe1, 0x71, 0x55, 0x18, 0xf6, 0x13,
-	0x38, 0x57, 0x38, 0x0e, 0x14, 0x78, 0x4e, 0x03, 0x3f, 0x45, 0x69, 0x7f, 0x60, 0xdf, 0x0b, 0x34,
-	0xea, 0xbb, 0x78, 0x5f, 0x74, 0xdb, 0x13, 0xbd, 0x44, 0xcd, 0x2e, 0xa2, 0x06, 0xc9, 0xde, 0x26,
-	0xc5, 0x33, 0x7d, 0x51, 0xdb, 0x5a, 0x26, 0x8b, 0xa5, 0xc4, 0xb9, 0x15, 0xd7, 0xcf, 0xb7, 0x6b,
-	0x3e, 0x73, 0x3f, 0xaa, 0xa0, 0x79, 0x44, 0x24, 0x1e, 0xcf, 0x99, 0xda, 0x09, 0xc2, 0xbc, 0x46,
-	0x98, 0x25, 0xe5, 0xd6, 0xc7, 0x67, 0xee, 0x3c, 0x22, 0x5f, 0x23, 0x3c, 0x59, 0x2f, 0xc6, 0xfb,
-	0xe3, 0xbd, 0x42, 0xcf, 0xe3, 0x8a, 0xf6, 0x35, 0xcd, 0x7c, 0x86, 0x3e, 0x24, 0xa9, 0xa6, 0x41,
-	0xfe, 0xe2, 0xb5, 0xdf, 0xee, 0x4f, 0xa1, 0xbb, 0xf7, 0xa7, 0xd0, 0x1f, 0xf7, 0xa7, 0xd0, 0x9b,
-	0x2f, 0x3c, 0xd2, 0x6f, 0xfa, 0xdb, 0xfe, 0x2a, 0x58, 0x1f, 0xd6, 0x3f, 0xa7, 0x2f, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x97, 0x0d, 0x89, 0x4a, 0x4b, 0x18, 0x00, 0x00,
+	// 1704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x5b, 0x6f, 0x1c, 0x35,
+	0x1b, 0xc7, 0xe5, 0x4d, 0x9b, 0x83, 0x73, 0x68, 0xea, 0xb7, 0xed, 0xbb, 0x1d, 0xb5, 0x69, 0xea,
+	0xd2, 0x92, 0xa6, 0xcd, 0x6c, 0x4e, 0x94, 0x52, 0x51, 0xa4, 0xb4, 0x69, 0xa3, 0x96, 0xa8, 0x44,
+	0xb3, 0x48, 0x08, 0x6e, 0x60, 0x32, 0xeb, 0xdd, 0x4c, 0x33, 0x3b, 0x1e, 0xc6, 0xde, 0xad, 0x42,
+	0x09, 0x12, 0xdc, 0xc0, 0x45, 0x25, 0x84, 0xb8, 0xe4, 0xae, 0x08, 0xc1, 0x05, 0x02, 0x09, 0x09,
+	0x09, 0x81, 0x84, 0x10, 0x02, 0x89, 0xcb, 0x4a, 0xfd, 0x02, 0xa8, 0xe2, 0x0b, 0x20, 0xf1, 0x01,
+	0x90, 0x3d, 0xe3, 0x19, 0x4f, 0x76, 0xbb, 0x1d, 0x92, 0x6d, 0xd3, 0x3b, 0xdb, 0xe3, 0xc3, 0xef,
+	0xf9, 0xfb, 0xf1, 0xe3, 0xc3, 0xc0, 0x93, 0xc1, 0x7a, 0xad, 0x64, 0x07, 0xae, 0xe3, 0xb9, 0xc4,
+	0xe7, 0xa5, 0x5b, 0x34, 0x5c, 0xaf, 0x7a, 0xf4, 0x56, 0x92, 0x30, 0x83, 0x90, 0x72, 0x8a, 0xfa,
+	0x55, 0xde, 0x58, 0xa9, 0xb9, 0x7c, 0xad, 0xb1, 0x6a, 0x3a, 0xb4, 0x5e, 0xb2, 0xc3, 0x1a, 0x0d,
+	0x42, 0x7a, 0x53, 0x26, 0xa6, 0x54, 0x15, 0x56, 0x6a, 0xce, 0x97, 0xe2, 0x6e, 0x59, 0xda, 0x63,
+	0x73, 0xc6, 0xf6, 0x82, 0x35, 0x7b, 0xa6, 0x54, 0x23, 0x3e, 0x09, 0x6d, 0x4e, 0x2a, 0x51, 0xdf,
+	0xc6, 0x91, 0x1a, 0xa5, 0x35, 0x8f, 0x88, 0xea, 0x25, 0xdb, 0xf7, 0x29, 0xb7, 0xb9, 0x4b, 0x7d,
+	0x16, 0x7f, 0xc5, 0xeb, 0xe7, 0x99, 0xe9, 0x52, 0xf9, 0xd5, 0xa1, 0x21, 0x29, 0x35, 0x5b, 0x7b,
+	0x98, 0x4f, 0xeb, 0xd4, 0x6d, 0x67, 0xcd, 0xf5, 0x49, 0xb8, 0x91, 0x12, 0xd4, 0x09, 0xb7, 0xdb,
+	0xb4, 0xc2, 0xbf, 0x14, 0xe0, 0xc1, 0xd7, 0x62, 0xba, 0xcb, 0x21, 0xb1, 0x39, 0xb1, 0xc8, 0xdb,
+	0x0d, 0xc2, 0x38, 0x3a, 0x02, 0x07, 0x7c, 0xbb, 0x4e, 0x58, 0x60, 0x3b, 0xa4, 0x08, 0xc6, 0xc1,
+	0xc4, 0x80, 0x95, 0x16, 0xa0, 0x2a, 0x4c, 0xd4, 0x28, 0x16, 0xc6, 0xc1, 0xc4, 0xe0, 0xec, 0x75,
+	0x33, 0x15, 0xc5, 0x54, 0xa2, 0xc8, 0xc4, 0x9b, 0x89, 0x28, 0x66, 0x73, 0xde, 0x0c, 0xd6, 0x6b,
+	0xa6, 0x40, 0x32, 0x13, 0x75, 0x95, 0x28, 0xa6, 0x02, 0xb1, 0x92, 0xbe, 0x11, 0x86, 0xd0, 0xf5,
+	0x19, 0xb7, 0x7d, 0x87, 0x5c, 0x5b, 0x2c, 0xf6, 0x08, 0x8c, 0x4b, 0x85, 0x22, 0xb0, 0xb4, 0x52,
+	0x84, 0xe1, 0x10, 0x23, 0x61, 0x93, 0x84, 0x8b, 0xe1, 0x86, 0xd5, 0xf0, 0x8b, 0x7b, 0xc6, 0xc1,
+	0x44, 0xbf, 0x95, 0x29, 0x43, 0xaf, 0xc3, 0x61, 0x47, 0x9a, 0xf7, 0x4a, 0x20, 0x85, 0x2d, 0xee,
+	0x95, 0xd0, 0x73, 0x66, 0xa4, 0x9a, 0xa9, 0xab, 0x96, 0x22, 0x0a, 0xd5, 0xcc, 0xe6, 0x8c, 0x79,
+	0x59, 0x6f, 0x6a, 0x65, 0x7b, 0xc2, 0xbf, 0x02, 0x88, 0x14, 0xf9, 0x12, 0xe1, 0x4a, 0x3f, 0x04,
+	0xf7, 0x08, 0xb9, 0x62, 0xe9, 0x64, 0x3a, 0xab, 0x69, 0x61, 0xab, 0xa6, 0x2b, 0x10, 0xd6, 0x08,
+	0x57, 0x80, 0x3d, 0x12, 0x70, 0x3a, 0x1f, 0xe0, 0x52, 0xd2, 0xce, 0xd2, 0xfa, 0x40, 0x87, 0x60,
+	0x6f, 0xd5, 0x25, 0x5e, 0x85, 0x49, 0x4d, 0x06, 0xac, 0x38, 0x87, 0x46, 0x61, 0x4f, 0xc3, 0xad,
+	0x48, 0x0d, 0x06, 0x2c, 0x91, 0xc4, 0x77, 0x0a, 0xf0, 0x7f, 0xca, 0x88, 0x65, 0x97, 0xf1, 0x7c,
+	0x5e, 0x50, 0x86, 0x83, 0x9e, 0xcb, 0x12, 0xe4, 0xc8, 0x11, 0x66, 0xf2, 0x21, 0x2f, 0xa7, 0x0d,
+	0x2d, 0xbd, 0x17, 0x0d, 0xba, 0x27, 0x03, 0x3d, 0x06, 0xa1, 0x18, 0xf9, 0xaa, 0xeb, 0x71, 0x12,
+	0xc6, 0x06, 0x69, 0x25, 0xc2, 0x0d, 0xa2, 0x89, 0xa9, 0x2c, 0x54, 0x45, 0x8d, 0xc8, 0xba, 0x4c,
+	0x19, 0x3a, 0x05, 0x47, 0xaa, 0xae, 0xef, 0xb2, 0x35, 0x52, 0xb9, 0x44, 0xaa, 0x34, 0x24, 0xc5,
+	0x5e, 0x59, 0x6b, 0x4b, 0x29, 0xfe, 0x10, 0xc0, 0xff, 0x27, 0xde, 0x48, 0x58, 0x63, 0xb5, 0xee,
+	0xee, 0x60, 0x62, 0x0d, 0xd8, 0x5f, 0x27, 0x75, 0xea, 0xbe, 0x43, 0x2a, 0xd2, 0xa6, 0x7e, 0x2b,
+	0xc9, 0x0b, 0xab, 0x02, 0x3b, 0xb4, 0xeb, 0x84, 0x93, 0x50, 0x78, 0x65, 0x8f, 0xb0, 0x2a, 0x2d,
+	0xc1, 0xbf, 0x01, 0x78, 0x20, 0x25, 0xe1, 0xe1, 0xc6, 0xf6, 0x31, 0xce, 0xc2, 0xfd, 0x21, 0x61,
+	0xdc, 0x0e, 0x79, 0xb9, 0xe1, 0x38, 0x84, 0xb1, 0x6a, 0xc3, 0x8b, 0x79, 0x5a, 0x3f, 0x88, 0xda,
+	0x3e, 0xad, 0x90, 0xab, 0x42, 0xfc, 0x32, 0xf1, 0x88, 0xc3, 0xa9, 0x52, 0xbd, 0xf5, 0xc3, 0x23,
+	0xcd, 0xb8, 0x05, 0x0f, 0xea, 0x7a, 0xd6, 0xc9, 0x8e, 0xcc, 0x68, 0x05, 0xeb, 0x79, 0x08, 0x18,
+	0x5e, 0x86, 0x45, 0x35, 0xf0, 0xab, 0x24, 0xac, 0xbb, 0xbe, 0xcd, 0xb7, 0x3f, 0x36, 0xfe, 0x18,
+	0xa4, 0xcb, 0xa4, 0xcc, 0x69, 0xf0, 0x84, 0xac, 0x40, 0x45, 0xd8, 0x57, 0x27, 0x8c, 0xd9, 0x35,
+	0x12, 0x4f, 0x81, 0xca, 0xe2, 0x7b, 0x5a, 0xf4, 0x29, 0x13, 0xbe, 0xeb, 0x40, 0xe8, 0x00, 0xdc,
+	0x1b, 0xac, 0xd9, 0x8c, 0xc4, 0xeb, 0x2f, 0xca, 0xa0, 0x49, 0x38, 0x4a, 0x1b, 0x3c, 0x68, 0xf0,
+	0x95, 0xd4, 0x4b, 0xa2, 0xa5, 0xd7, 0x52, 0x8e, 0x3f, 0x2f, 0xc0, 0x43, 0xca, 0xa4, 0x85, 0x20,
+	0x08, 0x69, 0xf3, 0x49, 0x79, 0x8b, 0x08, 0xc1, 0x9a, 0x1b, 0xef, 0x19, 0xef, 0x91, 0x21, 0x38,
+	0xd9, 0xa9, 0xda, 0x53, 0x99, 0x29, 0xfb, 0x15, 0x5f, 0xac, 0x50, 0xad, 0x0f, 0x5d, 0xa8, 0xbd,
+	0x19, 0xa1, 0x8c, 0x8b, 0x70, 0xdf, 0x96, 0x86, 0x22, 0x2e, 0xaf, 0x93, 0x8d, 0xd8, 0x3a, 0x91,
+	0x14, 0x6a, 0x36, 0x6d, 0xaf, 0xa1, 0x0c, 0x8b, 0x32, 0x17, 0x0a, 0xe7, 0x01, 0xfe, 0x04, 0xe8,
+	0x4b, 0xea, 0x26, 0x71, 0x76, 0x7f, 0xee, 0xf1, 0xef, 0x5a, 0xd8, 0xbc, 0x6c, 0x7b, 0xde, 0xaa,
+	0xed, 0xac, 0x6f, 0x9f, 0xea, 0x10, 0xec, 0x15, 0x83, 0x5f, 0xab, 0xa8, 0x8d, 0x20, 0xca, 0xa1,
+	0xb7, 0x60, 0x5f, 0x60, 0x6f, 0x78, 0xd4, 0xae, 0xc8, 0xf1, 0x07, 0x67, 0xaf, 0xee, 0xfc, 0xe8,
+	0x71, 0x8d, 0x93, 0xba, 0xa5, 0xba, 0xc5, 0x06, 0x2c, 0xb6, 0x9a, 0xc1, 0x02, 0xea, 0x33, 0x82,
+	0xaf, 0xa7, 0xce, 0x59, 0x6e, 0xb0, 0x80, 0xf8, 0x95, 0xed, 0x87, 0x93, 0xfb, 0xda, 0xe2, 0x5d,
+	0xa6, 0xb5, 0xed, 0x4b, 0x55, 0x84, 0x7d, 0x01, 0xad, 0xdc, 0x10, 0x8d, 0x22, 0xad, 0x54, 0x16,
+	0x2d, 0x40, 0xe8, 0xd1, 0x9a, 0xda, 0xa1, 0x23, 0xbd, 0x8e, 0x6b, 0x3b, 0xb4, 0x29, 0xce, 0x93,
+	0x62, 0x3f, 0x5e, 0xa1, 0x95, 0xe5, 0xa4, 0xa2, 0xa5, 0x35, 0x12, 0x38, 0xb5, 0x90, 0x04, 0xb1,
+	0xff, 0xca, 0xb4, 0xd8, 0xd2, 0x98, 0x72, 0x94, 0x68, 0x1d, 0x27, 0x79, 0xfc, 0xa3, 0xe6, 0x99,
+	0x8b, 0xc4, 0x23, 0x3b, 0x08, 0xb8, 0xe2, 0xdc, 0x56, 0x91, 0x5d, 0x64, 0x8f, 0x45, 0x39, 0xcf,
+	0x6d, 0x8b, 0x7a, 0x53, 0x2b, 0xdb, 0x93, 0x58, 0x5a, 0x55, 0x1a, 0x3a, 0x24, 0x3e, 0x2f, 0x46,
+	0x19, 0x5c, 0x4c, 0xa7, 0x57, 0xb1, 0xc7, 0x13, 0x7f, 0x57, 0x98, 0x65, 0x73, 0x67, 0x4d, 0x7d,
+	0x67, 0x4f, 0xdf, 0x21, 0x09, 0xdf, 0xd1, 0x3c, 0x4a, 0xc2, 0x5e, 0x69, 0x12, 0x5f, 0x0a, 0xcf,
+	0x37, 0x82, 0x44, 0x78, 0x91, 0x46, 0xab, 0xb0, 0x97, 0xae, 0x8a, 0xb8, 0xf1, 0x18, 0x0e, 0xf0,
+	0x71, 0xcf, 0xe2, 0x1c, 0x85, 0x52, 0x8c, 0x5d, 0x14, 0x0c, 0xbf, 0x04, 0xfb, 0x97, 0x69, 0x2d,
+	0x0a, 0xb3, 0x45, 0xd8, 0xe7, 0x50, 0x9f, 0x13, 0x9f, 0xc7, 0x83, 0xab, 0xac, 0xbe, 0x8e, 0x0a,
+	0x99, 0x75, 0x84, 0x3f, 0x03, 0xfa, 0x01, 0xd9, 0xe7, 0x4f, 0xd5, 0x35, 0x09, 0xff, 0xad, 0x2d,
+	0xb9, 0x72, 0xe6, 0xb4, 0xda, 0x99, 0x0f, 0xc3, 0xa1, 0x90, 0x30, 0xda, 0x08, 0x1d, 0xf2, 0xb2,
+	0xeb, 0x57, 0x62, 0xa3, 0x33, 0x65, 0x7a, 0x1d, 0x2d, 0xc0, 0x64, 0xca, 0x50, 0x08, 0x87, 0xa3,
+	0x43, 0x72, 0x36, 0xd0, 0x2c, 0xef, 0xdc, 0xd8, 0xb2, 0xea, 0x96, 0x59, 0xd9, 0x21, 0x66, 0xff,
+	0x39, 0x0c, 0xf7, 0x25, 0x36, 0x93, 0xb0, 0xe9, 0x3a, 0x04, 0x7d, 0x09, 0xe0, 0x48, 0x74, 0x59,
+	0x53, 0x5f, 0xd0, 0xb1, 0xd6, 0xed, 0x3b, 0x73, 0xd1, 0x35, 0xba, 0x38, 0x23, 0x78, 0xe2, 0x83,
+	0xfb, 0x7f, 0x7d, 0x5a, 0xc0, 0xf8, 0xa8, 0xbc, 0xaa, 0x37, 0x67, 0x92, 0x9b, 0x3f, 0x2b, 0xdd,
+	0x4e, 0x54, 0xdf, 0xbc, 0x00, 0x26, 0xd1, 0x17, 0x00, 0x0e, 0x2e, 0x11, 0x9e, 0x60, 0x1e, 0x69,
+	0xc5, 0x4c, 0x2f, 0x93, 0x5d, 0x65, 0x3c, 0x2b, 0x19, 0x4f, 0xa1, 0x67, 0x3a, 0x32, 0x46, 0xe9,
+	0x4d, 0xc1, 0x39, 0x2c, 0x16, 0x95, 0x6a, 0xce, 0xd0, 0xd1, 0x56, 0x52, 0xed, 0xc6, 0x68, 0xdc,
+	0xe8, 0x1e, 0xaa, 0xe8, 0x16, 0x9f, 0x94, 0xb8, 0xc7, 0x50, 0x67, 0x49, 0xd1, 0x7b, 0x70, 0x24,
+	0x1b, 0x9c, 0x33, 0x13, 0xdf, 0x2e, 0x6c, 0x1b, 0x6d, 0x24, 0x4f, 0x63, 0x15, 0x3e, 0x23, 0xc7,
+	0x3d, 0x89, 0x4e, 0x6c, 0x1d, 0x77, 0x8a, 0x88, 0xef, 0x99, 0xd1, 0xa7, 0x01, 0x62, 0x70, 0x30,
+	0x6d, 0xcc, 0x32, 0xd3, 0xd9, 0x12, 0xff, 0x8c, 0xc3, 0xed, 0x36, 0xe0, 0x68, 0xd8, 0xd3, 0x72,
+	0xd8, 0x13, 0xe8, 0xb8, 0x1a, 0x96, 0xf1, 0x90, 0xd8, 0xf5, 0x52, 0xdb, 0x41, 0xdf, 0x07, 0x70,
+	0x24, 0xda, 0xa5, 0x3a, 0xb9, 0x7b, 0x66, 0x0f, 0x36, 0xc6, 0x1f, 0x5e, 0x21, 0xde, 0xe8, 0x62,
+	0x07, 0x99, 0xcc, 0xe7, 0x20, 0xdf, 0x01, 0x38, 0x2c, 0x2f, 0xa6, 0x09, 0xc2, 0x58, 0xeb, 0x08,
+	0xfa, 0xcd, 0xb5, 0xab, 0xce, 0xfc, 0x9c, 0x64, 0x2d, 0x19, 0x93, 0x79, 0x58, 0x4b, 0xa1, 0xc0,
+	0x10, 0xab, 0xef, 0x27, 0x00, 0x47, 0xd5, 0xbd, 0x3e, 0xe1, 0x3e, 0xde, 0x8e, 0x3b, 0x73, 0xf7,
+	0xef, 0x2a, 0xfa, 0x79, 0x89, 0x3e, 0x6b, 0x4c, 0xe5, 0x44, 0x8f, 0x48, 0x04, 0xfd, 0xf7, 0x00,
+	0x8e, 0x44, 0xb7, 0xe8, 0x4e, 0xd3, 0x9e, 0xb9, 0x67, 0x77, 0x95, 0xfc, 0x9c, 0x24, 0x9f, 0x36,
+	0xce, 0xe4, 0x26, 0xaf, 0x13, 0xc1, 0xfd, 0x03, 0x80, 0xfb, 0xe2, 0x33, 0x73, 0x02, 0xde, 0xc6,
+	0x1d, 0xb3, 0xc7, 0xea, 0xae, 0x92, 0x3f, 0x2f, 0xc9, 0x67, 0x8c, 0xb3, 0xb9, 0xc8, 0x59, 0x04,
+	0x22, 0xd0, 0x7f, 0x06, 0x70, 0x7f, 0xf2, 0x7e, 0x90, 0xc0, 0xe3, 0x56, 0xf8, 0xad, 0x8f, 0x0c,
+	0x5d, 0xc5, 0x7f, 0x41, 0xe2, 0xcf, 0x19, 0x66, 0x2e, 0x7c, 0xae, 0x50, 0x84, 0x01, 0xdf, 0x02,
+	0x38, 0x24, 0x5e, 0x2c, 0x12, 0xf6, 0x36, 0x61, 0x5c, 0x7b, 0xd1, 0xe8, 0x2a, 0xf6, 0xbc, 0xc4,
+	0x36, 0x8d, 0xd3, 0xf9, 0x54, 0xe7, 0x34, 0x10, 0xc4, 0x5f, 0x03, 0x38, 0x58, 0xee, 0xbc, 0x43,
+	0x96, 0x1f, 0xcf, 0x0e, 0x39, 0x27, 0x79, 0xa7, 0x8c, 0x89, 0x7c, 0xbc, 0x84, 0x2b, 0xe7, 0x8e,
+	0xdf, 0x05, 0x3a, 0x39, 0x77, 0xf6, 0xe9, 0x60, 0x17, 0x9d, 0xdb, 0x8e, 0x40, 0xd2, 0x78, 0x22,
+	0x0e, 0xec, 0x9d, 0xe3, 0x89, 0xf6, 0xc8, 0xb0, 0xab, 0xf1, 0x44, 0x70, 0x08, 0xee, 0xbb, 0x00,
+	0x8e, 0xaa, 0xfb, 0x79, 0xa7, 0x28, 0xbe, 0xe5, 0x29, 0xc2, 0xc0, 0x9d, 0xaa, 0xc4, 0x9b, 0xe0,
+	0x92, 0x64, 0x5a, 0xc0, 0xe7, 0x72, 0x31, 0x39, 0x71, 0xf3, 0xd2, 0xed, 0xe8, 0x8d, 0x62, 0xf3,
+	0x82, 0x7a, 0x4b, 0x40, 0x5f, 0x01, 0x38, 0x24, 0x2e, 0x0c, 0x9d, 0x16, 0x9e, 0x76, 0xa1, 0xe8,
+	0xaa, 0xb0, 0x53, 0xd2, 0x88, 0x67, 0x31, 0xee, 0x6c, 0x84, 0xe7, 0xfa, 0x52, 0xcf, 0x77, 0x61,
+	0x5f, 0xf4, 0x0a, 0xc0, 0xda, 0x2d, 0xb6, 0xf4, 0x81, 0xc2, 0x40, 0xe9, 0x57, 0x75, 0xa9, 0xc2,
+	0x17, 0xe5, 0x58, 0xf3, 0x68, 0x36, 0x97, 0x60, 0xb7, 0xe3, 0x7b, 0xd5, 0x66, 0xc9, 0xa3, 0xb5,
+	0x8f, 0x0a, 0x60, 0x1a, 0x20, 0x0e, 0x87, 0xb4, 0xa1, 0xb6, 0x83, 0x30, 0x2d, 0x11, 0x26, 0x51,
+	0xbe, 0x75, 0xeb, 0xd1, 0xda, 0x34, 0x40, 0xdf, 0x00, 0x38, 0x52, 0xce, 0x9e, 0x03, 0x8e, 0xb5,
+	0xdb, 0x92, 0x1e, 0xd7, 0x29, 0xa0, 0x24, 0x99, 0x4f, 0xe3, 0x47, 0x1c, 0xb6, 0x92, 0xcd, 0xff,
+	0xd2, 0xf5, 0x3f, 0x1e, 0x8c, 0x81, 0x7b, 0x0f, 0xc6, 0xc0, 0x9f, 0x0f, 0xc6, 0xc0, 0x1b, 0x2f,
+	0xfe, 0xa7, 0x3f, 0x91, 0x5b, 0x7e, 0x70, 0xae, 0xf6, 0xca, 0x9f, 0x80, 0x73, 0xff, 0x0e, 0x00,
+	0x5e, 0x3d, 0x07, 0xa6, 0x01, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1468,6 +1749,9 @@ type WorkflowServiceClient interface {
 	TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ApproveWorkflow(ctx context.Context, in *WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	RejectWorkflow(ctx context.Context, in *WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	CallbackWorkflow(ctx context.Context, in *WorkflowCallbackRequest, opts ...grpc.CallOption) (*WorkflowCallbackResponse, error)
 	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
 	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
@@ -1646,6 +1930,33 @@ func (c *workflowServiceClient) SetWorkflow(ctx context.Context, in *WorkflowSet
 	return out, nil
 }
 
+func (c *workflowServiceClient) ApproveWorkflow(ctx context.Context, in *WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ApproveWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) RejectWorkflow(ctx context.Context, in *WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RejectWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) CallbackWorkflow(ctx context.Context, in *WorkflowCallbackRequest, opts ...grpc.CallOption) (*WorkflowCallbackResponse, error) {
+	out := new(WorkflowCallbackResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/CallbackWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	out := new(v1alpha1.Workflow)
 	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/LintWorkflow", in, out, opts...)
@@ -1744,6 +2055,9 @@ type WorkflowServiceServer interface {
 	TerminateWorkflow(context.Context, *WorkflowTerminateRequest) (*v1alpha1.Workflow, error)
 	StopWorkflow(context.Context, *WorkflowStopRequest) (*v1alpha1.Workflow, error)
 	SetWorkflow(context.Context, *WorkflowSetRequest) (*v1alpha1.Workflow, error)
+	ApproveWorkflow(context.Context, *WorkflowApproveRequest) (*v1alpha1.Workflow, error)
+	RejectWorkflow(context.Context, *WorkflowRejectRequest) (*v1alpha1.Workflow, error)
+	CallbackWorkflow(context.Context, *WorkflowCallbackRequest) (*WorkflowCallbackResponse, error)
 	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
 	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
 	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
@@ -1794,6 +2108,15 @@ func (*UnimplementedWorkflowServiceServer) StopWorkflow(ctx context.Context, req
 func (*UnimplementedWorkflowServiceServer) SetWorkflow(ctx context.Context, req *WorkflowSetRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflow not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) ApproveWorkflow(ctx context.Context, req *WorkflowApproveRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) RejectWorkflow(ctx context.Context, req *WorkflowRejectRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) CallbackWorkflow(ctx context.Context, req *WorkflowCallbackRequest) (*WorkflowCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackWorkflow not implemented")
+}
 func (*UnimplementedWorkflowServiceServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflow not implemented")
 }
@@ -2051,6 +2374,60 @@ func _WorkflowService_SetWorkflow_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_ApproveWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ApproveWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ApproveWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ApproveWorkflow(ctx, req.(*WorkflowApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_RejectWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RejectWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/RejectWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RejectWorkflow(ctx, req.(*WorkflowRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_CallbackWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CallbackWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/CallbackWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CallbackWorkflow(ctx, req.(*WorkflowCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_LintWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkflowLintRequest)
 	if err := dec(in); err != nil {
@@ -2178,7 +2555,19 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _WorkflowService_SetWorkflow_Handler,
 		},
 		{
-			MethodName: "LintWorkflow",
+			MethodName: "ApproveWorkflow",
+			Handler:    _WorkflowService_ApproveWorkflow_Handler,
+		},
+		{
+			MethodName: "RejectWorkflow",
+			Handler:    _WorkflowService_RejectWorkflow_Handler,
+		},
+		{
+			MethodName: "CallbackWorkflow",
+			Handler:    _WorkflowService_CallbackWorkflow_Handler,
+		},
+		{
+			MethodName: "LintWorkflow",
 			Handler:    _WorkflowService_LintWorkflow_Handler,
 		},
 		{
@@ -2767,6 +3156,222 @@ func (m *WorkflowSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowApproveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowApproveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowApproveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Parameters) > 0 {
+		for k := range m.Parameters {
+			v := m.Parameters[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflow(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowRejectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRejectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRejectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowSuspendRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3544,7 +4149,7 @@ func (m *WorkflowSetRequest) Size() (n int) {
 	return n
 }
 
-func (m *WorkflowSuspendRequest) Size() (n int) {
+func (m *WorkflowApproveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3558,13 +4163,29 @@ func (m *WorkflowSuspendRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflow(uint64(len(k))) + 1 + len(v) + sovWorkflow(uint64(len(v)))
+			n += mapEntrySize + 1 + sovWorkflow(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *WorkflowLogRequest) Size() (n int) {
+func (m *WorkflowRejectRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3578,19 +4199,11 @@ func (m *WorkflowLogRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.LogOptions != nil {
-		l = m.LogOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Grep)
+	l = len(m.NodeFieldSelector)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	l = len(m.Selector)
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
@@ -3600,7 +4213,7 @@ func (m *WorkflowLogRequest) Size() (n int) {
 	return n
 }
 
-func (m *WorkflowDeleteRequest) Size() (n int) {
+func (m *WorkflowCallbackRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3614,12 +4227,13 @@ func (m *WorkflowDeleteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	if m.DeleteOptions != nil {
-		l = m.DeleteOptions.Size()
+	l = len(m.NodeId)
+	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	if m.Force {
-		n += 2
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3627,7 +4241,7 @@ func (m *WorkflowDeleteRequest) Size() (n int) {
 	return n
 }
 
-func (m *WorkflowDeleteResponse) Size() (n int) {
+func (m *WorkflowCallbackResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3639,21 +4253,17 @@ func (m *WorkflowDeleteResponse) Size() (n int) {
 	return n
 }
 
-func (m *WatchWorkflowsRequest) Size() (n int) {
+func (m *WorkflowSuspendRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Namespace)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Fields)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
@@ -3663,37 +4273,136 @@ func (m *WatchWorkflowsRequest) Size() (n int) {
 	return n
 }
 
-func (m *WorkflowWatchEvent) Size() (n int) {
+func (m *WorkflowLogRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *WatchEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
-	if m.ListOptions != nil {
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.LogOptions != nil {
+		l = m.LogOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Grep)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.DeleteOptions != nil {
+		l = m.DeleteOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowWatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
 		l = m.ListOptions.Size()
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
@@ -5496,6 +6205,725 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowApproveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowApproveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowApproveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflow(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Parameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowRejectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowRejectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowRejectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
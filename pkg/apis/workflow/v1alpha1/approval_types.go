@@ -0,0 +1,183 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalTimeoutAction is what happens to an approval gate when it times out
+type ApprovalTimeoutAction string
+
+const (
+	// ApprovalTimeoutActionFail fails the node
+	ApprovalTimeoutActionFail ApprovalTimeoutAction = "Fail"
+	// ApprovalTimeoutActionResume resumes the node, as if it had been approved without any parameters
+	ApprovalTimeoutActionResume ApprovalTimeoutAction = "Resume"
+	// ApprovalTimeoutActionEscalate allows the escalation approvers to approve or reject the node too
+	ApprovalTimeoutActionEscalate ApprovalTimeoutAction = "Escalate"
+)
+
+// ApprovalGate makes a suspend node wait for approvers to approve it, rather than anyone who can resume the workflow
+type ApprovalGate struct {
+	// Approvers are the users and groups who can approve or reject the node.
+	// Anyone who can update the workflow can approve or reject the node if there are none.
+	Approvers Approvers `json:"approvers,omitempty" protobuf:"bytes,1,opt,name=approvers"`
+
+	// Quorum is the number of approvers who must approve the node before it is resumed, defaults to 1.
+	// A single rejection fails the node.
+	Quorum int32 `json:"quorum,omitempty" protobuf:"varint,2,opt,name=quorum"`
+
+	// Timeout is how long to wait for the quorum, e.g. "24h". Waits forever if empty.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+
+	// TimeoutAction is what happens when the timeout expires: "Fail" (the default) fails the node, "Resume" resumes it,
+	// and "Escalate" allows the escalation approvers to approve or reject it too
+	TimeoutAction ApprovalTimeoutAction `json:"timeoutAction,omitempty" protobuf:"bytes,4,opt,name=timeoutAction,casttype=ApprovalTimeoutAction"`
+
+	// Escalation is who can approve or reject the node once it has timed out, when the timeout action is "Escalate"
+	Escalation *ApprovalEscalation `json:"escalation,omitempty" protobuf:"bytes,5,opt,name=escalation"`
+}
+
+// Approvers are users and groups, who are matched against the claims of a user's SSO login
+type Approvers struct {
+	// Users are matched against the subject, email and preferred username of the user
+	Users []string `json:"users,omitempty" protobuf:"bytes,1,rep,name=users"`
+	// Groups are matched against the groups of the user
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,2,rep,name=groups"`
+}
+
+// ApprovalEscalation is who can approve or reject an approval gate once it has timed out
+type ApprovalEscalation struct {
+	// Approvers who can approve or reject the node once it has been escalated, as well as the original approvers
+	Approvers Approvers `json:"approvers" protobuf:"bytes,1,opt,name=approvers"`
+	// Timeout is how long to wait for the quorum once the node has been escalated before failing it, e.g. "24h".
+	// Waits forever if empty.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// ApprovalStatus is the state of the approval gate of a node
+type ApprovalStatus struct {
+	// Approvers are the users and groups who can currently approve or reject the node
+	Approvers Approvers `json:"approvers,omitempty" protobuf:"bytes,1,opt,name=approvers"`
+	// Quorum is the number of approvers who must approve the node
+	Quorum int32 `json:"quorum" protobuf:"varint,2,opt,name=quorum"`
+	// Escalated is whether the node timed out and was escalated
+	Escalated bool `json:"escalated,omitempty" protobuf:"varint,3,opt,name=escalated"`
+	// Decisions is the audit trail of who approved or rejected the node
+	Decisions []ApprovalDecision `json:"decisions,omitempty" protobuf:"bytes,4,rep,name=decisions"`
+}
+
+// ApprovalDecision is an approval or rejection of an approval gate
+type ApprovalDecision struct {
+	// User is who approved or rejected the node
+	User string `json:"user" protobuf:"bytes,1,opt,name=user"`
+	// Approved is true if the user approved the node, false if they rejected it
+	Approved bool `json:"approved" protobuf:"varint,2,opt,name=approved"`
+	// Parameters are the output parameters the user supplied
+	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters"`
+	// Message is the reason the user gave
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Time is when the user decided
+	Time metav1.Time `json:"time" protobuf:"bytes,5,opt,name=time"`
+}
+
+// GetQuorum returns the number of approvers who must approve the node
+func (in *ApprovalGate) GetQuorum() int32 {
+	if in.Quorum > 0 {
+		return in.Quorum
+	}
+	return 1
+}
+
+// GetTimeoutAction returns what happens when the gate times out
+func (in *ApprovalGate) GetTimeoutAction() ApprovalTimeoutAction {
+	if in.TimeoutAction != "" {
+		return in.TimeoutAction
+	}
+	return ApprovalTimeoutActionFail
+}
+
+// Validate returns an error if the gate is not valid
+func (in *ApprovalGate) Validate() error {
+	if in.Quorum < 0 {
+		return fmt.Errorf("quorum must not be negative")
+	}
+	if err := validateApprovalTimeout(in.Timeout); err != nil {
+		return fmt.Errorf("timeout %w", err)
+	}
+	switch in.GetTimeoutAction() {
+	case ApprovalTimeoutActionFail, ApprovalTimeoutActionResume:
+		if in.Escalation != nil {
+			return fmt.Errorf("escalation may only be used with the \"%s\" timeout action", ApprovalTimeoutActionEscalate)
+		}
+	case ApprovalTimeoutActionEscalate:
+		if in.Timeout == "" {
+			return fmt.Errorf("timeout is required with the \"%s\" timeout action", ApprovalTimeoutActionEscalate)
+		}
+		if in.Escalation == nil || in.Escalation.Approvers.IsEmpty() {
+			return fmt.Errorf("escalation.approvers is required with the \"%s\" timeout action", ApprovalTimeoutActionEscalate)
+		}
+		if err := validateApprovalTimeout(in.Escalation.Timeout); err != nil {
+			return fmt.Errorf("escalation.timeout %w", err)
+		}
+	default:
+		return fmt.Errorf("timeoutAction must be one of %s, %s or %s", ApprovalTimeoutActionFail, ApprovalTimeoutActionResume, ApprovalTimeoutActionEscalate)
+	}
+	return nil
+}
+
+// validateApprovalTimeout validates a timeout, unless it is a variable which is only resolved at runtime
+func validateApprovalTimeout(timeout string) error {
+	if timeout == "" || strings.Contains(timeout, "{{") {
+		return nil
+	}
+	if _, err := ParseStringToDuration(timeout); err != nil {
+		return fmt.Errorf("%q is not a valid duration: %w", timeout, err)
+	}
+	return nil
+}
+
+// IsEmpty returns whether there are no users or groups
+func (in Approvers) IsEmpty() bool {
+	return len(in.Users) == 0 && len(in.Groups) == 0
+}
+
+// Add returns the approvers with the users and groups of other added
+func (in Approvers) Add(other Approvers) Approvers {
+	out := Approvers{Users: slices.Clone(in.Users), Groups: slices.Clone(in.Groups)}
+	for _, user := range other.Users {
+		if !slices.Contains(out.Users, user) {
+			out.Users = append(out.Users, user)
+		}
+	}
+	for _, group := range other.Groups {
+		if !slices.Contains(out.Groups, group) {
+			out.Groups = append(out.Groups, group)
+		}
+	}
+	return out
+}
+
+// GetApprovals returns the number of approvals
+func (in *ApprovalStatus) GetApprovals() int32 {
+	var n int32
+	for _, decision := range in.Decisions {
+		if decision.Approved {
+			n++
+		}
+	}
+	return n
+}
+
+// GetDecision returns the decision of the user, or nil if they have not decided
+func (in *ApprovalStatus) GetDecision(user string) *ApprovalDecision {
+	for i, decision := range in.Decisions {
+		if decision.User == user {
+			return &in.Decisions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalGate_Validate(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		gate := &ApprovalGate{}
+		require.NoError(t, gate.Validate())
+		assert.Equal(t, int32(1), gate.GetQuorum())
+		assert.Equal(t, ApprovalTimeoutActionFail, gate.GetTimeoutAction())
+	})
+	t.Run("NegativeQuorum", func(t *testing.T) {
+		require.EqualError(t, (&ApprovalGate{Quorum: -1}).Validate(), "quorum must not be negative")
+	})
+	t.Run("InvalidTimeout", func(t *testing.T) {
+		require.ErrorContains(t, (&ApprovalGate{Timeout: "soon"}).Validate(), "timeout \"soon\" is not a valid duration")
+	})
+	t.Run("VariableTimeout", func(t *testing.T) {
+		require.NoError(t, (&ApprovalGate{Timeout: "{{inputs.parameters.timeout}}"}).Validate())
+	})
+	t.Run("InvalidTimeoutAction", func(t *testing.T) {
+		require.EqualError(t, (&ApprovalGate{TimeoutAction: "Retry"}).Validate(), "timeoutAction must be one of Fail, Resume or Escalate")
+	})
+	t.Run("EscalationWithoutEscalate", func(t *testing.T) {
+		gate := &ApprovalGate{Timeout: "1h", Escalation: &ApprovalEscalation{Approvers: Approvers{Users: []string{"admin"}}}}
+		require.EqualError(t, gate.Validate(), "escalation may only be used with the \"Escalate\" timeout action")
+	})
+	t.Run("EscalateWithoutTimeout", func(t *testing.T) {
+		gate := &ApprovalGate{TimeoutAction: ApprovalTimeoutActionEscalate, Escalation: &ApprovalEscalation{Approvers: Approvers{Users: []string{"admin"}}}}
+		require.EqualError(t, gate.Validate(), "timeout is required with the \"Escalate\" timeout action")
+	})
+	t.Run("EscalateWithoutApprovers", func(t *testing.T) {
+		gate := &ApprovalGate{TimeoutAction: ApprovalTimeoutActionEscalate, Timeout: "1h", Escalation: &ApprovalEscalation{}}
+		require.EqualError(t, gate.Validate(), "escalation.approvers is required with the \"Escalate\" timeout action")
+	})
+	t.Run("Escalate", func(t *testing.T) {
+		gate := &ApprovalGate{TimeoutAction: ApprovalTimeoutActionEscalate, Timeout: "1h", Escalation: &ApprovalEscalation{Approvers: Approvers{Groups: []string{"admins"}}, Timeout: "24h"}}
+		require.NoError(t, gate.Validate())
+	})
+}
+
+func TestApprovers_Add(t *testing.T) {
+	approvers := Approvers{Users: []string{"alice"}, Groups: []string{"devs"}}
+	added := approvers.Add(Approvers{Users: []string{"alice", "bob"}, Groups: []string{"admins"}})
+	assert.Equal(t, Approvers{Users: []string{"alice", "bob"}, Groups: []string{"devs", "admins"}}, added)
+	assert.Equal(t, []string{"alice"}, approvers.Users)
+	assert.False(t, added.IsEmpty())
+	assert.True(t, Approvers{}.IsEmpty())
+}
+
+func TestApprovalStatus(t *testing.T) {
+	status := &ApprovalStatus{Decisions: []ApprovalDecision{{User: "alice", Approved: true}, {User: "bob"}, {User: "carol", Approved: true}}}
+	assert.Equal(t, int32(2), status.GetApprovals())
+	assert.Equal(t, "bob", status.GetDecision("bob").User)
+	assert.Nil(t, status.GetDecision("dave"))
+}
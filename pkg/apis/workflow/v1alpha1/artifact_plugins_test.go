@@ -215,6 +215,27 @@ func TestArtifacts_GetPluginNames(t *testing.T) {
 		assert.Contains(t, pluginNames, ArtifactPluginName("my-plugin"))
 	})
 
+	t.Run("Shards", func(t *testing.T) {
+		artifacts := Artifacts{
+			{
+				Name: "aggregated-artifact",
+				Shards: []Artifact{
+					{
+						Name:             "shard",
+						ArtifactLocation: ArtifactLocation{Plugin: &PluginArtifact{Name: "my-plugin", Key: "path/to/shard-0"}},
+					},
+					{
+						Name:             "shard",
+						ArtifactLocation: ArtifactLocation{S3: &S3Artifact{S3Bucket: S3Bucket{Bucket: "my-bucket"}, Key: "path/to/shard-1"}},
+					},
+				},
+			},
+		}
+
+		pluginNames := artifacts.GetPluginNames(ctx, nil, ExcludeLogs, nil)
+		assert.Equal(t, []ArtifactPluginName{"my-plugin"}, pluginNames)
+	})
+
 	t.Run("MultiplePlugins", func(t *testing.T) {
 		artifacts := Artifacts{
 			{
@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
 )
 
 const (
 	// DefaultArchivePattern is the default pattern when storing artifacts in an archive repository
 	DefaultArchivePattern = "{{workflow.name}}/{{pod.name}}"
+	// DefaultContentAddressedKeyPrefix is the default prefix under which content-addressed artifacts are stored
+	DefaultContentAddressedKeyPrefix = "cas"
+	// contentAddressedRefsDir is the directory, next to the content-addressed objects, under which the workflows which
+	// reference them are recorded
+	contentAddressedRefsDir = ".refs"
 )
 
 // ArtifactRepository represents an artifact repository in which a controller will store its artifacts
@@ -29,12 +36,65 @@ type ArtifactRepository struct {
 	Azure *AzureArtifactRepository `json:"azure,omitempty" protobuf:"bytes,7,opt,name=azure"`
 	// Plugin stores artifact in a plugin-specific artifact repository
 	Plugin *PluginArtifactRepository `json:"plugin,omitempty" protobuf:"bytes,8,opt,name=plugin"`
+	// ContentAddressed stores output artifacts under a key derived from their content digest,
+	// so that an identical object already in the repository is not uploaded again
+	ContentAddressed *ContentAddressedStorage `json:"contentAddressed,omitempty" protobuf:"bytes,9,opt,name=contentAddressed"`
+	// OCI stores artifacts as OCI artifacts in an OCI registry
+	OCI *OCIArtifactRepository `json:"oci,omitempty" protobuf:"bytes,10,opt,name=oci"`
+	// Encryption encrypts artifacts in the executor before they are stored, with a key held in a secret
+	// in the workflow's namespace
+	Encryption *ArtifactEncryption `json:"encryption,omitempty" protobuf:"bytes,11,opt,name=encryption"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// ContentAddressedStorage configures the deduplication of output artifacts by content digest.
+// Each artifact is stored at `<keyPrefix>/sha256/<digest>/<fileName>`, so byte-identical outputs
+// of different workflows share a single object. Artifact GC only deletes a shared object once
+// no other workflow references it.
+type ContentAddressedStorage struct {
+	// KeyPrefix is the prefix of the keys content-addressed artifacts are stored under. Defaults to "cas".
+	KeyPrefix string `json:"keyPrefix,omitempty" protobuf:"bytes,1,opt,name=keyPrefix"`
+}
+
+// GetKeyPrefix returns the configured key prefix, or the default one.
+func (c *ContentAddressedStorage) GetKeyPrefix() string {
+	if c == nil || c.KeyPrefix == "" {
+		return DefaultContentAddressedKeyPrefix
+	}
+	return c.KeyPrefix
+}
+
+// DigestKey returns the directory key an artifact with the given digest (e.g. `sha256:abc...`) is stored under.
+func (c *ContentAddressedStorage) DigestKey(digest string) string {
+	algorithm, hex, _ := strings.Cut(digest, ":")
+	return path.Join(c.GetKeyPrefix(), algorithm, hex)
+}
+
+// ContentAddressedRefsKey returns the directory key under which the workflows which reference the content-addressed
+// object with the key and digest are recorded, one object per workflow, e.g. `cas/sha256/abc/.refs/<fileName>`.
+func ContentAddressedRefsKey(key, digest string) (string, error) {
+	algorithm, hex, _ := strings.Cut(digest, ":")
+	dir := path.Join(algorithm, hex) + "/"
+	i := strings.Index(key, dir)
+	if algorithm == "" || hex == "" || i < 0 || (i > 0 && key[i-1] != '/') || i+len(dir) == len(key) {
+		return "", fmt.Errorf("key %q is not content-addressed by digest %q", key, digest)
+	}
+	return path.Join(key[:i+len(dir)], contentAddressedRefsDir, key[i+len(dir):]), nil
+}
+
+// ArtifactEncryption configures the client-side envelope encryption of artifacts.
+// Each artifact is encrypted with a random data key, which is stored with the artifact,
+// wrapped by the key-encryption key held in the secret. As the secret is read from the
+// workflow's namespace, each namespace can have its own key.
+type ArtifactEncryption struct {
+	// KeySecret is the secret selector to the key-encryption key, a base64 encoded 256-bit key,
+	// e.g. generated by `openssl rand -base64 32`
+	KeySecret *apiv1.SecretKeySelector `json:"keySecret" protobuf:"bytes,1,opt,name=keySecret"`
+}
+
 type ArtifactRepositoryType interface {
 	IntoArtifactLocation(l *ArtifactLocation)
 }
@@ -51,6 +111,8 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 		return a.GCS
 	case a.HDFS != nil:
 		return a.HDFS
+	case a.OCI != nil:
+		return a.OCI
 	case a.OSS != nil:
 		return a.OSS
 	case a.Plugin != nil:
@@ -68,7 +130,7 @@ func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
 	if a == nil {
 		return nil
 	}
-	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs}
+	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs, ContentAddressed: a.ContentAddressed, Encryption: a.Encryption}
 	v := a.Get()
 	if v != nil {
 		v.IntoArtifactLocation(l)
@@ -185,6 +247,22 @@ func (r *HDFSArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
 	l.HDFS = &HDFSArtifact{HDFSConfig: r.HDFSConfig, Path: p, Force: r.Force}
 }
 
+// OCIArtifactRepository defines the controller configuration for an OCI registry artifact repository
+type OCIArtifactRepository struct {
+	OCIRegistry `json:",inline" protobuf:"bytes,1,opt,name=oCIRegistry"`
+
+	// KeyFormat defines the format of how to store keys and can reference workflow variables.
+	KeyFormat string `json:"keyFormat,omitempty" protobuf:"bytes,2,opt,name=keyFormat"`
+}
+
+func (r *OCIArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
+	k := r.KeyFormat
+	if k == "" {
+		k = DefaultArchivePattern
+	}
+	l.OCI = &OCIArtifact{OCIRegistry: r.OCIRegistry, Key: k}
+}
+
 // PluginArtifactRepository defines the controller configuration for a plugin artifact repository
 type PluginArtifactRepository struct {
 	Name          ArtifactPluginName `json:"name" protobuf:"bytes,1,opt,name=name"`
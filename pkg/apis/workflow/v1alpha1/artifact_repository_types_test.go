@@ -19,6 +19,16 @@ func TestArtifactRepository(t *testing.T) {
 		l := r.ToArtifactLocation()
 		assert.Equal(t, new(true), l.ArchiveLogs)
 	})
+	t.Run("ContentAddressed", func(t *testing.T) {
+		r := &ArtifactRepository{S3: &S3ArtifactRepository{}, ContentAddressed: &ContentAddressedStorage{}}
+		l := r.ToArtifactLocation()
+		assert.True(t, l.IsContentAddressed())
+	})
+	t.Run("Encryption", func(t *testing.T) {
+		r := &ArtifactRepository{S3: &S3ArtifactRepository{}, Encryption: &ArtifactEncryption{}}
+		l := r.ToArtifactLocation()
+		assert.True(t, l.IsEncrypted())
+	})
 	t.Run("Artifactory", func(t *testing.T) {
 		r := &ArtifactRepository{Artifactory: &ArtifactoryArtifactRepository{RepoURL: "http://my-repo"}}
 		assert.IsType(t, &ArtifactoryArtifactRepository{}, r.Get())
@@ -47,6 +57,14 @@ func TestArtifactRepository(t *testing.T) {
 		require.NotNil(t, l.HDFS)
 		assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.HDFS.Path)
 	})
+	t.Run("OCI", func(t *testing.T) {
+		r := &ArtifactRepository{OCI: &OCIArtifactRepository{OCIRegistry: OCIRegistry{Registry: "my-registry"}}}
+		assert.IsType(t, &OCIArtifactRepository{}, r.Get())
+		l := r.ToArtifactLocation()
+		require.NotNil(t, l.OCI)
+		assert.Equal(t, "my-registry", l.OCI.Registry)
+		assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.OCI.Key)
+	})
 	t.Run("Plugin", func(t *testing.T) {
 		r := &ArtifactRepository{Plugin: &PluginArtifactRepository{}}
 		assert.IsType(t, &PluginArtifactRepository{}, r.Get())
@@ -75,3 +93,27 @@ func TestArtifactRepository_IsArchiveLogs(t *testing.T) {
 	assert.False(t, (&ArtifactRepository{ArchiveLogs: new(false)}).IsArchiveLogs())
 	assert.True(t, (&ArtifactRepository{ArchiveLogs: new(true)}).IsArchiveLogs())
 }
+
+func TestContentAddressedStorage_DigestKey(t *testing.T) {
+	var c *ContentAddressedStorage
+	assert.Equal(t, "cas/sha256/abc", c.DigestKey("sha256:abc"))
+	c = &ContentAddressedStorage{KeyPrefix: "my-prefix/dedup"}
+	assert.Equal(t, "my-prefix/dedup/sha256/abc", c.DigestKey("sha256:abc"))
+}
+
+func TestContentAddressedRefsKey(t *testing.T) {
+	key, err := ContentAddressedRefsKey("cas/sha256/abc/my-art.tgz", "sha256:abc")
+	require.NoError(t, err)
+	assert.Equal(t, "cas/sha256/abc/.refs/my-art.tgz", key)
+	key, err = ContentAddressedRefsKey("sha256/abc/my-dir/my-art.tgz", "sha256:abc")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256/abc/.refs/my-dir/my-art.tgz", key)
+	_, err = ContentAddressedRefsKey("my-wf/my-pod/my-art.tgz", "sha256:abc")
+	require.Error(t, err)
+	_, err = ContentAddressedRefsKey("cas/sha256/abcd/my-art.tgz", "sha256:abc")
+	require.Error(t, err)
+	_, err = ContentAddressedRefsKey("cas/sha256/abc/", "sha256:abc")
+	require.Error(t, err)
+	_, err = ContentAddressedRefsKey("cas/sha256/abc/my-art.tgz", "")
+	require.Error(t, err)
+}
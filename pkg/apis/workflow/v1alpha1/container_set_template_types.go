@@ -2,11 +2,14 @@ package v1alpha1
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
+
+	argointstr "github.com/argoproj/argo-workflows/v4/util/intstr"
 )
 
 type ContainerSetTemplate struct {
@@ -77,6 +80,27 @@ func (in *ContainerSetTemplate) GetGraph() []ContainerNode {
 	return in.Containers
 }
 
+// GetContainerNode returns the container named n, or nil if there is no such container
+func (in *ContainerSetTemplate) GetContainerNode(n string) *ContainerNode {
+	for i, c := range in.GetGraph() {
+		if c.Name == n {
+			return &in.Containers[i]
+		}
+	}
+	return nil
+}
+
+// GetOutputArtifacts returns the output artifacts of all of the containers
+func (in *ContainerSetTemplate) GetOutputArtifacts() Artifacts {
+	var artifacts Artifacts
+	for _, c := range in.GetGraph() {
+		if c.Outputs != nil {
+			artifacts = append(artifacts, c.Outputs.Artifacts...)
+		}
+	}
+	return artifacts
+}
+
 func (in *ContainerSetTemplate) HasSequencedContainers() bool {
 	for _, n := range in.GetGraph() {
 		if len(n.Dependencies) > 0 {
@@ -133,10 +157,154 @@ func (in *ContainerSetTemplate) Validate() error {
 	if err != nil {
 		return fmt.Errorf("containers %s", err.Error())
 	}
+
+	for _, ctr := range in.Containers {
+		if ctr.RetryStrategy != nil {
+			if err := ctr.RetryStrategy.Validate(); err != nil {
+				return fmt.Errorf("containers.%s.retryStrategy.%s", ctr.Name, err.Error())
+			}
+		}
+		if ctr.Outputs != nil {
+			if err := validateContainerOutputs(ctr.Outputs); err != nil {
+				return fmt.Errorf("containers.%s.outputs.%s", ctr.Name, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// validateContainerOutputs checks that the outputs of a container of a container set are saved from paths, which are
+// the only outputs a container has
+func validateContainerOutputs(out *Outputs) error {
+	if out.Result != nil || out.ExitCode != nil {
+		return fmt.Errorf("result and exitCode cannot be set")
+	}
+	names := make([]string, 0)
+	for _, param := range out.Parameters {
+		names = append(names, param.Name)
+	}
+	if err := validateWorkflowFieldNames(names, true); err != nil {
+		return fmt.Errorf("parameters%s", err.Error())
+	}
+	for _, param := range out.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
+			return fmt.Errorf("parameters.%s.valueFrom.path must be specified", param.Name)
+		}
+		if param.GlobalName != "" {
+			return fmt.Errorf("parameters.%s.globalName cannot be set", param.Name)
+		}
+	}
+	names = make([]string, 0)
+	for _, art := range out.Artifacts {
+		names = append(names, art.Name)
+	}
+	if err := validateWorkflowFieldNames(names, true); err != nil {
+		return fmt.Errorf("artifacts%s", err.Error())
+	}
+	for _, art := range out.Artifacts {
+		if art.Path == "" {
+			return fmt.Errorf("artifacts.%s.path must be specified", art.Name)
+		}
+		if art.GlobalName != "" {
+			return fmt.Errorf("artifacts.%s.globalName cannot be set", art.Name)
+		}
+		if art.Stream {
+			return fmt.Errorf("artifacts.%s.stream cannot be set", art.Name)
+		}
+	}
 	return nil
 }
 
 type ContainerNode struct {
 	corev1.Container `json:",inline" protobuf:"bytes,1,opt,name=container"`
 	Dependencies     []string `json:"dependencies,omitempty" protobuf:"bytes,2,rep,name=dependencies"`
+	// RetryStrategy describes how to retry the container if it fails, in place of the container set's `retryStrategy`.
+	// Like it, this is a process-level retry that re-runs the command within the same container.
+	RetryStrategy *ContainerRetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,3,opt,name=retryStrategy"`
+	// Outputs are the output parameters and artifacts of the container, which are saved when it exits, and can be
+	// referenced as `containerSet.<name>.outputs` of the step or task that ran the container set.
+	// The schema of outputs is left out of the validation schema, as it would make the CRDs too large.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,4,opt,name=outputs"`
+}
+
+// ContainerRetryStrategy provides controls on how to retry a container of a container set
+type ContainerRetryStrategy struct {
+	// Limit is the maximum number of retry attempts of the container. It does not include the
+	// first, original attempt; the maximum number of total attempts will be `limit + 1`.
+	// If unset, the container is retried until it succeeds, or until backoff.maxDuration has passed.
+	Limit *intstr.IntOrString `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff is a backoff strategy between retries of the container. Its maxDuration limits the time
+	// taken by all attempts of the container.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+	// Expression is a condition expression for when the container is retried, which can use the `retries` and
+	// `lastRetry` variables. If it evaluates to false, the container is not retried.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+}
+
+// GetLimit returns the maximum number of retries of the container, or -1 if there is no limit
+func (s *ContainerRetryStrategy) GetLimit() int {
+	if s == nil {
+		return 0
+	}
+	if s.Limit == nil {
+		return -1
+	}
+	return s.Limit.IntValue()
+}
+
+// GetRetryDelay returns how long to wait before the retry of the container that follows the given number of
+// retries: duration * factor^retries, capped at cap
+func (s *ContainerRetryStrategy) GetRetryDelay(retries int) (time.Duration, error) {
+	if s == nil || s.Backoff == nil || s.Backoff.Duration == "" {
+		return 0, nil
+	}
+	delay, err := ParseStringToDuration(s.Backoff.Duration)
+	if err != nil {
+		return 0, err
+	}
+	factor, err := argointstr.Int32(s.Backoff.Factor)
+	if err != nil {
+		return 0, err
+	}
+	if factor != nil && *factor > 1 {
+		for range retries {
+			if delay > time.Duration(math.MaxInt64)/time.Duration(*factor) {
+				delay = time.Duration(math.MaxInt64)
+				break
+			}
+			delay *= time.Duration(*factor)
+		}
+	}
+	if s.Backoff.Cap != "" {
+		capDuration, err := ParseStringToDuration(s.Backoff.Cap)
+		if err != nil {
+			return 0, err
+		}
+		delay = min(delay, capDuration)
+	}
+	return delay, nil
+}
+
+// GetMaxDuration returns the maximum time allowed for all attempts of the container, or 0 if there is no maximum
+func (s *ContainerRetryStrategy) GetMaxDuration() (time.Duration, error) {
+	if s == nil || s.Backoff == nil || s.Backoff.MaxDuration == "" {
+		return 0, nil
+	}
+	return ParseStringToDuration(s.Backoff.MaxDuration)
+}
+
+// Validate checks if the ContainerRetryStrategy is valid
+func (s *ContainerRetryStrategy) Validate() error {
+	if s.Limit != nil && s.Limit.IntValue() < 0 {
+		return fmt.Errorf("limit must be non-negative")
+	}
+	if _, err := s.GetRetryDelay(0); err != nil {
+		return fmt.Errorf("backoff %w", err)
+	}
+	if _, err := s.GetMaxDuration(); err != nil {
+		return fmt.Errorf("backoff.maxDuration %w", err)
+	}
+	return nil
 }
@@ -181,3 +181,99 @@ containers:
 	err := validateContainerSetTemplate(validContainerSet)
 	require.NoError(t, err)
 }
+
+func TestContainerRetryStrategy(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var strategy *ContainerRetryStrategy
+		assert.Equal(t, 0, strategy.GetLimit())
+		delay, err := strategy.GetRetryDelay(3)
+		require.NoError(t, err)
+		assert.Zero(t, delay)
+	})
+	t.Run("Unlimited", func(t *testing.T) {
+		assert.Equal(t, -1, (&ContainerRetryStrategy{}).GetLimit())
+	})
+	t.Run("Limit", func(t *testing.T) {
+		limit := intstr.FromInt(2)
+		assert.Equal(t, 2, (&ContainerRetryStrategy{Limit: &limit}).GetLimit())
+	})
+	t.Run("Backoff", func(t *testing.T) {
+		factor := intstr.FromInt(2)
+		strategy := &ContainerRetryStrategy{Backoff: &Backoff{Duration: "1s", Factor: &factor, Cap: "5s", MaxDuration: "1m"}}
+		for retries, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+			delay, err := strategy.GetRetryDelay(retries)
+			require.NoError(t, err)
+			assert.Equal(t, expected, delay)
+		}
+		delay, err := strategy.GetRetryDelay(100)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, delay)
+		maxDuration, err := strategy.GetMaxDuration()
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, maxDuration)
+	})
+}
+
+func TestInvalidContainerRetryStrategy(t *testing.T) {
+	err := validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    retryStrategy:
+      limit: -1
+`)
+	require.EqualError(t, err, "containers.a.retryStrategy.limit must be non-negative")
+
+	err = validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    retryStrategy:
+      backoff:
+        duration: foo
+`)
+	require.ErrorContains(t, err, "containers.a.retryStrategy.backoff")
+}
+
+func TestInvalidContainerOutputs(t *testing.T) {
+	for outputs, expected := range map[string]string{
+		`exitCode: "1"`:           "containers.a.outputs.result and exitCode cannot be set",
+		`parameters: [{name: p}]`: "containers.a.outputs.parameters.p.valueFrom.path must be specified",
+		`parameters: [{name: p, globalName: g, valueFrom: {path: /tmp/p}}]`: "containers.a.outputs.parameters.p.globalName cannot be set",
+		`artifacts: [{name: a}]`:                              "containers.a.outputs.artifacts.a.path must be specified",
+		`artifacts: [{name: a, path: /tmp/a, globalName: g}]`: "containers.a.outputs.artifacts.a.globalName cannot be set",
+	} {
+		t.Run(expected, func(t *testing.T) {
+			err := validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    outputs: {` + outputs + `}
+`)
+			require.EqualError(t, err, expected)
+		})
+	}
+}
+
+func TestValidContainerOutputs(t *testing.T) {
+	err := validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    retryStrategy:
+      limit: 2
+      backoff:
+        duration: 1s
+        factor: 2
+      expression: lastRetry.exitCode == "1"
+    outputs:
+      parameters:
+        - name: p
+          valueFrom:
+            path: /tmp/p
+      artifacts:
+        - name: a
+          path: /tmp/a
+`)
+	require.NoError(t, err)
+}
@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,6 +32,96 @@ type WorkflowEventBindingSpec struct {
 	Event Event `json:"event" protobuf:"bytes,1,opt,name=event"`
 	// Submit is the workflow template to submit
 	Submit *Submit `json:"submit,omitempty" protobuf:"bytes,2,opt,name=submit"`
+	// Deduplication drops events which have the same key as an earlier event within a window
+	Deduplication *EventDeduplication `json:"deduplication,omitempty" protobuf:"bytes,3,opt,name=deduplication"`
+	// RateLimit drops events which exceed a rate
+	RateLimit *EventRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,4,opt,name=rateLimit"`
+	// ConcurrencyPolicy is what to do with an event when a workflow submitted by this binding is running.
+	// Allow (default) submits another workflow, Forbid drops the event, Replace stops the running workflows and submits another.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,5,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
+	// Action is an action on running workflows, instead of submitting a workflow
+	Action *EventAction `json:"action,omitempty" protobuf:"bytes,6,opt,name=action"`
+}
+
+// EventActionOperation is the operation of an event action
+type EventActionOperation string
+
+const (
+	// EventActionResume resumes the workflows, or the suspend nodes selected by the node field selector
+	EventActionResume EventActionOperation = "Resume"
+	// EventActionStop stops the workflows, or fails the suspend nodes selected by the node field selector
+	EventActionStop EventActionOperation = "Stop"
+	// EventActionTerminate terminates the workflows
+	EventActionTerminate EventActionOperation = "Terminate"
+	// EventActionSet sets the output parameters of the suspend nodes selected by the node field selector
+	EventActionSet EventActionOperation = "Set"
+)
+
+// EventAction is an action on running workflows
+type EventAction struct {
+	// Operation is Resume, Stop, Terminate or Set
+	Operation EventActionOperation `json:"operation" protobuf:"bytes,1,opt,name=operation,casttype=EventActionOperation"`
+	// Workflows selects the running workflows
+	Workflows EventWorkflowSelector `json:"workflows" protobuf:"bytes,2,opt,name=workflows"`
+	// NodeFieldSelector selects the suspend nodes of the workflows, e.g. `displayName=approve`.
+	// It is required to set output parameters.
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,3,opt,name=nodeFieldSelector"`
+	// Parameters are the output parameters of the nodes, extracted from the event with `valueFrom.event`
+	Parameters []Parameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// Message (https://github.com/expr-lang/expr) is the message of the nodes, e.g. `"rejected by " + payload.user`
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// EventWorkflowSelector selects running workflows, by name or labels, or both
+type EventWorkflowSelector struct {
+	// Name (https://github.com/expr-lang/expr) is the name of the workflow, e.g. `payload.workflow`
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Labels the workflows must have, the values are expressions (https://github.com/expr-lang/expr), e.g. `payload.commit`
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,2,rep,name=labels"`
+}
+
+// EventDeduplication drops events which have the same key as an earlier event within a window
+type EventDeduplication struct {
+	// Key (https://github.com/expr-lang/expr) is the key of the event, e.g. `payload.id`
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Window is how long after an event other events with the same key are dropped, e.g. "10m". Without units, it is in seconds.
+	Window string `json:"window" protobuf:"bytes,2,opt,name=window"`
+}
+
+// EventRateLimitUnit is the unit of time of an event rate limit
+type EventRateLimitUnit string
+
+const (
+	EventRateLimitUnitSecond EventRateLimitUnit = "Second"
+	EventRateLimitUnitMinute EventRateLimitUnit = "Minute"
+	EventRateLimitUnitHour   EventRateLimitUnit = "Hour"
+)
+
+// EventRateLimit drops events which exceed a rate
+type EventRateLimit struct {
+	// Unit is the unit of time of the rate: Second (default), Minute or Hour
+	Unit EventRateLimitUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit,casttype=EventRateLimitUnit"`
+	// RequestsPerUnit is the number of events allowed in each unit of time
+	RequestsPerUnit int32 `json:"requestsPerUnit" protobuf:"varint,2,opt,name=requestsPerUnit"`
+}
+
+// GetWindow returns the window of the deduplication
+func (d *EventDeduplication) GetWindow() (time.Duration, error) {
+	return ParseStringToDuration(d.Window)
+}
+
+// GetUnit returns the unit of time of the rate
+func (r *EventRateLimit) GetUnit() (time.Duration, error) {
+	switch r.Unit {
+	case EventRateLimitUnitSecond, "":
+		return time.Second, nil
+	case EventRateLimitUnitMinute:
+		return time.Minute, nil
+	case EventRateLimitUnitHour:
+		return time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid rate limit unit %q, must be one of Second, Minute or Hour", r.Unit)
+	}
 }
 
 type Event struct {
@@ -0,0 +1,43 @@
+package v1alpha1
+
+// GenerateTasks makes a DAG task generate more tasks of its DAG at runtime.
+// Once the task succeeds, its output is parsed as a JSON or YAML list of tasks, which are validated and spliced into
+// the DAG. Generated tasks without dependencies depend on the generating task, and tasks which depend on the
+// generating task also depend on the tasks it generated.
+type GenerateTasks struct {
+	// Parameter is the name of the output parameter holding the list of tasks.
+	// Defaults to the task's result, i.e. the standard output of a script or container template.
+	Parameter string `json:"parameter,omitempty" protobuf:"bytes,1,opt,name=parameter"`
+}
+
+// GetTasksOutput returns the output of a node which holds its generated tasks, and whether it has it
+func (g *GenerateTasks) GetTasksOutput(outputs *Outputs) (string, bool) {
+	if outputs == nil {
+		return "", false
+	}
+	if g.Parameter == "" {
+		if outputs.Result == nil {
+			return "", false
+		}
+		return *outputs.Result, true
+	}
+	for _, p := range outputs.Parameters {
+		if p.Name == g.Parameter && p.Value != nil {
+			return p.Value.String(), true
+		}
+	}
+	return "", false
+}
+
+// GenerateTasksStatus records the tasks a node with generateTasks generated. They are parsed and validated once, when
+// the node succeeds, and spliced into its DAG from here afterwards.
+type GenerateTasksStatus struct {
+	// Tasks are the tasks the node generated, as they are spliced into its DAG.
+	// The schema of tasks is left out of the validation schema, as it would make the CRDs too large.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Tasks []DAGTask `json:"tasks,omitempty" protobuf:"bytes,1,rep,name=tasks"`
+
+	// Message is why the tasks the node generated are invalid, in which case its DAG fails
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+}
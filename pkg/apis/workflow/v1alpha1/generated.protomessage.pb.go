@@ -5,6 +5,16 @@ package v1alpha1
 
 func (*Amount) ProtoMessage() {}
 
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (*ApprovalEscalation) ProtoMessage() {}
+
+func (*ApprovalGate) ProtoMessage() {}
+
+func (*ApprovalStatus) ProtoMessage() {}
+
+func (*Approvers) ProtoMessage() {}
+
 func (*ArchiveStrategy) ProtoMessage() {}
 
 func (*Arguments) ProtoMessage() {}
@@ -13,6 +23,8 @@ func (*ArtGCStatus) ProtoMessage() {}
 
 func (*Artifact) ProtoMessage() {}
 
+func (*ArtifactEncryption) ProtoMessage() {}
+
 func (*ArtifactGC) ProtoMessage() {}
 
 func (*ArtifactGCSpec) ProtoMessage() {}
@@ -69,10 +81,14 @@ func (*Condition) ProtoMessage() {}
 
 func (*ContainerNode) ProtoMessage() {}
 
+func (*ContainerRetryStrategy) ProtoMessage() {}
+
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 
 func (*ContainerSetTemplate) ProtoMessage() {}
 
+func (*ContentAddressedStorage) ProtoMessage() {}
+
 func (*ContinueOn) ProtoMessage() {}
 
 func (*Counter) ProtoMessage() {}
@@ -97,6 +113,14 @@ func (*DataSource) ProtoMessage() {}
 
 func (*Event) ProtoMessage() {}
 
+func (*EventAction) ProtoMessage() {}
+
+func (*EventDeduplication) ProtoMessage() {}
+
+func (*EventRateLimit) ProtoMessage() {}
+
+func (*EventWorkflowSelector) ProtoMessage() {}
+
 func (*ExecutorConfig) ProtoMessage() {}
 
 func (*ExecutorPlugin) ProtoMessage() {}
@@ -113,6 +137,10 @@ func (*GCSBucket) ProtoMessage() {}
 
 func (*Gauge) ProtoMessage() {}
 
+func (*GenerateTasks) ProtoMessage() {}
+
+func (*GenerateTasksStatus) ProtoMessage() {}
+
 func (*GitArtifact) ProtoMessage() {}
 
 func (*HDFSArtifact) ProtoMessage() {}
@@ -127,14 +155,26 @@ func (*HTTP) ProtoMessage() {}
 
 func (*HTTPArtifact) ProtoMessage() {}
 
+func (*HTTPAsync) ProtoMessage() {}
+
 func (*HTTPAuth) ProtoMessage() {}
 
 func (*HTTPBodySource) ProtoMessage() {}
 
+func (*HTTPCallback) ProtoMessage() {}
+
+func (*HTTPCleanup) ProtoMessage() {}
+
 func (*HTTPHeader) ProtoMessage() {}
 
 func (*HTTPHeaderSource) ProtoMessage() {}
 
+func (*HTTPJobStatus) ProtoMessage() {}
+
+func (*HTTPPoll) ProtoMessage() {}
+
+func (*HTTPRetryStrategy) ProtoMessage() {}
+
 func (*Header) ProtoMessage() {}
 
 func (*Histogram) ProtoMessage() {}
@@ -153,6 +193,8 @@ func (*LifecycleHook) ProtoMessage() {}
 
 func (*Link) ProtoMessage() {}
 
+func (*Loop) ProtoMessage() {}
+
 func (*ManifestFrom) ProtoMessage() {}
 
 func (*MemoizationStatus) ProtoMessage() {}
@@ -185,6 +227,12 @@ func (*OAuth2Auth) ProtoMessage() {}
 
 func (*OAuth2EndpointParam) ProtoMessage() {}
 
+func (*OCIArtifact) ProtoMessage() {}
+
+func (*OCIArtifactRepository) ProtoMessage() {}
+
+func (*OCIRegistry) ProtoMessage() {}
+
 func (*OSSArtifact) ProtoMessage() {}
 
 func (*OSSArtifactRepository) ProtoMessage() {}
@@ -249,6 +297,12 @@ func (*SuppliedValueFrom) ProtoMessage() {}
 
 func (*SuspendTemplate) ProtoMessage() {}
 
+func (*Switch) ProtoMessage() {}
+
+func (*SwitchCase) ProtoMessage() {}
+
+func (*SwitchStatus) ProtoMessage() {}
+
 func (*SyncDatabaseRef) ProtoMessage() {}
 
 func (*Synchronization) ProtoMessage() {}
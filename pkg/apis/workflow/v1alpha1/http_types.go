@@ -1,9 +1,12 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type HTTPHeaderSource struct {
@@ -52,6 +55,101 @@ type HTTP struct {
 	BodyFrom *HTTPBodySource `json:"bodyFrom,omitempty" protobuf:"bytes,8,opt,name=bodyFrom"`
 	// InsecureSkipVerify is a bool when if set to true will skip TLS verification for the HTTP client
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"bytes,7,opt,name=insecureSkipVerify"`
+	// Cleanup is an optional request sent if the node is stopped, terminated or times out before the HTTP Request completes,
+	// e.g. to cancel a job the HTTP Request started
+	Cleanup *HTTPCleanup `json:"cleanup,omitempty" protobuf:"bytes,9,opt,name=cleanup"`
+	// Auth contains information for client authentication: basic auth, OAuth2 client credentials or a client certificate
+	Auth *HTTPAuth `json:"auth,omitempty" protobuf:"bytes,10,opt,name=auth"`
+	// RetryStrategy retries the HTTP Request if it fails with a connection error or a 5xx response code
+	RetryStrategy *HTTPRetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,11,opt,name=retryStrategy"`
+	// Async waits for the job the HTTP Request started to complete, by polling its status or waiting for a callback,
+	// once the HTTP Request succeeds
+	Async *HTTPAsync `json:"async,omitempty" protobuf:"bytes,12,opt,name=async"`
+}
+
+// HTTPAsync waits for a job started by an HTTP Request to complete. Exactly one of poll or callback must be set.
+type HTTPAsync struct {
+	// Poll polls the status of the job until it succeeds or fails
+	Poll *HTTPPoll `json:"poll,omitempty" protobuf:"bytes,1,opt,name=poll"`
+	// Callback waits for the job to call back to the Argo Server, at the URL of the `http.callbackURL` variable
+	Callback *HTTPCallback `json:"callback,omitempty" protobuf:"bytes,2,opt,name=callback"`
+	// TimeoutSeconds is the maximum time to wait for the job to complete, after which the node fails
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,3,opt,name=timeoutSeconds"`
+}
+
+// HTTPPoll polls the status of a job started by an HTTP Request
+type HTTPPoll struct {
+	// URL of the job's status. Defaults to the Location header of the HTTP Request's response
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Method is HTTP methods for the status requests. Default is GET
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers are an optional list of headers to send with the status requests
+	Headers HTTPHeaders `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// IntervalSeconds is the time between status requests. Default is 10 seconds
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty" protobuf:"varint,4,opt,name=intervalSeconds"`
+	// SuccessCondition is an expression which is evaluated against each status response, the job succeeded once it is true
+	SuccessCondition string `json:"successCondition" protobuf:"bytes,5,opt,name=successCondition"`
+	// FailureCondition is an expression which is evaluated against each status response, the job failed once it is true
+	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,6,opt,name=failureCondition"`
+}
+
+// HTTPCallback waits for a job started by an HTTP Request to call back to the Argo Server
+type HTTPCallback struct {
+	// SuccessCondition is an expression which is evaluated against the callback, if it is false the node fails.
+	// By default, any callback succeeds.
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,1,opt,name=successCondition"`
+}
+
+// DefaultHTTPPollInterval is the time between status requests of polls which do not set one
+const DefaultHTTPPollInterval = 10 * time.Second
+
+// GetInterval returns the time between status requests
+func (p *HTTPPoll) GetInterval() time.Duration {
+	if p.IntervalSeconds == nil {
+		return DefaultHTTPPollInterval
+	}
+	return time.Duration(*p.IntervalSeconds) * time.Second
+}
+
+// Validate checks the async wait is either a poll or a callback
+func (a *HTTPAsync) Validate() error {
+	if (a.Poll == nil) == (a.Callback == nil) {
+		return fmt.Errorf("exactly one of poll or callback must be specified")
+	}
+	if a.Poll != nil {
+		if a.Poll.SuccessCondition == "" {
+			return fmt.Errorf("poll.successCondition is required")
+		}
+		if a.Poll.IntervalSeconds != nil && *a.Poll.IntervalSeconds <= 0 {
+			return fmt.Errorf("poll.intervalSeconds must be positive")
+		}
+	}
+	if a.TimeoutSeconds != nil && *a.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeoutSeconds must be positive")
+	}
+	return nil
+}
+
+// HTTPRetryStrategy retries HTTP Requests, within the node, which fail with a connection error or a 5xx response code
+type HTTPRetryStrategy struct {
+	// Limit is the maximum number of times to retry the request
+	Limit *intstr.IntOrString `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+	// Backoff is the backoff between retries, which defaults to a constant 1 second
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// HTTPCleanup is a request sent to clean up after an HTTP template which did not complete
+type HTTPCleanup struct {
+	// Method is HTTP methods for the cleanup request
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+	// URL of the cleanup request
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// Headers are an optional list of headers to send with the cleanup request
+	Headers HTTPHeaders `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Body is content of the cleanup request
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// TimeoutSeconds is request timeout for the cleanup request. Default is 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"bytes,5,opt,name=timeoutSeconds"`
 }
 
 func (h *HTTP) GetBodyBytes() []byte {
@@ -0,0 +1,22 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Loop runs a step or task repeatedly, one iteration after another, until a condition holds.
+// Each iteration after the first is passed the output parameters and artifacts of the previous iteration, as the
+// arguments of the same names.
+type Loop struct {
+	// Until is an expression evaluated against each iteration once it succeeds, e.g.
+	// `outputs.parameters.status == "done"`. The loop ends when it evaluates true.
+	// The iteration's outputs are available as `outputs.parameters.<name>` and `outputs.result`, and its 0-based
+	// index as `iteration`.
+	Until string `json:"until" protobuf:"bytes,1,opt,name=until"`
+
+	// MaxIterations is the maximum number of iterations. The loop fails if Until is still false after the last one.
+	MaxIterations *intstr.IntOrString `json:"maxIterations" protobuf:"bytes,2,opt,name=maxIterations"`
+
+	// Delay is the duration to wait between iterations, e.g. 30s. Default unit is seconds.
+	Delay string `json:"delay,omitempty" protobuf:"bytes,3,opt,name=delay"`
+}
@@ -0,0 +1,82 @@
+package v1alpha1
+
+// Switch chooses the template a step or task executes from cases, by the value of an expression.
+// Only the chosen case is executed, as the step or task's node.
+type Switch struct {
+	// Expression is evaluated when the step or task starts, e.g. `inputs.parameters.size`.
+	// Its value chooses the case to execute.
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+
+	// Cases are the templates the step or task can execute, by value of the expression
+	Cases []SwitchCase `json:"cases" protobuf:"bytes,2,rep,name=cases"`
+
+	// Default is executed when no case matches the value of the expression.
+	// Without a default, the step or task is skipped when no case matches.
+	Default *SwitchCase `json:"default,omitempty" protobuf:"bytes,3,opt,name=default"`
+}
+
+// SwitchCase is a template a step or task with a switch can execute
+type SwitchCase struct {
+	// Value of the expression which chooses the case. Not used by the default case.
+	Value string `json:"value,omitempty" protobuf:"bytes,1,opt,name=value"`
+
+	// Template is the name of the template to execute
+	Template string `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
+
+	// TemplateRef is the reference to the template resource to execute
+	TemplateRef *TemplateRef `json:"templateRef,omitempty" protobuf:"bytes,3,opt,name=templateRef"`
+
+	// Arguments hold arguments to the template
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,4,opt,name=arguments"`
+}
+
+// SwitchStatus records the case chosen by the switch of a step or task
+type SwitchStatus struct {
+	// Value is the value of the switch's expression
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+
+	// Default is whether no case matched the value, so the default case was chosen
+	Default bool `json:"default,omitempty" protobuf:"varint,2,opt,name=default"`
+}
+
+// Choose returns the case matching value, or else the default case, and whether it is the default case.
+// It returns nil if no case matches and there is no default.
+func (s *Switch) Choose(value string) (*SwitchCase, bool) {
+	for i := range s.Cases {
+		if s.Cases[i].Value == value {
+			return &s.Cases[i], false
+		}
+	}
+	return s.Default, s.Default != nil
+}
+
+// AllCases returns the cases, followed by the default case if there is one
+func (s *Switch) AllCases() []SwitchCase {
+	if s == nil {
+		return nil
+	}
+	if s.Default == nil {
+		return s.Cases
+	}
+	return append(append([]SwitchCase{}, s.Cases...), *s.Default)
+}
+
+// WithSwitchCase returns a copy of the step which executes the case of its switch
+func (s *WorkflowStep) WithSwitchCase(c SwitchCase) WorkflowStep {
+	step := *s
+	step.Template = c.Template
+	step.TemplateRef = c.TemplateRef
+	step.Arguments = c.Arguments
+	step.Switch = nil
+	return step
+}
+
+// WithSwitchCase returns a copy of the task which executes the case of its switch
+func (t *DAGTask) WithSwitchCase(c SwitchCase) DAGTask {
+	task := *t
+	task.Template = c.Template
+	task.TemplateRef = c.TemplateRef
+	task.Arguments = c.Arguments
+	task.Switch = nil
+	return task
+}
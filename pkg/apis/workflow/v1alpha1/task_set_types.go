@@ -37,4 +37,17 @@ type NodeResult struct {
 	Message  string    `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Outputs  *Outputs  `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
 	Progress Progress  `json:"progress,omitempty" protobuf:"bytes,4,opt,name=progress,casttype=Progress"`
+	// ContainerOutputs are the outputs of the containers of a container set, keyed by container name
+	ContainerOutputs map[string]Outputs `json:"containerOutputs,omitempty" protobuf:"bytes,5,rep,name=containerOutputs"`
+	// HTTPJob is the job an async HTTP template started and the agent waits for, so that the agent resumes waiting for
+	// it rather than sending the request again if it is restarted
+	HTTPJob *HTTPJobStatus `json:"httpJob,omitempty" protobuf:"bytes,6,opt,name=httpJob"`
+}
+
+// HTTPJobStatus is the state of the job an async HTTP template started
+type HTTPJobStatus struct {
+	// PollURL is the URL of the job's status, which is empty for jobs which call back
+	PollURL string `json:"pollURL" protobuf:"bytes,1,opt,name=pollURL"`
+	// Deadline is when the job times out, if it has a timeout
+	Deadline *metav1.Time `json:"deadline,omitempty" protobuf:"bytes,2,opt,name=deadline"`
 }
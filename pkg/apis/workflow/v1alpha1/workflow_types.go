@@ -109,6 +109,7 @@ const (
 	NodeTypeDAG       NodeType = "DAG"
 	NodeTypeTaskGroup NodeType = "TaskGroup"
 	NodeTypeRetry     NodeType = "Retry"
+	NodeTypeLoop      NodeType = "Loop"
 	NodeTypeSkipped   NodeType = "Skipped"
 	NodeTypeSuspend   NodeType = "Suspend"
 	NodeTypeHTTP      NodeType = "HTTP"
@@ -569,7 +570,9 @@ func (wfs WorkflowSpec) AsExecutorPluginSpec() ([]execplugin.Plugin, error) {
 		}
 
 		spec := execplugin.PluginSpec{
-			Sidecar: sidecar,
+			Version:      plugin.Spec.Version,
+			Capabilities: plugin.Spec.Capabilities,
+			Sidecar:      sidecar,
 		}
 		plugins = append(plugins, execplugin.Plugin{
 			ObjectMeta: plugin.ObjectMeta,
@@ -1001,6 +1004,13 @@ func (a Artifacts) GetPluginNames(ctx context.Context, defaultRepo *ArtifactRepo
 	}
 
 	for _, art := range a {
+		if len(art.Shards) > 0 {
+			// an aggregated artifact is loaded with the plugins of its shards
+			for _, name := range Artifacts(art.Shards).GetPluginNames(ctx, defaultRepo, ExcludeLogs, archiveLocation) {
+				plugins[name] = true
+			}
+			continue
+		}
 		artifactPluginName := ArtifactPluginName("")
 		if art.Plugin != nil {
 			artifactPluginName = art.Plugin.Name
@@ -1108,7 +1118,8 @@ type ValueFrom struct {
 	// Path in the container to retrieve an output parameter value from in container templates
 	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
 
-	// JSONPath of a resource to retrieve an output parameter value from in resource templates
+	// JSONPath of a resource to retrieve an output parameter value from in resource templates,
+	// or of the response body in HTTP templates
 	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,2,opt,name=jsonPath"`
 
 	// JQFilter expression against the resource object in resource templates
@@ -1130,7 +1141,8 @@ type ValueFrom struct {
 	// Default specifies a value to be used if retrieving the value from the specified source fails
 	Default *AnyString `json:"default,omitempty" protobuf:"bytes,5,opt,name=default"`
 
-	// Expression, if defined, is evaluated to specify the value for the parameter
+	// Expression, if defined, is evaluated to specify the value for the parameter.
+	// In HTTP templates, it is evaluated against the request and response, e.g. `response.headers["Location"][0]`
 	Expression string `json:"expression,omitempty" protobuf:"bytes,8,rep,name=expression"`
 }
 
@@ -1197,6 +1209,30 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Digest is the content digest of the artifact, e.g. `sha256:abc...`.
+	// It is recorded by the executor when the artifact is saved to a content-addressed repository.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,14,opt,name=digest"`
+
+	// Stream makes an output artifact readable by dependent DAG tasks while it is still being written.
+	// The path must be a file on a volume mount, which is uploaded in parts as it grows.
+	// Tasks which depend on `<task>.Streaming` start as soon as the stream is available, and read the
+	// artifact through a named pipe if its path is on a volume mount, or wait for it to complete otherwise.
+	Stream bool `json:"stream,omitempty" protobuf:"varint,15,opt,name=stream"`
+
+	// Shards are the artifacts an aggregated artifact is made of, e.g. the output artifact of a step or task expanded
+	// with withItems, withParam or withSequence, referenced as `{{steps.<name>.outputs.artifacts.<a>}}`.
+	// An aggregated input artifact is loaded as a directory, with each shard in a sub-directory named by its index.
+	// Note: This field is defined recursively. Kubernetes doesn't allow recursive types, so we
+	// need "x-kubernetes-preserve-unknown-fields: true" in the validation schema.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Shards []Artifact `json:"shards,omitempty" protobuf:"bytes,16,rep,name=shards"`
+}
+
+// HasLocationOrKey returns whether the artifact has a location or key, or is an aggregated artifact
+func (a *Artifact) HasLocationOrKey() bool {
+	return len(a.Shards) > 0 || a.ArtifactLocation.HasLocationOrKey()
 }
 
 // GetArtifactGC returns the ArtifactGC that was defined by the artifact. If none was provided, a default value is returned.
@@ -1303,6 +1339,12 @@ type ExecutorPlugin struct {
 
 type ExecutorPluginSpec struct {
 	Sidecar ExecutorPluginSidecar `json:"sidecar" protobuf:"bytes,1,opt,name=sidecar"`
+	// Version is the version of the plugin. If the plugin reports its version when the agent checks its health,
+	// they must match.
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// Capabilities are the names of the plugin templates the plugin executes, i.e. the key of the template's `plugin`
+	// field. A plugin without capabilities is asked to execute every plugin template.
+	Capabilities []string `json:"capabilities,omitempty" protobuf:"bytes,3,rep,name=capabilities"`
 }
 
 type ExecutorPluginSidecar struct {
@@ -1381,7 +1423,7 @@ type ArtifactLocationType interface {
 // It is used as single artifact in the context of inputs/outputs (e.g. outputs.artifacts.artname).
 // It is also used to describe the location of multiple artifacts such as the archive location
 // of a single workflow step, which the executor will use as a default location to store its files.
-// +kubebuilder:validation:XValidation:rule="(has(self.s3) ? 1 : 0) + (has(self.git) ? 1 : 0) + (has(self.http) ? 1 : 0) + (has(self.artifactory) ? 1 : 0) + (has(self.hdfs) ? 1 : 0) + (has(self.raw) ? 1 : 0) + (has(self.oss) ? 1 : 0) + (has(self.gcs) ? 1 : 0) + (has(self.azure) ? 1 : 0) + (has(self.plugin) ? 1 : 0) <= 1",message="at most one artifact location can be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.s3) ? 1 : 0) + (has(self.git) ? 1 : 0) + (has(self.http) ? 1 : 0) + (has(self.artifactory) ? 1 : 0) + (has(self.hdfs) ? 1 : 0) + (has(self.raw) ? 1 : 0) + (has(self.oss) ? 1 : 0) + (has(self.gcs) ? 1 : 0) + (has(self.azure) ? 1 : 0) + (has(self.plugin) ? 1 : 0) + (has(self.oci) ? 1 : 0) <= 1",message="at most one artifact location can be specified"
 type ArtifactLocation struct {
 	// ArchiveLogs indicates if the container logs should be archived
 	ArchiveLogs *bool `json:"archiveLogs,omitempty" protobuf:"varint,1,opt,name=archiveLogs"`
@@ -1415,6 +1457,15 @@ type ArtifactLocation struct {
 
 	// Plugin contains plugin artifact location details
 	Plugin *PluginArtifact `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+
+	// ContentAddressed indicates that output artifacts should be stored under a key derived from their content digest
+	ContentAddressed *ContentAddressedStorage `json:"contentAddressed,omitempty" protobuf:"bytes,12,opt,name=contentAddressed"`
+
+	// OCI contains OCI registry artifact location details
+	OCI *OCIArtifact `json:"oci,omitempty" protobuf:"bytes,13,opt,name=oci"`
+
+	// Encryption indicates that artifacts should be encrypted by the executor before they are stored
+	Encryption *ArtifactEncryption `json:"encryption,omitempty" protobuf:"bytes,14,opt,name=encryption"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1441,6 +1492,8 @@ func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
 		return a.S3, nil
 	case a.Plugin != nil:
 		return a.Plugin, nil
+	case a.OCI != nil:
+		return a.OCI, nil
 	default:
 		return nil, fmt.Errorf("artifact storage is not configured; see the docs for setup instructions: https://argo-workflows.readthedocs.io/en/latest/configure-artifact-repository/")
 	}
@@ -1468,6 +1521,8 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 		a.S3 = &S3Artifact{}
 	case *PluginArtifact:
 		a.Plugin = &PluginArtifact{}
+	case *OCIArtifact:
+		a.OCI = &OCIArtifact{}
 	default:
 		return fmt.Errorf("set type not supported for type: %v", reflect.TypeOf(v))
 	}
@@ -1529,6 +1584,16 @@ func (a *ArtifactLocation) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// IsEncrypted returns whether artifacts saved to this location are encrypted by the executor
+func (a *ArtifactLocation) IsEncrypted() bool {
+	return a != nil && a.Encryption != nil
+}
+
+// IsContentAddressed returns whether artifacts saved to this location are deduplicated by content digest
+func (a *ArtifactLocation) IsContentAddressed() bool {
+	return a != nil && a.ContentAddressed != nil
+}
+
 func (a *ArtifactLocation) GetKey() (string, error) {
 	v, err := a.Get()
 	if err != nil {
@@ -1801,6 +1866,17 @@ type WorkflowStep struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+
+	// Loop runs the step repeatedly, until a condition on the outputs of an iteration holds
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,14,opt,name=loop"`
+
+	// Switch chooses the template the step executes, and its arguments, from cases.
+	// A step with a switch does not set template, templateRef, inline or arguments.
+	// Note: the schema of the cases, each with its own arguments, is left out of the validation schema, as it would
+	// make the CRDs too large.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Switch *Switch `json:"switch,omitempty" protobuf:"bytes,15,opt,name=switch"`
 }
 
 func (s *WorkflowStep) GetName() string {
@@ -2646,6 +2722,15 @@ type NodeStatus struct {
 	// This prevents duplicate restart attempts when the controller processes the same failed pod multiple times.
 	// Cleared when the replacement pod starts running.
 	RestartingPodUID string `json:"restartingPodUID,omitempty" protobuf:"bytes,30,opt,name=restartingPodUID"`
+
+	// Approval is the state of the node's approval gate, if it is a suspend node with one
+	Approval *ApprovalStatus `json:"approval,omitempty" protobuf:"bytes,31,opt,name=approval"`
+
+	// Switch records the case chosen by the switch of the node's step or task, if it has one
+	Switch *SwitchStatus `json:"switch,omitempty" protobuf:"bytes,32,opt,name=switch"`
+
+	// GenerateTasks records the tasks generated by the node, if its task has generateTasks
+	GenerateTasks *GenerateTasksStatus `json:"generateTasks,omitempty" protobuf:"bytes,33,opt,name=generateTasks"`
 }
 
 // Completed is used to determine if this node can proceed
@@ -2732,6 +2817,11 @@ func (n NodeStatus) Pending() bool {
 	return n.Phase == NodePending
 }
 
+// IsStreaming returns whether the node is running and has made its stream output artifacts available
+func (n NodeStatus) IsStreaming() bool {
+	return n.Phase == NodeRunning && n.Outputs.HasStreams()
+}
+
 // IsDaemoned returns whether or not the node is daemoned
 func (n NodeStatus) IsDaemoned() bool {
 	if n.Daemoned == nil || !*n.Daemoned {
@@ -3273,6 +3363,57 @@ func (g *GCSArtifact) HasLocation() bool {
 	return g != nil && g.Bucket != "" && g.Key != ""
 }
 
+// OCIRegistry contains the access information for interfacing with an OCI registry
+type OCIRegistry struct {
+	// Registry is the host, and optional port, of the registry, e.g. ghcr.io or registry.example.com:5000
+	Registry string `json:"registry,omitempty" protobuf:"bytes,1,opt,name=registry"`
+
+	// Repository is the repository artifacts are pushed under, e.g. my-org/artifacts
+	Repository string `json:"repository,omitempty" protobuf:"bytes,2,opt,name=repository"`
+
+	// Insecure will connect to the registry over plain HTTP, or without verifying its TLS certificate
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,3,opt,name=insecure"`
+
+	// UsernameSecret is the secret selector to the registry username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty" protobuf:"bytes,4,opt,name=usernameSecret"`
+
+	// PasswordSecret is the secret selector to the registry password or token
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,5,opt,name=passwordSecret"`
+}
+
+// OCIArtifact is the location of an artifact stored in an OCI registry, as an OCI artifact manifest.
+// The directory of the key is appended to the repository, and its base name is the tag, e.g. the key
+// `my-wf/my-pod/main.tgz` in the repository `my-org/artifacts` is pushed as `my-org/artifacts/my-wf/my-pod:main.tgz`.
+type OCIArtifact struct {
+	OCIRegistry `json:",inline" protobuf:"bytes,1,opt,name=oCIRegistry"`
+
+	// Key is the path in the repository where the artifact resides
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+
+	// Digest pins the artifact to a manifest digest, e.g. sha256:abc... It is set when an output artifact is pushed,
+	// and an input artifact with a digest is only loaded if its manifest still has this digest.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+
+	// MediaType is the media type of the artifact's layers. Defaults to application/octet-stream
+	MediaType string `json:"mediaType,omitempty" protobuf:"bytes,4,opt,name=mediaType"`
+
+	// Annotations are added to the manifest of the artifact when it is pushed
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,rep,name=annotations"`
+}
+
+func (o *OCIArtifact) GetKey() (string, error) {
+	return o.Key, nil
+}
+
+func (o *OCIArtifact) SetKey(key string) error {
+	o.Key = key
+	return nil
+}
+
+func (o *OCIArtifact) HasLocation() bool {
+	return o != nil && o.Registry != "" && o.Key != ""
+}
+
 // OSSBucket contains the access information required for interfacing with an Alibaba Cloud OSS bucket
 type OSSBucket struct {
 	// Endpoint is the hostname of the bucket endpoint
@@ -3667,6 +3808,20 @@ type DAGTask struct {
 	// Hooks hold the lifecycle hook which is invoked at lifecycle of
 	// task, irrespective of the success, failure, or error status of the primary task
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+
+	// Loop runs the task repeatedly, until a condition on the outputs of an iteration holds
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,15,opt,name=loop"`
+
+	// GenerateTasks splices the tasks the task outputs into the DAG once it succeeds
+	GenerateTasks *GenerateTasks `json:"generateTasks,omitempty" protobuf:"bytes,16,opt,name=generateTasks"`
+
+	// Switch chooses the template the task executes, and its arguments, from cases.
+	// A task with a switch does not set template, templateRef, inline or arguments.
+	// Note: the schema of the cases, each with its own arguments, is left out of the validation schema, as it would
+	// make the CRDs too large.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Switch *Switch `json:"switch,omitempty" protobuf:"bytes,17,opt,name=switch"`
 }
 
 func (t *DAGTask) GetName() string {
@@ -3718,6 +3873,10 @@ type SuspendTemplate struct {
 	// Duration is the seconds to wait before automatically resuming a template. Must be a string. Default unit is seconds.
 	// Could also be a Duration, e.g.: "2m", "6h"
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+
+	// Approval makes the node an approval gate, which is resumed when enough approvers approve it with `argo approve`,
+	// and fails if one of them rejects it with `argo reject`. It cannot be resumed with `argo resume`.
+	Approval *ApprovalGate `json:"approval,omitempty" protobuf:"bytes,2,opt,name=approval"`
 }
 
 // GetArtifactByName returns an input artifact by its name
@@ -3784,6 +3943,19 @@ func (out *Outputs) HasArtifacts() bool {
 	return out != nil && len(out.Artifacts) > 0
 }
 
+// HasStreams returns whether any of the output artifacts are streams
+func (out *Outputs) HasStreams() bool {
+	if out == nil {
+		return false
+	}
+	for _, a := range out.Artifacts {
+		if a.Stream {
+			return true
+		}
+	}
+	return false
+}
+
 func (out *Outputs) HasParameters() bool {
 	return out != nil && len(out.Parameters) > 0
 }
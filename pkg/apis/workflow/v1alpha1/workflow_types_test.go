@@ -341,6 +341,15 @@ func TestAzureArtifact(t *testing.T) {
 	assert.Equal(t, "my-blob", key)
 }
 
+func TestOCIArtifact(t *testing.T) {
+	a := &OCIArtifact{Key: "my-key", OCIRegistry: OCIRegistry{Registry: "my-registry"}}
+	assert.True(t, a.HasLocation())
+	require.NoError(t, a.SetKey("my-key"))
+	key, err := a.GetKey()
+	require.NoError(t, err)
+	assert.Equal(t, "my-key", key)
+}
+
 func TestGitArtifact(t *testing.T) {
 	a := &GitArtifact{Repo: "my-repo"}
 	assert.True(t, a.HasLocation())
@@ -455,6 +464,9 @@ func TestArtifactLocation_Get(t *testing.T) {
 	v, _ = (&ArtifactLocation{HTTP: &HTTPArtifact{}}).Get()
 	assert.IsType(t, &HTTPArtifact{}, v)
 
+	v, _ = (&ArtifactLocation{OCI: &OCIArtifact{}}).Get()
+	assert.IsType(t, &OCIArtifact{}, v)
+
 	v, _ = (&ArtifactLocation{OSS: &OSSArtifact{}}).Get()
 	assert.IsType(t, &OSSArtifact{}, v)
 
@@ -495,6 +507,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		require.NoError(t, l.SetType(&HTTPArtifact{}))
 		assert.NotNil(t, l.HTTP)
 	})
+	t.Run("OCI", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		require.NoError(t, l.SetType(&OCIArtifact{}))
+		assert.NotNil(t, l.OCI)
+	})
 	t.Run("OSS", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		require.NoError(t, l.SetType(&OSSArtifact{}))
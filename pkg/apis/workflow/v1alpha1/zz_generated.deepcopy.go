@@ -31,6 +31,119 @@ func (in *Amount) DeepCopy() *Amount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalDecision) DeepCopyInto(out *ApprovalDecision) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalDecision.
+func (in *ApprovalDecision) DeepCopy() *ApprovalDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalEscalation) DeepCopyInto(out *ApprovalEscalation) {
+	*out = *in
+	in.Approvers.DeepCopyInto(&out.Approvers)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalEscalation.
+func (in *ApprovalEscalation) DeepCopy() *ApprovalEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalGate) DeepCopyInto(out *ApprovalGate) {
+	*out = *in
+	in.Approvers.DeepCopyInto(&out.Approvers)
+	if in.Escalation != nil {
+		in, out := &in.Escalation, &out.Escalation
+		*out = new(ApprovalEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalGate.
+func (in *ApprovalGate) DeepCopy() *ApprovalGate {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
+	*out = *in
+	in.Approvers.DeepCopyInto(&out.Approvers)
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]ApprovalDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approvers) DeepCopyInto(out *Approvers) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approvers.
+func (in *Approvers) DeepCopy() *Approvers {
+	if in == nil {
+		return nil
+	}
+	out := new(Approvers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 	*out = *in
@@ -141,6 +254,13 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]Artifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -154,6 +274,27 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactEncryption) DeepCopyInto(out *ArtifactEncryption) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactEncryption.
+func (in *ArtifactEncryption) DeepCopy() *ArtifactEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
 	*out = *in
@@ -279,6 +420,21 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(PluginArtifact)
 		**out = **in
 	}
+	if in.ContentAddressed != nil {
+		in, out := &in.ContentAddressed, &out.ContentAddressed
+		*out = new(ContentAddressedStorage)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIArtifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ArtifactEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -380,6 +536,21 @@ func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 		*out = new(PluginArtifactRepository)
 		**out = **in
 	}
+	if in.ContentAddressed != nil {
+		in, out := &in.ContentAddressed, &out.ContentAddressed
+		*out = new(ContentAddressedStorage)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ArtifactEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -923,6 +1094,16 @@ func (in *ContainerNode) DeepCopyInto(out *ContainerNode) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(ContainerRetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(Outputs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -936,6 +1117,32 @@ func (in *ContainerNode) DeepCopy() *ContainerNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRetryStrategy) DeepCopyInto(out *ContainerRetryStrategy) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRetryStrategy.
+func (in *ContainerRetryStrategy) DeepCopy() *ContainerRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerSetRetryStrategy) DeepCopyInto(out *ContainerSetRetryStrategy) {
 	*out = *in
@@ -992,6 +1199,22 @@ func (in *ContainerSetTemplate) DeepCopy() *ContainerSetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentAddressedStorage) DeepCopyInto(out *ContentAddressedStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentAddressedStorage.
+func (in *ContentAddressedStorage) DeepCopy() *ContentAddressedStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentAddressedStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -1221,6 +1444,21 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(Loop)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GenerateTasks != nil {
+		in, out := &in.GenerateTasks, &out.GenerateTasks
+		*out = new(GenerateTasks)
+		**out = **in
+	}
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = new(Switch)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1321,6 +1559,85 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAction) DeepCopyInto(out *EventAction) {
+	*out = *in
+	in.Workflows.DeepCopyInto(&out.Workflows)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAction.
+func (in *EventAction) DeepCopy() *EventAction {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDeduplication) DeepCopyInto(out *EventDeduplication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDeduplication.
+func (in *EventDeduplication) DeepCopy() *EventDeduplication {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDeduplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateLimit) DeepCopyInto(out *EventRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateLimit.
+func (in *EventRateLimit) DeepCopy() *EventRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventWorkflowSelector) DeepCopyInto(out *EventWorkflowSelector) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventWorkflowSelector.
+func (in *EventWorkflowSelector) DeepCopy() *EventWorkflowSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(EventWorkflowSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
@@ -1376,6 +1693,11 @@ func (in *ExecutorPluginSidecar) DeepCopy() *ExecutorPluginSidecar {
 func (in *ExecutorPluginSpec) DeepCopyInto(out *ExecutorPluginSpec) {
 	*out = *in
 	in.Sidecar.DeepCopyInto(&out.Sidecar)
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1465,6 +1787,45 @@ func (in *Gauge) DeepCopy() *Gauge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenerateTasks) DeepCopyInto(out *GenerateTasks) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenerateTasks.
+func (in *GenerateTasks) DeepCopy() *GenerateTasks {
+	if in == nil {
+		return nil
+	}
+	out := new(GenerateTasks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenerateTasksStatus) DeepCopyInto(out *GenerateTasksStatus) {
+	*out = *in
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]DAGTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenerateTasksStatus.
+func (in *GenerateTasksStatus) DeepCopy() *GenerateTasksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GenerateTasksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 	*out = *in
@@ -1613,6 +1974,26 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 		*out = new(HTTPBodySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(HTTPCleanup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(HTTPRetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Async != nil {
+		in, out := &in.Async, &out.Async
+		*out = new(HTTPAsync)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1629,25 +2010,56 @@ func (in *HTTP) DeepCopy() *HTTP {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]Header, len(*in))
-		copy(*out, *in)
-	}
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(HTTPAuth)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		copy(*out, *in)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPArtifact.
+func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAsync) DeepCopyInto(out *HTTPAsync) {
+	*out = *in
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(HTTPPoll)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Callback != nil {
+		in, out := &in.Callback, &out.Callback
+		*out = new(HTTPCallback)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPArtifact.
-func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAsync.
+func (in *HTTPAsync) DeepCopy() *HTTPAsync {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPArtifact)
+	out := new(HTTPAsync)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1692,6 +2104,50 @@ func (in *HTTPBodySource) DeepCopy() *HTTPBodySource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCallback) DeepCopyInto(out *HTTPCallback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCallback.
+func (in *HTTPCallback) DeepCopy() *HTTPCallback {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCleanup) DeepCopyInto(out *HTTPCleanup) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(HTTPHeaders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCleanup.
+func (in *HTTPCleanup) DeepCopy() *HTTPCleanup {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCleanup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
 	*out = *in
@@ -1756,6 +2212,80 @@ func (in HTTPHeaders) DeepCopy() HTTPHeaders {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJobStatus) DeepCopyInto(out *HTTPJobStatus) {
+	*out = *in
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJobStatus.
+func (in *HTTPJobStatus) DeepCopy() *HTTPJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPoll) DeepCopyInto(out *HTTPPoll) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(HTTPHeaders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPoll.
+func (in *HTTPPoll) DeepCopy() *HTTPPoll {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPoll)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryStrategy) DeepCopyInto(out *HTTPRetryStrategy) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryStrategy.
+func (in *HTTPRetryStrategy) DeepCopy() *HTTPRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -1951,6 +2481,27 @@ func (in *Link) DeepCopy() *Link {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Loop) DeepCopyInto(out *Loop) {
+	*out = *in
+	if in.MaxIterations != nil {
+		in, out := &in.MaxIterations, &out.MaxIterations
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Loop.
+func (in *Loop) DeepCopy() *Loop {
+	if in == nil {
+		return nil
+	}
+	out := new(Loop)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestFrom) DeepCopyInto(out *ManifestFrom) {
 	*out = *in
@@ -2164,6 +2715,18 @@ func (in *NodeResult) DeepCopyInto(out *NodeResult) {
 		*out = new(Outputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContainerOutputs != nil {
+		in, out := &in.ContainerOutputs, &out.ContainerOutputs
+		*out = make(map[string]Outputs, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.HTTPJob != nil {
+		in, out := &in.HTTPJob, &out.HTTPJob
+		*out = new(HTTPJobStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2239,6 +2802,21 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = new(SwitchStatus)
+		**out = **in
+	}
+	if in.GenerateTasks != nil {
+		in, out := &in.GenerateTasks, &out.GenerateTasks
+		*out = new(GenerateTasksStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2363,6 +2941,73 @@ func (in *OAuth2EndpointParam) DeepCopy() *OAuth2EndpointParam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+	in.OCIRegistry.DeepCopyInto(&out.OCIRegistry)
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactRepository) DeepCopyInto(out *OCIArtifactRepository) {
+	*out = *in
+	in.OCIRegistry.DeepCopyInto(&out.OCIRegistry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactRepository.
+func (in *OCIArtifactRepository) DeepCopy() *OCIArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistry) DeepCopyInto(out *OCIRegistry) {
+	*out = *in
+	if in.UsernameSecret != nil {
+		in, out := &in.UsernameSecret, &out.UsernameSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistry.
+func (in *OCIRegistry) DeepCopy() *OCIRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSSArtifact) DeepCopyInto(out *OSSArtifact) {
 	*out = *in
@@ -3138,6 +3783,11 @@ func (in *SuppliedValueFrom) DeepCopy() *SuppliedValueFrom {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendTemplate) DeepCopyInto(out *SuspendTemplate) {
 	*out = *in
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalGate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3151,6 +3801,72 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Switch) DeepCopyInto(out *Switch) {
+	*out = *in
+	if in.Cases != nil {
+		in, out := &in.Cases, &out.Cases
+		*out = make([]SwitchCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(SwitchCase)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Switch.
+func (in *Switch) DeepCopy() *Switch {
+	if in == nil {
+		return nil
+	}
+	out := new(Switch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchCase) DeepCopyInto(out *SwitchCase) {
+	*out = *in
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchCase.
+func (in *SwitchCase) DeepCopy() *SwitchCase {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchStatus) DeepCopyInto(out *SwitchStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchStatus.
+func (in *SwitchStatus) DeepCopy() *SwitchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
 	*out = *in
@@ -3341,7 +4057,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(SuspendTemplate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
@@ -3775,6 +4491,21 @@ func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec)
 		*out = new(Submit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Deduplication != nil {
+		in, out := &in.Deduplication, &out.Deduplication
+		*out = new(EventDeduplication)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(EventRateLimit)
+		**out = **in
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(EventAction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4211,6 +4942,16 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(Loop)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = new(Switch)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -22,6 +22,8 @@ type ExecuteTemplateArgs struct {
 	Workflow *Workflow `json:"workflow"`
 	// Required: true
 	Template *wfv1.Template `json:"template"`
+	// NodeID is the ID of the node the template is executed for, so the execution can be found when it is requeued or cancelled
+	NodeID string `json:"nodeID,omitempty"`
 }
 
 // ExecuteTemplateResponse is the response object for template execution.
@@ -43,9 +45,84 @@ func (r ExecuteTemplateReply) GetRequeue() time.Duration {
 	return 0
 }
 
+// CancelTemplateRequest is the request object for template cancellation.
+// swagger:parameters cancelTemplate
+type CancelTemplateRequest struct {
+	// in: body
+	// Required: true
+	Body CancelTemplateArgs
+}
+
+type CancelTemplateArgs struct {
+	// Required: true
+	Workflow *Workflow `json:"workflow"`
+	// Required: true
+	Template *wfv1.Template `json:"template"`
+	// NodeID is the ID of the node the template was executed for
+	NodeID string `json:"nodeID,omitempty"`
+	// Reason is why the template is cancelled, e.g. because the workflow was stopped or the node timed out
+	Reason string `json:"reason,omitempty"`
+}
+
+// CancelTemplateResponse is the response object for template cancellation.
+// swagger:response cancelTemplate
+type CancelTemplateResponse struct {
+	// in: body
+	Body CancelTemplateReply
+}
+
+type CancelTemplateReply struct{}
+
+// HealthRequest is the request object for a health check.
+// swagger:parameters health
+type HealthRequest struct {
+	// in: body
+	// Required: true
+	Body HealthArgs
+}
+
+type HealthArgs struct{}
+
+// HealthResponse is the response object for a health check.
+// swagger:response health
+type HealthResponse struct {
+	// in: body
+	Body HealthReply
+}
+
+type HealthReply struct {
+	// Version is the version of the plugin, which must match the version the plugin was installed with, if any
+	Version string `json:"version,omitempty"`
+}
+
 type TemplateExecutor interface {
 	// swagger:route POST /template.execute executeTemplate
 	//     Responses:
 	//       200: executeTemplate
 	ExecuteTemplate(ctx context.Context, args ExecuteTemplateArgs, reply *ExecuteTemplateReply) error
 }
+
+// TemplateCanceller is implemented by a TemplateExecutor which can cancel the templates it is executing. It is
+// optional, so that existing implementations of TemplateExecutor do not have to implement it.
+type TemplateCanceller interface {
+	// CancelTemplate is called when a template the plugin is executing is no longer needed, because the node was
+	// stopped, terminated or timed out, so that the plugin can stop any work it started.
+	//
+	// swagger:route POST /template.cancel cancelTemplate
+	//     Responses:
+	//       200: cancelTemplate
+	CancelTemplate(ctx context.Context, args CancelTemplateArgs, reply *CancelTemplateReply) error
+}
+
+// HealthChecker is implemented by a TemplateExecutor which can report its health. It is optional, a TemplateExecutor
+// which does not implement it is always healthy.
+type HealthChecker interface {
+	// Health is called by the agent to check the plugin is ready before it routes templates to it, and periodically
+	// afterwards. A plugin which responds with an error, or does not respond, is unhealthy. It is optional, a plugin
+	// which does not implement it is healthy while it is serving requests.
+	//
+	// swagger:route POST /plugin.health health
+	//     Responses:
+	//       200: health
+	Health(ctx context.Context, args HealthArgs, reply *HealthReply) error
+}
@@ -21,7 +21,13 @@ func (p Plugin) Validate() error {
 }
 
 type PluginSpec struct {
-	Sidecar Sidecar `json:"sidecar"`
+	// Version is the version of the plugin. If the plugin reports its version when the agent checks its health, they
+	// must match.
+	Version string `json:"version,omitempty"`
+	// Capabilities are the names of the plugin templates the plugin executes, i.e. the key of the template's `plugin`
+	// field. A plugin without capabilities is asked to execute every plugin template.
+	Capabilities []string `json:"capabilities,omitempty"`
+	Sidecar      Sidecar  `json:"sidecar"`
 }
 
 type Sidecar struct {
@@ -34,7 +34,10 @@ import (
 
 	argo "github.com/argoproj/argo-workflows/v4"
 	"github.com/argoproj/argo-workflows/v4/config"
+	"github.com/argoproj/argo-workflows/v4/persist/archivestore"
 	persist "github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
 	clusterwftemplatepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/cronworkflow"
 	eventpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/event"
@@ -47,8 +50,11 @@ import (
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/server/apiserver/accesslog"
+	apitokenserver "github.com/argoproj/argo-workflows/v4/server/apitoken"
+	"github.com/argoproj/argo-workflows/v4/server/artifactlineage"
 	"github.com/argoproj/argo-workflows/v4/server/artifacts"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/server/auth/apitoken"
 	"github.com/argoproj/argo-workflows/v4/server/auth/sso"
 	"github.com/argoproj/argo-workflows/v4/server/auth/webhook"
 	"github.com/argoproj/argo-workflows/v4/server/cache"
@@ -107,6 +113,10 @@ type argoServer struct {
 	allowedLinkProtocol      []string
 	cache                    *cache.ResourceCache
 	restConfig               *rest.Config
+	// sessionProxy is the database session, nil if there is no persistence
+	sessionProxy *sqldb.SessionProxy
+	// apiTokenRepo records the API tokens issued by the Argo Server, nil if they are not enabled
+	apiTokenRepo persist.APITokenRepo
 }
 
 type ArgoServerOpts struct {
@@ -160,16 +170,40 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (Server, error) {
 		if err != nil {
 			return nil, err
 		}
-		if ssoIf.IsRBACEnabled() {
-			// resourceCache is only used for SSO RBAC
-			resourceCache = cache.NewResourceCache(opts.Clients.Kubernetes, getResourceCacheNamespace(opts.ManagedNamespace))
-			resourceCache.Run(ctx.Done())
-		}
 		log.Info(ctx, "SSO enabled")
 	} else {
 		log.Info(ctx, "SSO disabled")
 	}
-	gatekeeper, err := auth.NewGatekeeper(opts.AuthModes, opts.Clients, opts.RestConfig, ssoIf, auth.DefaultClientForAuthorization, opts.Namespace, opts.SSONamespace, opts.Namespaced, resourceCache)
+	if ssoIf.IsRBACEnabled() || opts.AuthModes[auth.APIToken] {
+		// resourceCache is only used for SSO RBAC and API tokens
+		resourceCache = cache.NewResourceCache(opts.Clients.Kubernetes, getResourceCacheNamespace(opts.ManagedNamespace))
+		resourceCache.Run(ctx.Done())
+	}
+	var sessionProxy *sqldb.SessionProxy
+	var apiTokenRepo persist.APITokenRepo
+	if opts.AuthModes[auth.APIToken] {
+		// API tokens are recorded in the database if there is one, so they can be listed and revoked in bulk,
+		// otherwise in a secret
+		c, err := configController.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if c.Persistence != nil {
+			sessionProxy, err = sqldb.NewSessionProxy(ctx, sqldb.SessionProxyConfig{
+				KubectlConfig: opts.Clients.Kubernetes,
+				Namespace:     opts.Namespace,
+				DBConfig:      c.Persistence.DBConfig,
+			})
+			if err != nil {
+				return nil, err
+			}
+			apiTokenRepo = persist.NewAPITokenRepo(sessionProxy, c.Persistence.GetClusterName())
+		} else {
+			apiTokenRepo = apitoken.NewSecretRepo(ctx, opts.Clients.Kubernetes, opts.Namespace)
+		}
+		log.Info(ctx, "API tokens enabled")
+	}
+	gatekeeper, err := auth.NewGatekeeper(opts.AuthModes, opts.Clients, opts.RestConfig, ssoIf, auth.DefaultClientForAuthorization, opts.Namespace, opts.SSONamespace, opts.Namespaced, resourceCache, apiTokenRepo)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +235,8 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (Server, error) {
 		allowedLinkProtocol:      opts.AllowedLinkProtocol,
 		cache:                    resourceCache,
 		restConfig:               opts.RestConfig,
+		sessionProxy:             sessionProxy,
+		apiTokenRepo:             apiTokenRepo,
 	}, nil
 }
 
@@ -241,15 +277,21 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	offloadRepo := persist.ExplosiveOffloadNodeStatusRepo
 	wfArchive := persist.NullWorkflowArchive
+	artifactLineageRepo := persist.NullArtifactLineageRepo
+	eventDeduplicationRepo := persist.NewMemoryEventDeduplicationRepo()
 	persistence := config.Persistence
 	if persistence != nil {
-		sessionProxy, sessionErr := sqldb.NewSessionProxy(ctx, sqldb.SessionProxyConfig{
-			KubectlConfig: as.clients.Kubernetes,
-			Namespace:     as.namespace,
-			DBConfig:      persistence.DBConfig,
-		})
-		if sessionErr != nil {
-			log.WithFatal().Error(ctx, sessionErr.Error())
+		sessionProxy := as.sessionProxy
+		if sessionProxy == nil {
+			var sessionErr error
+			sessionProxy, sessionErr = sqldb.NewSessionProxy(ctx, sqldb.SessionProxyConfig{
+				KubectlConfig: as.clients.Kubernetes,
+				Namespace:     as.namespace,
+				DBConfig:      persistence.DBConfig,
+			})
+			if sessionErr != nil {
+				log.WithFatal().Error(ctx, sessionErr.Error())
+			}
 		}
 		tableName, tableErr := persist.GetTableName(persistence)
 		if tableErr != nil {
@@ -263,7 +305,16 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		}
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
-		wfArchive = persist.NewWorkflowArchive(sessionProxy, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		// the Argo Server loads workflows moved by archive tiering, and deletes them with their archived workflows
+		workflowStore, storeErr := archivestore.New(as.clients.Kubernetes, as.namespace, persistence, &config.ArtifactRepository)
+		if storeErr != nil {
+			log.WithFatal().Error(ctx, storeErr.Error())
+		}
+		wfArchive = persist.NewWorkflowArchive(sessionProxy, persistence.GetClusterName(), as.managedNamespace, instanceIDService, workflowStore)
+		// likewise, the Argo Server only reads artifact lineage, so it is always enabled
+		artifactLineageRepo = persist.NewArtifactLineageRepo(sessionProxy, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		// the keys of events are shared by replicas of the Argo Server
+		eventDeduplicationRepo = persist.NewEventDeduplicationRepo(sessionProxy, persistence.GetClusterName())
 	}
 	resourceCacheNamespace := getResourceCacheNamespace(as.managedNamespace)
 	wftmplStore, err := workflowtemplate.NewInformer(as.restConfig, resourceCacheNamespace)
@@ -283,8 +334,13 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
 	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
 	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories, log)
-	eventServer := event.NewController(ctx, instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch)
-	wfArchiveServer := workflowarchive.NewWorkflowArchiveServer(wfArchive, offloadRepo, config.WorkflowDefaults)
+	eventServer := event.NewController(ctx, instanceIDService, eventRecorderManager, hydrator.New(offloadRepo), eventDeduplicationRepo, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch)
+	wfArchiveServer := workflowarchive.NewWorkflowArchiveServer(wfArchive, offloadRepo, config.WorkflowDefaults, artifactRepositories)
+	artifactLineageServer := artifactlineage.NewArtifactLineageServer(artifactLineageRepo)
+	var apiTokenServer apitokenpkg.APITokenServiceServer
+	if as.apiTokenRepo != nil {
+		apiTokenServer = apitokenserver.NewAPITokenServer(as.apiTokenRepo)
+	}
 
 	syncServer := serversync.NewSyncServer(ctx, as.clients.Kubernetes, as.namespace, config.Synchronization)
 	wfStore, err := store.NewSQLiteStore(instanceIDService)
@@ -292,7 +348,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		log.WithFatal().Error(ctx, err.Error())
 	}
 	workflowServer := workflow.NewServer(ctx, instanceIDService, offloadRepo, wfArchive, as.clients.Workflow, wfStore, wfStore, wftmplStore, cwftmplInformer, config.WorkflowDefaults, &resourceCacheNamespace, artifactRepositories)
-	grpcServer := as.newGRPCServer(ctx, instanceIDService, workflowServer, wftmplStore, cwftmplInformer, wfArchiveServer, syncServer, eventServer, config.Links, config.Columns, config.NavColor, config.WorkflowDefaults)
+	grpcServer := as.newGRPCServer(ctx, instanceIDService, workflowServer, wftmplStore, cwftmplInformer, wfArchiveServer, artifactLineageServer, apiTokenServer, syncServer, eventServer, config.Links, config.Columns, config.NavColor, config.WorkflowDefaults)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
@@ -352,7 +408,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService instanceid.Service, workflowServer workflowpkg.WorkflowServiceServer, wftmplStore types.WorkflowTemplateStore, cwftmplStore types.ClusterWorkflowTemplateStore, wfArchiveServer workflowarchivepkg.ArchivedWorkflowServiceServer, syncServer syncpkg.SyncServiceServer, eventServer *event.Controller, links []*v1alpha1.Link, columns []*v1alpha1.Column, navColor string, wfDefaults *v1alpha1.Workflow) *grpc.Server {
+func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService instanceid.Service, workflowServer workflowpkg.WorkflowServiceServer, wftmplStore types.WorkflowTemplateStore, cwftmplStore types.ClusterWorkflowTemplateStore, wfArchiveServer workflowarchivepkg.ArchivedWorkflowServiceServer, artifactLineageServer artifactlineagepkg.ArtifactLineageServiceServer, apiTokenServer apitokenpkg.APITokenServiceServer, syncServer syncpkg.SyncServiceServer, eventServer *event.Controller, links []*v1alpha1.Link, columns []*v1alpha1.Column, navColor string, wfDefaults *v1alpha1.Workflow) *grpc.Server {
 	serverLog := logging.RequireLoggerFromContext(ctx)
 
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs. However, since it is bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default. To enable them please call the following in your server initialization code:"
@@ -394,6 +450,10 @@ func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService insta
 	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewServer(instanceIDService, wftmplStore, cwftmplStore))
 	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService, wftmplStore, cwftmplStore, wfDefaults))
 	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, wfArchiveServer)
+	artifactlineagepkg.RegisterArtifactLineageServiceServer(grpcServer, artifactLineageServer)
+	if apiTokenServer != nil {
+		apitokenpkg.RegisterAPITokenServiceServer(grpcServer, apiTokenServer)
+	}
 	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService, cwftmplStore, wfDefaults))
 	syncpkg.RegisterSyncServiceServer(grpcServer, syncServer)
 	grpc_prometheus.Register(grpcServer)
@@ -452,6 +512,8 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(ctx, workflowtemplatepkg.RegisterWorkflowTemplateServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(ctx, cronworkflowpkg.RegisterCronWorkflowServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(ctx, workflowarchivepkg.RegisterArchivedWorkflowServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(ctx, artifactlineagepkg.RegisterArtifactLineageServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(ctx, apitokenpkg.RegisterAPITokenServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(ctx, clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(ctx, syncpkg.RegisterSyncServiceHandlerFromEndpoint, gwmux, endpoint, dialOpts)
 
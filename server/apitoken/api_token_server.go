@@ -0,0 +1,125 @@
+package apitoken
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/server/auth/apitoken"
+	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+const defaultExpiresIn = 30 * 24 * time.Hour
+
+type apiTokenServer struct {
+	repo sqldb.APITokenRepo
+}
+
+// NewAPITokenServer returns a new apiTokenServer
+func NewAPITokenServer(repo sqldb.APITokenRepo) apitokenpkg.APITokenServiceServer {
+	return &apiTokenServer{repo}
+}
+
+func (s *apiTokenServer) CreateAPIToken(ctx context.Context, req *apitokenpkg.CreateAPITokenRequest) (*apitokenpkg.CreateAPITokenResponse, error) {
+	if req.ServiceAccount == "" {
+		return nil, status.Error(codes.InvalidArgument, "serviceAccount is required")
+	}
+	expiresIn := defaultExpiresIn
+	if req.ExpiresIn != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(req.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid expiresIn %q", req.ExpiresIn))
+		}
+	}
+	if err := canCreateServiceAccountToken(ctx, req.Namespace, req.ServiceAccount); err != nil {
+		return nil, err
+	}
+	if _, err := auth.GetKubeClient(ctx).CoreV1().ServiceAccounts(req.Namespace).Get(ctx, req.ServiceAccount, metav1.GetOptions{}); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	token, authorization, err := apitoken.New(req.Namespace, req.ServiceAccount, sqldb.APITokenScope{
+		Namespaces:        req.Namespaces,
+		Verbs:             req.Verbs,
+		WorkflowTemplates: req.WorkflowTemplates,
+	}, time.Now(), expiresIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.repo.CreateToken(ctx, token); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"apiToken": token.ID, "namespace": token.Namespace, "serviceAccount": token.ServiceAccount}).Info(ctx, "Created API token")
+	return &apitokenpkg.CreateAPITokenResponse{Token: toAPIToken(*token), Authorization: authorization}, nil
+}
+
+func (s *apiTokenServer) ListAPITokens(ctx context.Context, req *apitokenpkg.ListAPITokensRequest) (*apitokenpkg.APITokenList, error) {
+	allowed, err := auth.CanIResource(ctx, "list", "", "serviceaccounts", "", req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list service accounts in namespace \"%s\"", req.Namespace))
+	}
+	tokens, err := s.repo.ListTokens(ctx, req.Namespace)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*apitokenpkg.APIToken, len(tokens))
+	for i, token := range tokens {
+		items[i] = toAPIToken(token)
+	}
+	return &apitokenpkg.APITokenList{Items: items}, nil
+}
+
+func (s *apiTokenServer) RevokeAPIToken(ctx context.Context, req *apitokenpkg.RevokeAPITokenRequest) (*apitokenpkg.RevokeAPITokenResponse, error) {
+	token, err := s.repo.GetToken(ctx, req.Id)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if token == nil || token.Namespace != req.Namespace {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("API token \"%s\" not found in namespace \"%s\"", req.Id, req.Namespace))
+	}
+	if err := canCreateServiceAccountToken(ctx, token.Namespace, token.ServiceAccount); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteToken(ctx, token.ID); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"apiToken": token.ID, "namespace": token.Namespace, "serviceAccount": token.ServiceAccount}).Info(ctx, "Revoked API token")
+	return &apitokenpkg.RevokeAPITokenResponse{}, nil
+}
+
+// canCreateServiceAccountToken returns an error unless the user can create Kubernetes tokens for the service account,
+// as an API token has the same permissions as one
+func canCreateServiceAccountToken(ctx context.Context, namespace, serviceAccount string) error {
+	allowed, err := auth.CanIResource(ctx, "create", "", "serviceaccounts", "token", namespace, serviceAccount)
+	if err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to create tokens for service account \"%s\" in namespace \"%s\"", serviceAccount, namespace))
+	}
+	return nil
+}
+
+func toAPIToken(token sqldb.APITokenRecord) *apitokenpkg.APIToken {
+	return &apitokenpkg.APIToken{
+		Id:                token.ID,
+		Namespace:         token.Namespace,
+		ServiceAccount:    token.ServiceAccount,
+		Namespaces:        token.Namespaces,
+		Verbs:             token.Verbs,
+		WorkflowTemplates: token.WorkflowTemplates,
+		CreatedAt:         &metav1.Time{Time: token.CreatedAt},
+		ExpiresAt:         &metav1.Time{Time: token.ExpiresAt},
+	}
+}
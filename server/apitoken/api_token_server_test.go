@@ -0,0 +1,87 @@
+package apitoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	apitokenpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/server/auth/apitoken"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func Test_apiTokenServer(t *testing.T) {
+	kubeClient := kubefake.NewClientset(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "my-ns"}})
+	allowed := true
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	repo := apitoken.NewSecretRepo(ctx, kubeClient, "argo")
+	s := NewAPITokenServer(repo)
+
+	t.Run("CreateAPIToken", func(t *testing.T) {
+		_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns", ServiceAccount: "my-sa", ExpiresIn: "-1h"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns", ServiceAccount: "my-sa", Verbs: []string{"patch"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns", ServiceAccount: "missing-sa"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		allowed = false
+		_, err = s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns", ServiceAccount: "my-sa"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+	})
+
+	resp, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Namespace: "my-ns", ServiceAccount: "my-sa", Verbs: []string{"create"}, WorkflowTemplates: []string{"deploy-app"}, ExpiresIn: "1h"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Authorization, apitoken.Prefix+resp.Token.Id+".")
+	assert.Equal(t, []string{"create"}, resp.Token.Verbs)
+
+	t.Run("ListAPITokens", func(t *testing.T) {
+		list, err := s.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{Namespace: "my-ns"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, resp.Token.Id, list.Items[0].Id)
+		assert.Equal(t, []string{"deploy-app"}, list.Items[0].WorkflowTemplates)
+		list, err = s.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{Namespace: "other-ns"})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+
+	t.Run("RevokeAPIToken", func(t *testing.T) {
+		// tokens are read from an informer, which sees the token shortly after it is created
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			token, err := repo.GetToken(ctx, resp.Token.Id)
+			require.NoError(c, err)
+			assert.NotNil(c, token)
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err := s.RevokeAPIToken(ctx, &apitokenpkg.RevokeAPITokenRequest{Namespace: "other-ns", Id: resp.Token.Id})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		allowed = false
+		_, err = s.RevokeAPIToken(ctx, &apitokenpkg.RevokeAPITokenRequest{Namespace: "my-ns", Id: resp.Token.Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		_, err = s.RevokeAPIToken(ctx, &apitokenpkg.RevokeAPITokenRequest{Namespace: "my-ns", Id: resp.Token.Id})
+		require.NoError(t, err)
+		list, err := s.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{Namespace: "my-ns"})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+}
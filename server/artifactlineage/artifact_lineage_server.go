@@ -0,0 +1,153 @@
+package artifactlineage
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+)
+
+// maxDepth bounds the number of workflows followed upstream and downstream of an artifact, as each level
+// costs a query per edge
+const maxDepth = 10
+
+type artifactLineageServer struct {
+	repo sqldb.ArtifactLineageRepo
+}
+
+// NewArtifactLineageServer returns a new artifactLineageServer
+func NewArtifactLineageServer(repo sqldb.ArtifactLineageRepo) artifactlineagepkg.ArtifactLineageServiceServer {
+	return &artifactLineageServer{repo}
+}
+
+func (s *artifactLineageServer) SearchArtifacts(ctx context.Context, req *artifactlineagepkg.SearchArtifactsRequest) (*artifactlineagepkg.SearchArtifactsResponse, error) {
+	if err := canListWorkflows(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	records, err := s.repo.ListRecords(ctx, sqldb.ArtifactLineageFilter{
+		Namespace:    req.Namespace,
+		Key:          req.Key,
+		Digest:       req.Digest,
+		WorkflowName: req.WorkflowName,
+		WorkflowUID:  req.WorkflowUID,
+		Direction:    req.Direction,
+		Limit:        int(req.Limit),
+	})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*artifactlineagepkg.ArtifactLineageEdge, len(records))
+	for i, record := range records {
+		items[i] = toEdge(record)
+	}
+	return &artifactlineagepkg.SearchArtifactsResponse{Items: items}, nil
+}
+
+// GetArtifactLineage returns the nodes which produced and consumed the artifact, then walks the graph: upstream through
+// the inputs of each producer to their producers, and downstream through the outputs of each consumer to their consumers.
+func (s *artifactLineageServer) GetArtifactLineage(ctx context.Context, req *artifactlineagepkg.GetArtifactLineageRequest) (*artifactlineagepkg.ArtifactLineageGraph, error) {
+	if req.Key == "" && req.Digest == "" {
+		return nil, status.Error(codes.InvalidArgument, "key or digest is required")
+	}
+	if req.Depth < 0 || req.Depth > maxDepth {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("depth must be between 0 and %d", maxDepth))
+	}
+	if err := canListWorkflows(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	g := &graph{repo: s.repo, namespace: req.Namespace, seen: map[string]bool{}}
+	_, frontier, err := g.add(ctx, sqldb.ArtifactLineageFilter{Key: req.Key, Digest: req.Digest})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	for range req.Depth {
+		if frontier, err = g.next(ctx, frontier); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	return &artifactlineagepkg.ArtifactLineageGraph{Edges: g.edges}, nil
+}
+
+type graph struct {
+	repo      sqldb.ArtifactLineageRepo
+	namespace string
+	seen      map[string]bool
+	edges     []*artifactlineagepkg.ArtifactLineageEdge
+}
+
+// add adds the records matching the filter to the graph, returning all of them, and those which were not already in it
+func (g *graph) add(ctx context.Context, filter sqldb.ArtifactLineageFilter) ([]sqldb.ArtifactLineageRecord, []sqldb.ArtifactLineageRecord, error) {
+	filter.Namespace = g.namespace
+	records, err := g.repo.ListRecords(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	var added []sqldb.ArtifactLineageRecord
+	for _, record := range records {
+		id := record.UID + "/" + record.NodeID + "/" + record.Direction + "/" + record.Name
+		if g.seen[id] {
+			continue
+		}
+		g.seen[id] = true
+		g.edges = append(g.edges, toEdge(record))
+		added = append(added, record)
+	}
+	return records, added, nil
+}
+
+// next follows each edge one workflow further, away from the artifact it was reached through
+func (g *graph) next(ctx context.Context, frontier []sqldb.ArtifactLineageRecord) ([]sqldb.ArtifactLineageRecord, error) {
+	var next []sqldb.ArtifactLineageRecord
+	for _, record := range frontier {
+		// a producer's inputs lead upstream to their producers, a consumer's outputs lead downstream to their consumers
+		sibling, opposite := sqldb.ArtifactLineageInput, sqldb.ArtifactLineageOutput
+		if record.Direction == sqldb.ArtifactLineageInput {
+			sibling, opposite = sqldb.ArtifactLineageOutput, sqldb.ArtifactLineageInput
+		}
+		siblings, _, err := g.add(ctx, sqldb.ArtifactLineageFilter{WorkflowUID: record.UID, NodeID: record.NodeID, Direction: sibling})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range siblings {
+			_, added, err := g.add(ctx, sqldb.ArtifactLineageFilter{Key: s.Key, Direction: opposite})
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, added...)
+		}
+	}
+	return next, nil
+}
+
+func toEdge(record sqldb.ArtifactLineageRecord) *artifactlineagepkg.ArtifactLineageEdge {
+	return &artifactlineagepkg.ArtifactLineageEdge{
+		Namespace:    record.Namespace,
+		WorkflowName: record.WorkflowName,
+		WorkflowUID:  record.UID,
+		NodeID:       record.NodeID,
+		Direction:    record.Direction,
+		ArtifactName: record.Name,
+		Key:          record.Key,
+		Digest:       record.Digest,
+		CreatedAt:    &metav1.Time{Time: record.CreatedAt},
+	}
+}
+
+func canListWorkflows(ctx context.Context, namespace string) error {
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
+	if err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\"", namespace))
+	}
+	return nil
+}
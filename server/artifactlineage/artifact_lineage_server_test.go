@@ -0,0 +1,94 @@
+package artifactlineage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb/mocks"
+	artifactlineagepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/artifactlineage"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func Test_artifactLineageServer(t *testing.T) {
+	repo := &mocks.ArtifactLineageRepo{}
+	kubeClient := &kubefake.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	s := NewArtifactLineageServer(repo)
+
+	// wf-a produces "a", which wf-b consumes to produce "b", which wf-c consumes
+	producerA := sqldb.ArtifactLineageRecord{Namespace: "my-ns", UID: "a", NodeID: "a-1", Direction: sqldb.ArtifactLineageOutput, Name: "out", Key: "a"}
+	consumerA := sqldb.ArtifactLineageRecord{Namespace: "my-ns", UID: "b", NodeID: "b-1", Direction: sqldb.ArtifactLineageInput, Name: "in", Key: "a"}
+	producerB := sqldb.ArtifactLineageRecord{Namespace: "my-ns", UID: "b", NodeID: "b-1", Direction: sqldb.ArtifactLineageOutput, Name: "out", Key: "b"}
+	consumerB := sqldb.ArtifactLineageRecord{Namespace: "my-ns", UID: "c", NodeID: "c-1", Direction: sqldb.ArtifactLineageInput, Name: "in", Key: "b"}
+	list := func(filter sqldb.ArtifactLineageFilter, records ...sqldb.ArtifactLineageRecord) {
+		repo.On("ListRecords", mock.Anything, filter).Return(records, nil)
+	}
+	list(sqldb.ArtifactLineageFilter{Namespace: "my-ns", Key: "b"}, producerB, consumerB)
+	list(sqldb.ArtifactLineageFilter{Namespace: "my-ns", WorkflowUID: "b", NodeID: "b-1", Direction: sqldb.ArtifactLineageInput}, consumerA)
+	list(sqldb.ArtifactLineageFilter{Namespace: "my-ns", Key: "a", Direction: sqldb.ArtifactLineageOutput}, producerA)
+	list(sqldb.ArtifactLineageFilter{Namespace: "my-ns", WorkflowUID: "c", NodeID: "c-1", Direction: sqldb.ArtifactLineageOutput})
+	list(sqldb.ArtifactLineageFilter{Namespace: "my-ns", Direction: sqldb.ArtifactLineageOutput, Limit: 2}, producerB, producerA)
+
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	t.Run("SearchArtifacts", func(t *testing.T) {
+		allowed = false
+		_, err := s.SearchArtifacts(ctx, &artifactlineagepkg.SearchArtifactsRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		resp, err := s.SearchArtifacts(ctx, &artifactlineagepkg.SearchArtifactsRequest{Namespace: "my-ns", Direction: sqldb.ArtifactLineageOutput, Limit: 2})
+		require.NoError(t, err)
+		if assert.Len(t, resp.Items, 2) {
+			assert.Equal(t, "b", resp.Items[0].WorkflowUID)
+			assert.Equal(t, "out", resp.Items[0].ArtifactName)
+		}
+	})
+	t.Run("GetArtifactLineage", func(t *testing.T) {
+		t.Run("Invalid", func(t *testing.T) {
+			_, err := s.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{Namespace: "my-ns"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = s.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{Namespace: "my-ns", Key: "b", Depth: maxDepth + 1})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+		t.Run("PermissionDenied", func(t *testing.T) {
+			allowed = false
+			defer func() { allowed = true }()
+			_, err := s.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{Namespace: "my-ns", Key: "b"})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("Direct", func(t *testing.T) {
+			graph, err := s.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{Namespace: "my-ns", Key: "b"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"b/output/b", "c/input/b"}, edgeIDs(graph))
+		})
+		t.Run("Depth", func(t *testing.T) {
+			graph, err := s.GetArtifactLineage(ctx, &artifactlineagepkg.GetArtifactLineageRequest{Namespace: "my-ns", Key: "b", Depth: 1})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"b/output/b", "c/input/b", "b/input/a", "a/output/a"}, edgeIDs(graph))
+		})
+	})
+}
+
+func edgeIDs(graph *artifactlineagepkg.ArtifactLineageGraph) []string {
+	var ids []string
+	for _, e := range graph.Edges {
+		ids = append(ids, e.WorkflowUID+"/"+e.Direction+"/"+e.Key)
+	}
+	return ids
+}
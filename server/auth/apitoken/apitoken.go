@@ -0,0 +1,170 @@
+// Package apitoken issues and verifies the scoped API tokens of the Argo Server.
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	workflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflow"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	servertypes "github.com/argoproj/argo-workflows/v4/server/types"
+)
+
+// Prefix is the prefix of the authorization of an API token, the rest is "<id>.<secret>"
+const Prefix = "Bearer argo-token:"
+
+// Verbs are the verbs a token can be restricted to
+var Verbs = []string{"get", "list", "watch", "create", "update", "delete"}
+
+// New returns a new token for the service account, and its authorization. The token records the hash of the
+// authorization's secret, not the secret.
+func New(namespace, serviceAccount string, scope sqldb.APITokenScope, now time.Time, expiresIn time.Duration) (*sqldb.APITokenRecord, string, error) {
+	for _, verb := range scope.Verbs {
+		if !slices.Contains(Verbs, verb) {
+			return nil, "", fmt.Errorf("invalid verb %q, must be one of %s", verb, strings.Join(Verbs, ", "))
+		}
+	}
+	id, err := random(8, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := random(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	token := &sqldb.APITokenRecord{
+		ID:             id,
+		Namespace:      namespace,
+		ServiceAccount: serviceAccount,
+		APITokenScope:  scope,
+		Hash:           hash(secret),
+		CreatedAt:      now.UTC(),
+		ExpiresAt:      now.Add(expiresIn).UTC(),
+	}
+	return token, Prefix + id + "." + secret, nil
+}
+
+func random(n int, encode func([]byte) string) (string, error) {
+	data := make([]byte, n)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return encode(data), nil
+}
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Parse returns the ID and secret of the authorization of an API token
+func Parse(authorization string) (string, string, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(authorization, Prefix), ".")
+	if !ok || !strings.HasPrefix(authorization, Prefix) || id == "" || secret == "" {
+		return "", "", fmt.Errorf("malformed API token")
+	}
+	return id, secret, nil
+}
+
+// Verify returns an error if the secret is not the token's, or the token has expired
+func Verify(token *sqldb.APITokenRecord, secret string, now time.Time) error {
+	if subtle.ConstantTimeCompare([]byte(hash(secret)), []byte(token.Hash)) != 1 {
+		return fmt.Errorf("API token not valid")
+	}
+	if !now.Before(token.ExpiresAt) {
+		return fmt.Errorf("API token expired")
+	}
+	return nil
+}
+
+// VerbForMethod returns the verb of the gRPC method, e.g. "create" for "/workflow.WorkflowService/SubmitWorkflow".
+// Requests which are not gRPC requests, e.g. artifact downloads, have no method and are "get".
+func VerbForMethod(fullMethod string) string {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	switch {
+	case name == "", strings.HasPrefix(name, "Get"), strings.HasPrefix(name, "Lint"), strings.HasSuffix(name, "Logs"):
+		return "get"
+	case strings.HasPrefix(name, "List"):
+		return "list"
+	case strings.HasPrefix(name, "Watch"):
+		return "watch"
+	case strings.HasPrefix(name, "Create"), strings.HasPrefix(name, "Submit"):
+		return "create"
+	case strings.HasPrefix(name, "Delete"):
+		return "delete"
+	default:
+		return "update"
+	}
+}
+
+// Authorize returns an error if the scope of the token does not allow the request
+func Authorize(token *sqldb.APITokenRecord, verb string, req any) error {
+	if len(token.Verbs) > 0 && !slices.Contains(token.Verbs, verb) {
+		return fmt.Errorf("API token does not allow %q", verb)
+	}
+	if len(token.Namespaces) > 0 {
+		namespace := ""
+		if namespacedRequest, ok := req.(servertypes.NamespacedRequest); ok {
+			namespace = namespacedRequest.GetNamespace()
+		}
+		if !slices.Contains(token.Namespaces, namespace) {
+			return fmt.Errorf("API token does not allow namespace %q", namespace)
+		}
+	}
+	if len(token.WorkflowTemplates) > 0 {
+		name, ok := workflowTemplateName(req)
+		if !ok || !slices.Contains(token.WorkflowTemplates, name) {
+			return fmt.Errorf("API token only allows requests for workflow templates %s", strings.Join(token.WorkflowTemplates, ", "))
+		}
+		if r, ok := req.(*workflowpkg.WorkflowSubmitRequest); ok && !isParametersOnly(r.SubmitOptions) {
+			return fmt.Errorf("API token only allows the name, parameters, labels and annotations of workflows submitted from workflow templates")
+		}
+	}
+	return nil
+}
+
+// isParametersOnly returns whether the options only name, parameterize and label the workflow, rather than change what
+// it runs or who runs it
+func isParametersOnly(opts *wfv1.SubmitOpts) bool {
+	return opts == nil || opts.Entrypoint == "" && opts.ServiceAccount == "" && opts.PodPriorityClassName == "" &&
+		opts.Priority == nil && opts.OwnerReference == nil && len(opts.Artifacts) == 0
+}
+
+// workflowTemplateName returns the name of the workflow template a request is for. Workflows can only be submitted
+// from a workflow template, not created with a workflowTemplateRef, as the rest of their spec is not restricted.
+func workflowTemplateName(req any) (string, bool) {
+	switch r := req.(type) {
+	case *workflowpkg.WorkflowSubmitRequest:
+		switch r.ResourceKind {
+		case workflow.WorkflowTemplateKind, workflow.WorkflowTemplateSingular, workflow.WorkflowTemplatePlural, workflow.WorkflowTemplateShortName:
+			return r.ResourceName, true
+		}
+	case *workflowtemplatepkg.WorkflowTemplateGetRequest:
+		return r.Name, true
+	case *workflowtemplatepkg.WorkflowTemplateDeleteRequest:
+		return r.Name, true
+	case *workflowtemplatepkg.WorkflowTemplateCreateRequest:
+		if r.Template != nil {
+			return r.Template.Name, true
+		}
+	case *workflowtemplatepkg.WorkflowTemplateUpdateRequest:
+		if r.Template != nil {
+			return r.Template.Name, true
+		}
+	case *workflowtemplatepkg.WorkflowTemplateLintRequest:
+		if r.Template != nil {
+			return r.Template.Name, true
+		}
+	}
+	return "", false
+}
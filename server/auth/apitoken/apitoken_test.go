@@ -0,0 +1,97 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	workflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflow"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+func TestNew(t *testing.T) {
+	now := time.Now()
+	t.Run("InvalidVerb", func(t *testing.T) {
+		_, _, err := New("my-ns", "my-sa", sqldb.APITokenScope{Verbs: []string{"patch"}}, now, time.Hour)
+		require.EqualError(t, err, `invalid verb "patch", must be one of get, list, watch, create, update, delete`)
+	})
+	token, authorization, err := New("my-ns", "my-sa", sqldb.APITokenScope{Verbs: []string{"create"}}, now, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "my-ns", token.Namespace)
+	assert.Equal(t, "my-sa", token.ServiceAccount)
+	assert.Equal(t, []string{"create"}, token.Verbs)
+	assert.True(t, now.Add(time.Hour).Equal(token.ExpiresAt))
+	id, secret, err := Parse(authorization)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, id)
+	assert.NotContains(t, token.Hash, secret)
+	t.Run("Verify", func(t *testing.T) {
+		require.NoError(t, Verify(token, secret, now))
+		require.EqualError(t, Verify(token, secret+"x", now), "API token not valid")
+		require.EqualError(t, Verify(token, secret, now.Add(time.Hour)), "API token expired")
+	})
+}
+
+func TestParse(t *testing.T) {
+	for _, authorization := range []string{"", "Bearer my-token", "Bearer argo-token:", "Bearer argo-token:my-id", "Bearer argo-token:.my-secret", "Bearer argo-token:my-id."} {
+		_, _, err := Parse(authorization)
+		require.Error(t, err, authorization)
+	}
+	id, secret, err := Parse("Bearer argo-token:my-id.my-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "my-id", id)
+	assert.Equal(t, "my-secret", secret)
+}
+
+func TestVerbForMethod(t *testing.T) {
+	for method, verb := range map[string]string{
+		"":                                         "get",
+		"/workflow.WorkflowService/GetWorkflow":    "get",
+		"/workflow.WorkflowService/WorkflowLogs":   "get",
+		"/workflow.WorkflowService/LintWorkflow":   "get",
+		"/workflow.WorkflowService/ListWorkflows":  "list",
+		"/workflow.WorkflowService/WatchEvents":    "watch",
+		"/workflow.WorkflowService/SubmitWorkflow": "create",
+		"/workflow.WorkflowService/CreateWorkflow": "create",
+		"/workflow.WorkflowService/DeleteWorkflow": "delete",
+		"/workflow.WorkflowService/RetryWorkflow":  "update",
+	} {
+		assert.Equal(t, verb, VerbForMethod(method), method)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	token := &sqldb.APITokenRecord{APITokenScope: sqldb.APITokenScope{
+		Namespaces:        []string{"prod"},
+		Verbs:             []string{"create"},
+		WorkflowTemplates: []string{"deploy-app"},
+	}}
+	submit := func(namespace, kind, name string) *workflowpkg.WorkflowSubmitRequest {
+		return &workflowpkg.WorkflowSubmitRequest{Namespace: namespace, ResourceKind: kind, ResourceName: name}
+	}
+	require.NoError(t, Authorize(token, "create", submit("prod", "WorkflowTemplate", "deploy-app")))
+	require.NoError(t, Authorize(token, "create", submit("prod", "workflowtemplate", "deploy-app")))
+	require.EqualError(t, Authorize(token, "delete", submit("prod", "WorkflowTemplate", "deploy-app")), `API token does not allow "delete"`)
+	require.EqualError(t, Authorize(token, "create", submit("dev", "WorkflowTemplate", "deploy-app")), `API token does not allow namespace "dev"`)
+	require.EqualError(t, Authorize(token, "create", submit("prod", "WorkflowTemplate", "drop-db")), "API token only allows requests for workflow templates deploy-app")
+	require.EqualError(t, Authorize(token, "create", submit("prod", "CronWorkflow", "deploy-app")), "API token only allows requests for workflow templates deploy-app")
+	require.Error(t, Authorize(token, "create", &workflowtemplatepkg.WorkflowTemplateCreateRequest{Namespace: "prod"}))
+	withOptions := func(opts *wfv1.SubmitOpts) *workflowpkg.WorkflowSubmitRequest {
+		req := submit("prod", "WorkflowTemplate", "deploy-app")
+		req.SubmitOptions = opts
+		return req
+	}
+	require.NoError(t, Authorize(token, "create", withOptions(&wfv1.SubmitOpts{GenerateName: "deploy-", Parameters: []string{"version=1.2.3"}, Labels: "team=a"})))
+	require.Error(t, Authorize(token, "create", withOptions(&wfv1.SubmitOpts{ServiceAccount: "admin"})))
+	require.Error(t, Authorize(token, "create", withOptions(&wfv1.SubmitOpts{Entrypoint: "drop-db"})))
+	require.Error(t, Authorize(token, "create", withOptions(&wfv1.SubmitOpts{Artifacts: []string{"in=s3://other/key"}})))
+	require.Error(t, Authorize(token, "create", &workflowpkg.WorkflowCreateRequest{Namespace: "prod", Workflow: &wfv1.Workflow{Spec: wfv1.WorkflowSpec{
+		WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "deploy-app"},
+		PodSpecPatch:        `{"hostNetwork": true}`,
+	}}}))
+	require.NoError(t, Authorize(&sqldb.APITokenRecord{}, "delete", &workflowpkg.WorkflowDeleteRequest{Namespace: "dev"}))
+}
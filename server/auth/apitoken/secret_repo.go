@@ -0,0 +1,130 @@
+package apitoken
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+)
+
+// SecretName is the name of the secret that records API tokens when there is no database
+const SecretName = "argo-server-api-tokens"
+
+type secretRepo struct {
+	secrets typedcorev1.SecretInterface
+	// lister reads the secret from an informer, as it is read by every request made with a token
+	lister listerscorev1.SecretNamespaceLister
+}
+
+// NewSecretRepo returns an APITokenRepo which records tokens in a secret in the namespace of the Argo Server, one key
+// per token. It watches the secret until the context is done.
+func NewSecretRepo(ctx context.Context, kubeClient kubernetes.Interface, namespace string) sqldb.APITokenRepo {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 20*time.Minute,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", SecretName).String()
+		}))
+	lister := informerFactory.Core().V1().Secrets().Lister().Secrets(namespace)
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+	return &secretRepo{secrets: kubeClient.CoreV1().Secrets(namespace), lister: lister}
+}
+
+func (r *secretRepo) CreateToken(ctx context.Context, token *sqldb.APITokenRecord) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return r.update(ctx, func(secret *corev1.Secret) {
+		pruneExpired(secret, token.CreatedAt)
+		secret.Data[token.ID] = data
+	})
+}
+
+// pruneExpired removes the tokens which have expired, so the secret does not grow without limit
+func pruneExpired(secret *corev1.Secret, now time.Time) {
+	for id, data := range secret.Data {
+		token := sqldb.APITokenRecord{}
+		if json.Unmarshal(data, &token) == nil && !now.Before(token.ExpiresAt) {
+			delete(secret.Data, id)
+		}
+	}
+}
+
+func (r *secretRepo) GetToken(ctx context.Context, id string) (*sqldb.APITokenRecord, error) {
+	secret, err := r.lister.Get(SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, ok := secret.Data[id]
+	if !ok {
+		return nil, nil
+	}
+	token := &sqldb.APITokenRecord{}
+	return token, json.Unmarshal(data, token)
+}
+
+func (r *secretRepo) ListTokens(ctx context.Context, namespace string) ([]sqldb.APITokenRecord, error) {
+	secret, err := r.secrets.Get(ctx, SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tokens []sqldb.APITokenRecord
+	for _, data := range secret.Data {
+		token := sqldb.APITokenRecord{}
+		if err := json.Unmarshal(data, &token); err != nil {
+			return nil, err
+		}
+		if namespace == "" || token.Namespace == namespace {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].CreatedAt.After(tokens[j].CreatedAt) })
+	return tokens, nil
+}
+
+func (r *secretRepo) DeleteToken(ctx context.Context, id string) error {
+	return r.update(ctx, func(secret *corev1.Secret) {
+		delete(secret.Data, id)
+	})
+}
+
+// update updates the secret, creating it if it does not exist
+func (r *secretRepo) update(ctx context.Context, f func(secret *corev1.Secret)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := r.secrets.Get(ctx, SecretName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretName}, Data: map[string][]byte{}}
+			f(secret)
+			_, err = r.secrets.Create(ctx, secret, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		f(secret)
+		_, err = r.secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+}
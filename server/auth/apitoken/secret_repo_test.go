@@ -0,0 +1,57 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestSecretRepo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	repo := NewSecretRepo(ctx, kubefake.NewClientset(), "argo")
+	got, err := repo.GetToken(ctx, "my-id")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	token := &sqldb.APITokenRecord{ID: "my-id", Namespace: "my-ns", ServiceAccount: "my-sa", APITokenScope: sqldb.APITokenScope{Verbs: []string{"get"}}, Hash: "my-hash", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, repo.CreateToken(ctx, token))
+	require.NoError(t, repo.CreateToken(ctx, &sqldb.APITokenRecord{ID: "other-id", Namespace: "other-ns", CreatedAt: now.Add(time.Second), ExpiresAt: now.Add(time.Second)}))
+
+	// the token is read from an informer, which sees the secret shortly after it is updated
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		got, err := repo.GetToken(ctx, "my-id")
+		require.NoError(c, err)
+		assert.Equal(c, token, got)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tokens, err := repo.ListTokens(ctx, "my-ns")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "my-id", tokens[0].ID)
+	tokens, err = repo.ListTokens(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "other-id", tokens[0].ID)
+
+	require.NoError(t, repo.DeleteToken(ctx, "my-id"))
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		got, err := repo.GetToken(ctx, "my-id")
+		require.NoError(c, err)
+		assert.Nil(c, got)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("PruneExpired", func(t *testing.T) {
+		require.NoError(t, repo.CreateToken(ctx, &sqldb.APITokenRecord{ID: "new-id", Namespace: "my-ns", CreatedAt: now.Add(time.Minute), ExpiresAt: now.Add(time.Hour)}))
+		tokens, err := repo.ListTokens(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, "new-id", tokens[0].ID)
+	})
+}
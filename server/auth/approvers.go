@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	authTypes "github.com/argoproj/argo-workflows/v4/server/auth/types"
+)
+
+// serviceAccountSubjectPrefix prefixes the subject of Kubernetes service account tokens.
+const serviceAccountSubjectPrefix = "system:serviceaccount:"
+
+// ssoClaims returns the claims of the user if they logged in with SSO, and nil otherwise. The claims of client and
+// server mode come from Kubernetes credentials, which can be shared by anyone, so they do not identify an approver.
+func ssoClaims(ctx context.Context) *authTypes.Claims {
+	claims := GetClaims(ctx)
+	if GetMode(ctx) != SSO || claims == nil || strings.HasPrefix(claims.Subject, serviceAccountSubjectPrefix) {
+		return nil
+	}
+	return claims
+}
+
+// Approver returns who the user is recorded as when they approve or reject an approval gate, their email if they
+// have one, otherwise their preferred username or subject. It is empty if the user did not log in with SSO, e.g. they
+// authenticated with a Kubernetes token.
+func Approver(ctx context.Context) string {
+	claims := ssoClaims(ctx)
+	switch {
+	case claims == nil:
+		return ""
+	case claims.Email != "":
+		return claims.Email
+	case claims.PreferredUsername != "":
+		return claims.PreferredUsername
+	default:
+		return claims.Subject
+	}
+}
+
+// IsApprover returns whether the user is one of the approvers, matching their subject, email and preferred username
+// against the users, and their groups against the groups. Any SSO user is an approver if there are none.
+func IsApprover(ctx context.Context, approvers wfv1.Approvers) bool {
+	claims := ssoClaims(ctx)
+	if claims == nil || Approver(ctx) == "" {
+		return false
+	}
+	if approvers.IsEmpty() {
+		return true
+	}
+	for _, user := range []string{claims.Subject, claims.Email, claims.PreferredUsername} {
+		if user != "" && slices.Contains(approvers.Users, user) {
+			return true
+		}
+	}
+	for _, group := range claims.Groups {
+		if slices.Contains(approvers.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
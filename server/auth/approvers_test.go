@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	ssomocks "github.com/argoproj/argo-workflows/v4/server/auth/sso/mocks"
+	"github.com/argoproj/argo-workflows/v4/server/auth/types"
+	servertypes "github.com/argoproj/argo-workflows/v4/server/types"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func ssoContext(claims *types.Claims) context.Context {
+	return context.WithValue(context.WithValue(context.Background(), ClaimsKey, claims), ModeKey, SSO)
+}
+
+func TestApprover(t *testing.T) {
+	assert.Empty(t, Approver(context.Background()))
+	assert.Equal(t, "my-sub", Approver(ssoContext(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})))
+	assert.Equal(t, "my-user", Approver(ssoContext(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, PreferredUsername: "my-user"})))
+	assert.Equal(t, "me@my.org", Approver(ssoContext(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, PreferredUsername: "my-user", Email: "me@my.org"})))
+	assert.Empty(t, Approver(context.WithValue(context.Background(), ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})))
+	assert.Empty(t, Approver(ssoContext(&types.Claims{Claims: jwt.Claims{Subject: "system:serviceaccount:argo:default"}})))
+}
+
+func TestIsApprover(t *testing.T) {
+	ctx := ssoContext(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "me@my.org", Groups: []string{"my-group"}})
+	assert.True(t, IsApprover(ctx, wfv1.Approvers{}))
+	assert.True(t, IsApprover(ctx, wfv1.Approvers{Users: []string{"me@my.org"}}))
+	assert.True(t, IsApprover(ctx, wfv1.Approvers{Users: []string{"my-sub"}}))
+	assert.True(t, IsApprover(ctx, wfv1.Approvers{Users: []string{"other@my.org"}, Groups: []string{"my-group"}}))
+	assert.False(t, IsApprover(ctx, wfv1.Approvers{Users: []string{"other@my.org"}, Groups: []string{"other-group"}}))
+	assert.False(t, IsApprover(context.Background(), wfv1.Approvers{}))
+}
+
+// TestIsApproverAuthModes pins that only users who logged in with SSO can approve, even though client and server mode
+// also have claims, derived from the Kubernetes credentials.
+func TestIsApproverAuthModes(t *testing.T) {
+	clients := &servertypes.Clients{}
+	clientForAuthorization := func(string, *rest.Config) (*rest.Config, *servertypes.Clients, error) {
+		return &rest.Config{Username: "system:serviceaccount:my-ns:my-sa"}, clients, nil
+	}
+	approvers := wfv1.Approvers{Users: []string{"system:serviceaccount:argo:argo-server", "system:serviceaccount:my-ns:my-sa"}}
+	t.Run("Client", func(t *testing.T) {
+		g, err := NewGatekeeper(Modes{Client: true}, clients, nil, nil, clientForAuthorization, "", "", true, nil, nil)
+		require.NoError(t, err)
+		ctx, err := g.Context(x(logging.TestContext(t.Context()), "Bearer my-token"))
+		require.NoError(t, err)
+		require.NotNil(t, GetClaims(ctx))
+		assert.Empty(t, Approver(ctx))
+		assert.False(t, IsApprover(ctx, wfv1.Approvers{}))
+		assert.False(t, IsApprover(ctx, approvers))
+	})
+	t.Run("Server", func(t *testing.T) {
+		g, err := NewGatekeeper(Modes{Server: true}, clients, &rest.Config{Username: "system:serviceaccount:argo:argo-server"}, nil, clientForAuthorization, "", "", true, nil, nil)
+		require.NoError(t, err)
+		ctx, err := g.Context(x(logging.TestContext(t.Context()), ""))
+		require.NoError(t, err)
+		require.NotNil(t, GetClaims(ctx))
+		assert.Empty(t, Approver(ctx))
+		assert.False(t, IsApprover(ctx, wfv1.Approvers{}))
+		assert.False(t, IsApprover(ctx, approvers))
+	})
+	t.Run("SSO", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything).Return(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "me@my.org"}, nil)
+		ssoIf.On("IsRBACEnabled").Return(false)
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "", "", true, nil, nil)
+		require.NoError(t, err)
+		ctx, err := g.Context(x(logging.TestContext(t.Context()), "Bearer v2:whatever"))
+		require.NoError(t, err)
+		assert.Equal(t, "me@my.org", Approver(ctx))
+		assert.True(t, IsApprover(ctx, wfv1.Approvers{Users: []string{"me@my.org"}}))
+	})
+}
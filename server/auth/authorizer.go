@@ -3,6 +3,9 @@ package auth
 import (
 	"context"
 
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	authUtil "github.com/argoproj/argo-workflows/v4/util/auth"
 )
 
@@ -14,3 +17,24 @@ func CanI(ctx context.Context, verb, resource, namespace, name string) (bool, er
 	}
 	return allowed, nil
 }
+
+// CanIResource returns whether the user can perform the verb on the resource of the API group, which can be a
+// subresource of a named resource, e.g. "create" on the "token" subresource of a service account
+func CanIResource(ctx context.Context, verb, group, resource, subresource, namespace, name string) (bool, error) {
+	review, err := GetKubeClient(ctx).AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        verb,
+				Group:       group,
+				Resource:    resource,
+				Subresource: subresource,
+				Name:        name,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
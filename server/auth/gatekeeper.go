@@ -8,10 +8,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v4/util/secrets"
 
 	events "github.com/argoproj/argo-events/pkg/client/clientset/versioned"
+	"github.com/go-jose/go-jose/v4/jwt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -22,7 +24,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	persist "github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	workflow "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v4/server/auth/apitoken"
 	"github.com/argoproj/argo-workflows/v4/server/auth/serviceaccount"
 	"github.com/argoproj/argo-workflows/v4/server/auth/sso"
 	authTypes "github.com/argoproj/argo-workflows/v4/server/auth/types"
@@ -43,6 +47,7 @@ const (
 	EventsKey  ContextKey = "events.Interface"
 	KubeKey    ContextKey = "kubernetes.Interface"
 	ClaimsKey  ContextKey = "types.Claims"
+	ModeKey    ContextKey = "auth.Mode"
 )
 
 type Gatekeeper interface {
@@ -66,9 +71,11 @@ type gatekeeper struct {
 	ssoNamespace string
 	namespaced   bool
 	cache        *cache.ResourceCache
+	// apiTokens are the API tokens issued by the Argo Server, nil if they are not enabled
+	apiTokens persist.APITokenRepo
 }
 
-func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.Config, ssoIf sso.Interface, clientForAuthorization ClientForAuthorization, namespace string, ssoNamespace string, namespaced bool, cache *cache.ResourceCache) (Gatekeeper, error) {
+func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.Config, ssoIf sso.Interface, clientForAuthorization ClientForAuthorization, namespace string, ssoNamespace string, namespaced bool, cache *cache.ResourceCache, apiTokens persist.APITokenRepo) (Gatekeeper, error) {
 	if len(modes) == 0 {
 		return nil, fmt.Errorf("must specify at least one auth mode")
 	}
@@ -82,6 +89,7 @@ func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.C
 		ssoNamespace,
 		namespaced,
 		cache,
+		apiTokens,
 	}, nil
 }
 
@@ -102,7 +110,11 @@ func (s *gatekeeper) StreamServerInterceptor() grpc.StreamServerInterceptor {
 }
 
 func (s *gatekeeper) ContextWithRequest(ctx context.Context, req any) (context.Context, error) {
-	clients, claims, err := s.getClients(ctx, req)
+	mode, authorization, err := s.getMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clients, claims, err := s.getClients(ctx, mode, authorization, req)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +123,7 @@ func (s *gatekeeper) ContextWithRequest(ctx context.Context, req any) (context.C
 	ctx = context.WithValue(ctx, EventsKey, clients.Events)
 	ctx = context.WithValue(ctx, KubeKey, clients.Kubernetes)
 	ctx = context.WithValue(ctx, ClaimsKey, claims)
+	ctx = context.WithValue(ctx, ModeKey, mode)
 	return ctx, nil
 }
 
@@ -135,6 +148,12 @@ func GetClaims(ctx context.Context) *authTypes.Claims {
 	return config
 }
 
+// GetMode returns the auth mode the user authenticated with, it is empty if they are not known.
+func GetMode(ctx context.Context) Mode {
+	mode, _ := ctx.Value(ModeKey).(Mode)
+	return mode
+}
+
 func getAuthHeaders(md metadata.MD) []string {
 	// looks for the HTTP header `Authorization: Bearer ...`
 	for _, t := range md.Get("authorization") {
@@ -157,7 +176,8 @@ func getAuthHeaders(md metadata.MD) []string {
 	return authorizations
 }
 
-func (s *gatekeeper) getClients(ctx context.Context, req any) (*servertypes.Clients, *authTypes.Claims, error) {
+// getMode returns the auth mode of the first valid authorization of the request, and that authorization.
+func (s *gatekeeper) getMode(ctx context.Context) (Mode, string, error) {
 	md, _ := metadata.FromIncomingContext(ctx)
 	authorizations := getAuthHeaders(md)
 	// Required for GetMode() with Server auth when no auth header specified
@@ -177,8 +197,12 @@ func (s *gatekeeper) getClients(ctx context.Context, req any) (*servertypes.Clie
 		}
 	}
 	if !valid {
-		return nil, nil, status.Error(codes.Unauthenticated, "token not valid. see https://argo-workflows.readthedocs.io/en/latest/faq/")
+		return "", "", status.Error(codes.Unauthenticated, "token not valid. see https://argo-workflows.readthedocs.io/en/latest/faq/")
 	}
+	return mode, authorization, nil
+}
+
+func (s *gatekeeper) getClients(ctx context.Context, mode Mode, authorization string, req any) (*servertypes.Clients, *authTypes.Claims, error) {
 	switch mode {
 	case Client:
 		restConfig, clients, err := s.clientForAuthorization(authorization, s.restConfig)
@@ -190,6 +214,8 @@ func (s *gatekeeper) getClients(ctx context.Context, req any) (*servertypes.Clie
 	case Server:
 		claims, _ := serviceaccount.ClaimSetFor(s.restConfig)
 		return s.clients, claims, nil
+	case APIToken:
+		return s.apiTokenAuthorization(ctx, authorization, req)
 	case SSO:
 		logger := logging.RequireLoggerFromContext(ctx)
 		claims, err := s.ssoIf.Authorize(authorization)
@@ -318,6 +344,48 @@ func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *authTypes.Cl
 	return s.getClientsForServiceAccount(ctx, claims, delegatedAccount)
 }
 
+// apiTokenAuthorization verifies the API token, and that its scope allows the request, then returns the clients of the
+// token's service account, so the service account's RBAC applies too
+func (s *gatekeeper) apiTokenAuthorization(ctx context.Context, authorization string, req any) (*servertypes.Clients, *authTypes.Claims, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if s.apiTokens == nil || s.cache == nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "API tokens are not enabled")
+	}
+	id, secret, err := apitoken.Parse(authorization)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	token, err := s.apiTokens.GetToken(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error(ctx, "failed to get API token")
+		return nil, nil, status.Error(codes.Internal, "failed to get API token")
+	}
+	if token == nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "API token not valid")
+	}
+	if err := apitoken.Verify(token, secret, time.Now()); err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	method, _ := grpc.Method(ctx)
+	if err := apitoken.Authorize(token, apitoken.VerbForMethod(method), req); err != nil {
+		return nil, nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	serviceAccount, err := s.cache.ServiceAccountLister.ServiceAccounts(token.Namespace).Get(token.ServiceAccount)
+	if err != nil {
+		logger.WithError(err).Error(ctx, "failed to get API token service account")
+		return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
+	}
+	claims := &authTypes.Claims{Claims: jwt.Claims{Subject: "api-token:" + token.ID}}
+	// important! write an audit entry (i.e. log entry) so we know which token performed an operation
+	logger.WithFields(logging.Fields{"apiToken": token.ID, "serviceAccount": serviceAccount.Name, "method": method}).Info(ctx, "using the service account of API token")
+	clients, err := s.getClientsForServiceAccount(ctx, claims, serviceAccount)
+	if err != nil {
+		logger.WithError(err).Error(ctx, "failed to get clients for API token service account")
+		return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
+	}
+	return clients, claims, nil
+}
+
 func (s *gatekeeper) authorizationForServiceAccount(ctx context.Context, serviceAccount *corev1.ServiceAccount) (string, error) {
 	secretName := secrets.TokenNameForServiceAccount(serviceAccount)
 	secret, err := s.cache.GetSecret(ctx, serviceAccount.GetNamespace(), secretName)
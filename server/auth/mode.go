@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/argoproj/argo-workflows/v4/server/auth/apitoken"
 	"github.com/argoproj/argo-workflows/v4/server/auth/sso"
 )
 
@@ -15,11 +16,13 @@ const (
 	Client Mode = "client"
 	Server Mode = "server"
 	SSO    Mode = "sso"
+	// APIToken is the mode of the API tokens issued by the Argo Server
+	APIToken Mode = "api-token"
 )
 
 func (m Modes) Add(value string) error {
 	switch value {
-	case "client", "server", "sso":
+	case "client", "server", "sso", "api-token":
 		m[Mode(value)] = true
 	case "hybrid":
 		m[Client] = true
@@ -34,6 +37,9 @@ func (m Modes) GetMode(authorisation string) (Mode, bool) {
 	if m[SSO] && strings.HasPrefix(authorisation, sso.Prefix) {
 		return SSO, true
 	}
+	if m[APIToken] && strings.HasPrefix(authorisation, apitoken.Prefix) {
+		return APIToken, true
+	}
 	if m[Client] && (strings.HasPrefix(authorisation, "Bearer ") || strings.HasPrefix(authorisation, "Basic ")) {
 		return Client, true
 	}
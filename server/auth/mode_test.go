@@ -32,13 +32,19 @@ func TestModes_Add(t *testing.T) {
 		require.NoError(t, m.Add("sso"))
 		assert.Contains(t, m, SSO)
 	})
+	t.Run("APIToken", func(t *testing.T) {
+		m := Modes{}
+		require.NoError(t, m.Add("api-token"))
+		assert.Contains(t, m, APIToken)
+	})
 }
 
 func TestModes_GetMode(t *testing.T) {
 	m := Modes{
-		Client: true,
-		SSO:    true,
-		Server: true,
+		Client:   true,
+		SSO:      true,
+		Server:   true,
+		APIToken: true,
 	}
 	t.Run("Client", func(t *testing.T) {
 		mode, valid := m.GetMode("Bearer ")
@@ -55,6 +61,11 @@ func TestModes_GetMode(t *testing.T) {
 		require.True(t, valid)
 		assert.Equal(t, SSO, mode)
 	})
+	t.Run("APIToken", func(t *testing.T) {
+		mode, valid := m.GetMode("Bearer argo-token:")
+		require.True(t, valid)
+		assert.Equal(t, APIToken, mode)
+	})
 
 	m = Modes{
 		Client: false,
@@ -66,4 +77,10 @@ func TestModes_GetMode(t *testing.T) {
 		require.True(t, valid)
 		assert.Equal(t, Server, mode)
 	})
+
+	t.Run("APIToken not enabled", func(t *testing.T) {
+		mode, valid := Modes{Client: true}.GetMode("Bearer argo-token:")
+		require.True(t, valid)
+		assert.Equal(t, Client, mode)
+	})
 }
@@ -0,0 +1,190 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // some webhook sources still sign with SHA-1
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// hmacOptions configures how the signature of a webhook request is verified, for sources that sign the request body
+// with an HMAC, e.g. Stripe or Slack
+type hmacOptions struct {
+	// Header is the name of the header of the signature, e.g. "X-Signature"
+	Header string `json:"header"`
+	// Algorithm is the hash algorithm, one of "sha1", "sha256" or "sha512", defaults to "sha256"
+	Algorithm string `json:"algorithm,omitempty"`
+	// Encoding is the encoding of the signature, either "hex" or "base64", defaults to "hex"
+	Encoding string `json:"encoding,omitempty"`
+	// Prefix is the prefix of the signature in the header, e.g. "sha256=" or "v0="
+	Prefix string `json:"prefix,omitempty"`
+	// SignatureKey is set if the header is a list of comma separated key=value pairs, and is the key of the
+	// signatures, e.g. "v1"
+	SignatureKey string `json:"signatureKey,omitempty"`
+	// TimestampHeader is the name of the header of the Unix time the request was signed at, e.g.
+	// "X-Slack-Request-Timestamp". If the timestamp is signed, requests outside the tolerance are rejected, as are
+	// requests that replay a signature.
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+	// TimestampKey is the key of the timestamp if it is one of the key=value pairs of the signature header, e.g. "t"
+	TimestampKey string `json:"timestampKey,omitempty"`
+	// SignedPrefix is the text signed before the timestamp, e.g. "v0:"
+	SignedPrefix string `json:"signedPrefix,omitempty"`
+	// TimestampSeparator separates the timestamp and the body in the signed content, defaults to "."
+	TimestampSeparator string `json:"timestampSeparator,omitempty"`
+	// Tolerance is the maximum age of a request, defaults to "5m"
+	Tolerance string `json:"tolerance,omitempty"`
+}
+
+func (o *hmacOptions) newHash() (func() hash.Hash, error) {
+	switch o.Algorithm {
+	case "sha1":
+		return sha1.New, nil
+	case "", "sha256":
+		return sha256.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported HMAC algorithm %q", o.Algorithm)
+	}
+}
+
+func (o *hmacOptions) decode(signature string) ([]byte, error) {
+	switch o.Encoding {
+	case "", "hex":
+		return hex.DecodeString(signature)
+	case "base64":
+		return base64.StdEncoding.DecodeString(signature)
+	default:
+		return nil, fmt.Errorf("unsupported HMAC encoding %q", o.Encoding)
+	}
+}
+
+func (o *hmacOptions) getTolerance() (time.Duration, error) {
+	if o.Tolerance == "" {
+		return 5 * time.Minute, nil
+	}
+	return time.ParseDuration(o.Tolerance)
+}
+
+func (o *hmacOptions) timestamped() bool {
+	return o.TimestampHeader != "" || o.TimestampKey != ""
+}
+
+// signedRequest is a request whose signature was verified
+type signedRequest struct {
+	signature string
+	// expiresAt is when the signature can no longer be replayed, it is zero if the request is not timestamped
+	expiresAt time.Time
+}
+
+// hmacMatch verifies the signature of the request
+func hmacMatch(client *webhookClient, r *http.Request, now time.Time) (*signedRequest, error) {
+	o := client.HMAC
+	if o == nil || o.Header == "" {
+		return nil, fmt.Errorf("hmac header is required")
+	}
+	newHash, err := o.newHash()
+	if err != nil {
+		return nil, err
+	}
+	header := r.Header.Get(o.Header)
+	if header == "" {
+		return nil, nil
+	}
+	var signatures []string
+	var timestamp string
+	if o.SignatureKey != "" {
+		for _, pair := range strings.Split(header, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+			switch key {
+			case o.SignatureKey:
+				signatures = append(signatures, value)
+			case o.TimestampKey:
+				timestamp = value
+			}
+		}
+	} else {
+		signatures = []string{header}
+	}
+	if o.TimestampHeader != "" {
+		timestamp = r.Header.Get(o.TimestampHeader)
+	}
+	signed := &signedRequest{}
+	var content strings.Builder
+	if o.timestamped() {
+		tolerance, err := o.getTolerance()
+		if err != nil {
+			return nil, err
+		}
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return nil, nil
+		}
+		signedAt := time.Unix(seconds, 0)
+		if now.Sub(signedAt).Abs() > tolerance {
+			return nil, nil
+		}
+		signed.expiresAt = signedAt.Add(tolerance)
+		separator := o.TimestampSeparator
+		if separator == "" {
+			separator = "."
+		}
+		content.WriteString(o.SignedPrefix + timestamp + separator)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	content.Write(body)
+	mac := hmac.New(newHash, []byte(client.Secret))
+	mac.Write([]byte(content.String()))
+	expected := mac.Sum(nil)
+	for _, signature := range signatures {
+		decoded, err := o.decode(strings.TrimPrefix(signature, o.Prefix))
+		if err == nil && hmac.Equal(decoded, expected) {
+			signed.signature = signature
+			return signed, nil
+		}
+	}
+	return nil, nil
+}
+
+// replayCache records the signatures of timestamped requests until they expire, so that replayed requests are
+// rejected
+type replayCache struct {
+	mu        sync.Mutex
+	expiresAt map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{expiresAt: map[string]time.Time{}}
+}
+
+// record returns false if the signature was already recorded
+func (c *replayCache) record(signed *signedRequest, now time.Time) bool {
+	if signed.expiresAt.IsZero() {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for signature, expiresAt := range c.expiresAt {
+		if !expiresAt.After(now) {
+			delete(c.expiresAt, signature)
+		}
+	}
+	if _, ok := c.expiresAt[signed.signature]; ok {
+		return false
+	}
+	c.expiresAt[signed.signature] = signed.expiresAt
+	return true
+}
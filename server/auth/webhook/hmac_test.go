@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func sign(secret, content string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
+
+func Test_hmacMatch(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	match := func(t *testing.T, o hmacOptions, headers map[string]string) *signedRequest {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/events/my-ns/my-d", bytes.NewBufferString("{}"))
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		signed, err := hmacMatch(&webhookClient{Type: "hmac", Secret: "sh!", HMAC: &o}, r, now)
+		require.NoError(t, err)
+		return signed
+	}
+	t.Run("Hex", func(t *testing.T) {
+		signed := match(t, hmacOptions{Header: "X-Signature", Prefix: "sha256="}, map[string]string{
+			"X-Signature": "sha256=" + hex.EncodeToString(sign("sh!", "{}")),
+		})
+		require.NotNil(t, signed)
+		assert.True(t, signed.expiresAt.IsZero())
+	})
+	t.Run("Base64", func(t *testing.T) {
+		assert.NotNil(t, match(t, hmacOptions{Header: "X-Signature", Encoding: "base64"}, map[string]string{
+			"X-Signature": base64.StdEncoding.EncodeToString(sign("sh!", "{}")),
+		}))
+	})
+	t.Run("WrongSignature", func(t *testing.T) {
+		assert.Nil(t, match(t, hmacOptions{Header: "X-Signature"}, map[string]string{
+			"X-Signature": hex.EncodeToString(sign("other", "{}")),
+		}))
+	})
+	t.Run("NoHeader", func(t *testing.T) {
+		assert.Nil(t, match(t, hmacOptions{Header: "X-Signature"}, nil))
+	})
+	slack := hmacOptions{Header: "X-Slack-Signature", Prefix: "v0=", TimestampHeader: "X-Slack-Request-Timestamp", SignedPrefix: "v0:", TimestampSeparator: ":"}
+	t.Run("Slack", func(t *testing.T) {
+		signed := match(t, slack, map[string]string{
+			"X-Slack-Signature":         "v0=" + hex.EncodeToString(sign("sh!", "v0:"+ts+":{}")),
+			"X-Slack-Request-Timestamp": ts,
+		})
+		require.NotNil(t, signed)
+		assert.Equal(t, now.Add(5*time.Minute), signed.expiresAt)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		old := strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+		assert.Nil(t, match(t, slack, map[string]string{
+			"X-Slack-Signature":         "v0=" + hex.EncodeToString(sign("sh!", "v0:"+old+":{}")),
+			"X-Slack-Request-Timestamp": old,
+		}))
+	})
+	t.Run("Stripe", func(t *testing.T) {
+		assert.NotNil(t, match(t, hmacOptions{Header: "Stripe-Signature", SignatureKey: "v1", TimestampKey: "t"}, map[string]string{
+			"Stripe-Signature": "t=" + ts + ",v1=" + hex.EncodeToString(sign("other", ts+".{}")) + ",v1=" + hex.EncodeToString(sign("sh!", ts+".{}")),
+		}))
+	})
+	t.Run("InvalidAlgorithm", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString("{}"))
+		_, err := hmacMatch(&webhookClient{Type: "hmac", HMAC: &hmacOptions{Header: "X-Signature", Algorithm: "md5"}}, r, now)
+		require.EqualError(t, err, `unsupported HMAC algorithm "md5"`)
+	})
+}
+
+func TestInterceptorHMAC(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	k := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argo-workflows-webhook-clients", Namespace: "my-ns"},
+			Data: map[string][]byte{
+				"slack": []byte("type: hmac\nsecret: sh!\nhmac:\n  header: X-Slack-Signature\n  prefix: v0=\n  timestampHeader: X-Slack-Request-Timestamp\n  signedPrefix: 'v0:'\n  timestampSeparator: ':'"),
+			},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "slack-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-slack-token")},
+		},
+	)
+	i := NewInterceptor(logging.RequireLoggerFromContext(ctx)).Interceptor(k)
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	intercept := func() (*http.Request, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/events/my-ns/my-d", bytes.NewBufferString("{}"))
+		r.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(sign("sh!", "v0:"+ts+":{}")))
+		r.Header.Set("X-Slack-Request-Timestamp", ts)
+		i(w, r, &testHTTPHandler{})
+		return r, w
+	}
+	r, _ := intercept()
+	assert.Equal(t, []string{"Bearer my-slack-token"}, r.Header["Authorization"])
+	// the same request is rejected if it is replayed
+	r, w := intercept()
+	assert.Empty(t, r.Header["Authorization"])
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestInterceptorHMACMisconfiguredClient(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	k := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argo-workflows-webhook-clients", Namespace: "my-ns"},
+			Data: map[string][]byte{
+				"no-header": []byte("type: hmac\nsecret: sh!"),
+				"md5":       []byte("type: hmac\nsecret: sh!\nhmac:\n  header: X-Signature\n  algorithm: md5"),
+				"valid":     []byte("type: hmac\nsecret: sh!\nhmac:\n  header: X-Signature"),
+			},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "valid-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-valid-token")},
+		},
+	)
+	i := NewInterceptor(logging.RequireLoggerFromContext(ctx)).Interceptor(k)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/events/my-ns/my-d", bytes.NewBufferString("{}"))
+	r.Header.Set("X-Signature", hex.EncodeToString(sign("sh!", "{}")))
+	i(w, r, &testHTTPHandler{})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"Bearer my-valid-token"}, r.Header["Authorization"])
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/util/secrets"
@@ -20,6 +21,8 @@ type webhookClient struct {
 	Type string `json:"type"`
 	// e.g. "shh!"
 	Secret string `json:"secret"`
+	// HMAC configures the verification of the signature of "hmac" webhooks
+	HMAC *hmacOptions `json:"hmac,omitempty"`
 }
 
 type matcher = func(secret string, r *http.Request) bool
@@ -35,11 +38,12 @@ var webhookParsers = map[string]matcher{
 const pathPrefix = "/api/v1/events/"
 
 type Interceptor struct {
-	logger logging.Logger
+	logger  logging.Logger
+	replays *replayCache
 }
 
 func NewInterceptor(logger logging.Logger) *Interceptor {
-	return &Interceptor{logger: logger}
+	return &Interceptor{logger: logger, replays: newReplayCache()}
 }
 
 // Interceptor creates an annotator that verifies webhook signatures and adds the appropriate access token to the request.
@@ -95,7 +99,23 @@ func (i *Interceptor) addWebhookAuthorization(r *http.Request, kube kubernetes.I
 			return fmt.Errorf("failed to unmarshal webhook client \"%s\": %w", serviceAccountName, err)
 		}
 		i.logger.WithFields(logging.Fields{"serviceAccountName": serviceAccountName, "webhookType": client.Type}).Debug(r.Context(), "Attempting to match webhook request")
-		ok := webhookParsers[client.Type](client.Secret, r)
+		var signed *signedRequest
+		var ok bool
+		if client.Type == "hmac" {
+			now := time.Now()
+			signed, err = hmacMatch(client, r, now)
+			if err != nil {
+				// a misconfigured client must not stop the other clients from matching
+				i.logger.WithField("serviceAccountName", serviceAccountName).WithError(err).Warn(r.Context(), "Skipping misconfigured webhook client")
+				continue
+			}
+			ok = signed != nil
+			if ok && !i.replays.record(signed, now) {
+				return fmt.Errorf("webhook request for client \"%s\" was replayed", serviceAccountName)
+			}
+		} else {
+			ok = webhookParsers[client.Type](client.Secret, r)
+		}
 		if ok {
 			i.logger.WithField("serviceAccountName", serviceAccountName).Debug(r.Context(), "Matched webhook request")
 			serviceAccount, err := serviceAccountInterface.Get(ctx, serviceAccountName, metav1.GetOptions{})
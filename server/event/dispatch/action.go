@@ -0,0 +1,124 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/util"
+)
+
+// act performs the action of the binding on the running workflows it selects
+func (o *Operation) act(ctx context.Context, wfeb wfv1.WorkflowEventBinding) error {
+	action := wfeb.Spec.Action
+	switch action.Operation {
+	case wfv1.EventActionResume, wfv1.EventActionStop, wfv1.EventActionTerminate, wfv1.EventActionSet:
+	default:
+		return fmt.Errorf("invalid action operation: %s", action.Operation)
+	}
+	values := util.SetOperationValues{}
+	if action.Message != "" {
+		message, err := o.evaluateStringExpression(action.Message, "message")
+		if err != nil {
+			return err
+		}
+		values.Message = message
+	}
+	if len(action.Parameters) > 0 {
+		if action.NodeFieldSelector == "" {
+			return fmt.Errorf("a node field selector is required to set output parameters")
+		}
+		values.OutputParameters = map[string]string{}
+		for _, p := range action.Parameters {
+			value, err := o.evaluateParameter(p)
+			if err != nil {
+				return err
+			}
+			values.OutputParameters[p.Name] = value.String()
+		}
+	}
+	//nolint: contextcheck
+	wfClient := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace)
+	names, err := o.selectWorkflows(ctx, wfClient, action.Workflows)
+	if err != nil {
+		return err
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "operation": action.Operation, "workflows": names}).Info(ctx, "Performing event action")
+	var errs []error
+	for _, name := range names {
+		if err := o.actOn(ctx, wfClient, name, action, values); err != nil {
+			errs = append(errs, fmt.Errorf("failed to %s workflow %s: %w", strings.ToLower(string(action.Operation)), name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (o *Operation) actOn(ctx context.Context, wfClient v1alpha1.WorkflowInterface, name string, action *wfv1.EventAction, values util.SetOperationValues) error {
+	switch action.Operation {
+	case wfv1.EventActionResume:
+		if len(values.OutputParameters) > 0 {
+			values.Phase = wfv1.NodeSucceeded
+			return util.SetWorkflow(ctx, wfClient, o.hydrator, name, action.NodeFieldSelector, values)
+		}
+		return util.ResumeWorkflow(ctx, wfClient, o.hydrator, name, action.NodeFieldSelector)
+	case wfv1.EventActionStop:
+		return util.StopWorkflow(ctx, wfClient, o.hydrator, name, action.NodeFieldSelector, values.Message)
+	case wfv1.EventActionTerminate:
+		return util.TerminateWorkflow(ctx, wfClient, name)
+	default:
+		return util.SetWorkflow(ctx, wfClient, o.hydrator, name, action.NodeFieldSelector, values)
+	}
+}
+
+// selectWorkflows returns the names of the running workflows which have the name and labels of the selector
+func (o *Operation) selectWorkflows(ctx context.Context, wfClient v1alpha1.WorkflowInterface, selector wfv1.EventWorkflowSelector) ([]string, error) {
+	if selector.Name == "" && len(selector.Labels) == 0 {
+		return nil, fmt.Errorf("a workflow name or labels are required to select workflows")
+	}
+	wfLabels := map[string]string{}
+	for key, value := range selector.Labels {
+		evalValue, err := o.evaluateStringExpression(value, fmt.Sprintf("label \"%s\"", key))
+		if err != nil {
+			return nil, err
+		}
+		wfLabels[key] = evalValue
+	}
+	labelSelector := k8slabels.SelectorFromSet(wfLabels)
+	if selector.Name != "" {
+		name, err := o.evaluateStringExpression(selector.Name, "name")
+		if err != nil {
+			return nil, err
+		}
+		wf, err := wfClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow: %w", err)
+		}
+		if err := o.instanceIDService.Validate(wf); err != nil {
+			return nil, fmt.Errorf("failed to validate workflow instanceid: %w", err)
+		}
+		if wf.Status.Fulfilled() || !labelSelector.Matches(k8slabels.Set(wf.Labels)) {
+			return nil, nil
+		}
+		return []string{wf.Name}, nil
+	}
+	options := metav1.ListOptions{LabelSelector: labelSelector.String() + "," + common.LabelKeyCompleted + "!=true"}
+	o.instanceIDService.With(&options)
+	list, err := wfClient.List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	names := make([]string, len(list.Items))
+	for i, wf := range list.Items {
+		names[i] = wf.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,135 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v4/server/auth"
+	"github.com/argoproj/argo-workflows/v4/util/instanceid"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v4/workflow/hydrator/fake"
+)
+
+func suspendedWorkflow(name string, wfLabels map[string]string) *wfv1.Workflow {
+	wfLabels[common.LabelKeyControllerInstanceID] = "my-instanceid"
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: wfLabels},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowRunning,
+			Nodes: wfv1.Nodes{
+				name + "-approve": {
+					ID: name + "-approve", Name: name + ".approve", DisplayName: "approve",
+					Type: wfv1.NodeTypeSuspend, Phase: wfv1.NodeRunning,
+					Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Supplied: &wfv1.SuppliedValueFrom{}}}}},
+				},
+			},
+		},
+	}
+}
+
+func TestDispatchAction(t *testing.T) {
+	completed := suspendedWorkflow("completed", map[string]string{"commit": "abc", common.LabelKeyCompleted: "true"})
+	completed.Status.Phase = wfv1.WorkflowSucceeded
+
+	// dispatch dispatches the event to a binding with the action, and returns the workflows
+	dispatch := func(t *testing.T, action wfv1.EventAction, recorder *record.FakeRecorder) (map[string]wfv1.Workflow, error) {
+		t.Helper()
+		client := fake.NewClientset(
+			suspendedWorkflow("my-wf", map[string]string{"commit": "abc"}),
+			suspendedWorkflow("other-wf", map[string]string{"commit": "abc"}),
+			suspendedWorkflow("unselected-wf", map[string]string{"commit": "def"}),
+			completed,
+		)
+		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+		op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, NewLimits(sqldb.NewMemoryEventDeduplicationRepo()), []wfv1.WorkflowEventBinding{{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+			Spec:       wfv1.WorkflowEventBindingSpec{Event: wfv1.Event{Selector: "true"}, Action: &action},
+		}}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{"workflow": "my-wf", "commit": "abc", "user": "alice"}`)})
+		require.NoError(t, err)
+		dispatchErr := op.Dispatch(ctx)
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		wfs := map[string]wfv1.Workflow{}
+		for _, wf := range list.Items {
+			wfs[wf.Name] = wf
+		}
+		return wfs, dispatchErr
+	}
+	approve := func(wf wfv1.Workflow) wfv1.NodeStatus {
+		return wf.Status.Nodes[wf.Name+"-approve"]
+	}
+
+	t.Run("ResumeByName", func(t *testing.T) {
+		wfs, err := dispatch(t, wfv1.EventAction{
+			Operation:         wfv1.EventActionResume,
+			Workflows:         wfv1.EventWorkflowSelector{Name: "payload.workflow"},
+			NodeFieldSelector: "displayName=approve",
+			Parameters:        []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Event: "payload.user"}}},
+			Message:           `"approved by " + payload.user`,
+		}, record.NewFakeRecorder(6))
+		require.NoError(t, err)
+		node := approve(wfs["my-wf"])
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "approved by alice", node.Message)
+		assert.Equal(t, "alice", node.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, wfv1.NodeRunning, approve(wfs["other-wf"]).Phase, "only the named workflow is resumed")
+	})
+	t.Run("StopByLabels", func(t *testing.T) {
+		wfs, err := dispatch(t, wfv1.EventAction{
+			Operation: wfv1.EventActionStop,
+			Workflows: wfv1.EventWorkflowSelector{Labels: map[string]string{"commit": "payload.commit"}},
+		}, record.NewFakeRecorder(6))
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.ShutdownStrategyStop, wfs["my-wf"].Spec.Shutdown)
+		assert.Equal(t, wfv1.ShutdownStrategyStop, wfs["other-wf"].Spec.Shutdown)
+		assert.Empty(t, wfs["unselected-wf"].Spec.Shutdown)
+		assert.Empty(t, wfs["completed"].Spec.Shutdown, "completed workflows are not selected")
+	})
+	t.Run("Terminate", func(t *testing.T) {
+		wfs, err := dispatch(t, wfv1.EventAction{
+			Operation: wfv1.EventActionTerminate,
+			Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow", Labels: map[string]string{"commit": `"def"`}},
+		}, record.NewFakeRecorder(6))
+		require.NoError(t, err)
+		assert.Empty(t, wfs["my-wf"].Spec.Shutdown, "the workflow does not have the labels")
+	})
+	t.Run("Set", func(t *testing.T) {
+		wfs, err := dispatch(t, wfv1.EventAction{
+			Operation:         wfv1.EventActionSet,
+			Workflows:         wfv1.EventWorkflowSelector{Name: "payload.workflow"},
+			NodeFieldSelector: "displayName=approve",
+			Parameters:        []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Event: "payload.user"}}},
+		}, record.NewFakeRecorder(6))
+		require.NoError(t, err)
+		node := approve(wfs["my-wf"])
+		assert.Equal(t, wfv1.NodeRunning, node.Phase, "the node is not resumed")
+		assert.Equal(t, "alice", node.Outputs.Parameters[0].Value.String())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			action wfv1.EventAction
+			want   string
+		}{
+			"Operation":         {wfv1.EventAction{Operation: "Pause", Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}}, "invalid action operation: Pause"},
+			"Selector":          {wfv1.EventAction{Operation: wfv1.EventActionStop}, "a workflow name or labels are required to select workflows"},
+			"NodeFieldSelector": {wfv1.EventAction{Operation: wfv1.EventActionSet, Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}, Parameters: []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Event: "payload.user"}}}}, "a node field selector is required to set output parameters"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				recorder := record.NewFakeRecorder(6)
+				_, err := dispatch(t, tt.action, recorder)
+				require.Error(t, err)
+				assert.Contains(t, <-recorder.Events, tt.want)
+			})
+		}
+	})
+}
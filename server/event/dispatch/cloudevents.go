@@ -0,0 +1,105 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+// cloudEventHeaderPrefix is the prefix of the HTTP headers of the attributes of a CloudEvent in binary mode
+const cloudEventHeaderPrefix = "ce-"
+
+// cloudEventContentType is the content type of a CloudEvent in structured mode
+const cloudEventContentType = "application/cloudevents+json"
+
+// cloudEvent returns the context attributes and data of the event if it is a CloudEvent v1.0, either in binary mode,
+// where the attributes are `ce-` headers and the payload is the data, or in structured mode, where the content type is
+// application/cloudevents+json and the payload is the JSON encoded event. Extension attributes are returned with the
+// other attributes.
+func cloudEvent(ctx context.Context, payload *wfv1.Item) (map[string]any, *wfv1.Item, bool, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(cloudEventHeaderPrefix+"specversion")) > 0 {
+		attributes := map[string]any{}
+		for k, v := range md {
+			if name, ok := strings.CutPrefix(k, cloudEventHeaderPrefix); ok && len(v) > 0 {
+				attributes[name] = v[0]
+			}
+		}
+		if err := validateCloudEvent(attributes); err != nil {
+			return nil, nil, false, err
+		}
+		return attributes, payload, true, nil
+	}
+	// other payloads are kept as they are, even if they look like an event
+	if payload == nil || !isCloudEventContentType(md) {
+		return nil, nil, false, nil
+	}
+	attributes := map[string]any{}
+	if err := json.Unmarshal(payload.Value, &attributes); err != nil {
+		return nil, nil, false, fmt.Errorf("failed to unmarshal structured CloudEvent: %w", err)
+	}
+	if err := validateCloudEvent(attributes); err != nil {
+		return nil, nil, false, err
+	}
+	data, err := cloudEventData(attributes)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	delete(attributes, "data")
+	delete(attributes, "data_base64")
+	return attributes, data, true, nil
+}
+
+// isCloudEventContentType returns true if the content type of the request is that of a structured mode event. The
+// header is forwarded with the grpc-gateway prefix, as gRPC reserves Content-Type for itself.
+func isCloudEventContentType(md metadata.MD) bool {
+	for _, contentType := range md.Get(runtime.MetadataPrefix + "content-type") {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == cloudEventContentType {
+			return true
+		}
+	}
+	return false
+}
+
+func validateCloudEvent(attributes map[string]any) error {
+	if specVersion := attributes["specversion"]; specVersion != "1.0" {
+		return fmt.Errorf("unsupported CloudEvents specversion: %v", specVersion)
+	}
+	for _, name := range []string{"id", "source", "type"} {
+		if value, _ := attributes[name].(string); value == "" {
+			return fmt.Errorf("CloudEvent attribute %q is required", name)
+		}
+	}
+	return nil
+}
+
+// cloudEventData returns the data of a structured mode event, binary data must be JSON
+func cloudEventData(attributes map[string]any) (*wfv1.Item, error) {
+	if data, ok := attributes["data"]; ok {
+		value, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		return &wfv1.Item{Value: value}, nil
+	}
+	encoded, ok := attributes["data_base64"].(string)
+	if !ok {
+		return nil, nil
+	}
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode CloudEvent data_base64: %w", err)
+	}
+	if !json.Valid(value) {
+		return nil, fmt.Errorf("CloudEvent data must be JSON")
+	}
+	return &wfv1.Item{Value: value}, nil
+}
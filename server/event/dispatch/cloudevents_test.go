@@ -0,0 +1,83 @@
+package dispatch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func Test_expressionEnvironment_CloudEvents(t *testing.T) {
+	structured := func(t *testing.T) context.Context {
+		return metadata.NewIncomingContext(logging.TestContext(t.Context()), metadata.MD{
+			"grpcgateway-content-type": []string{"application/cloudevents+json; charset=utf-8"},
+		})
+	}
+	t.Run("Binary", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(logging.TestContext(t.Context()), metadata.MD{
+			"ce-specversion": []string{"1.0"},
+			"ce-id":          []string{"1"},
+			"ce-source":      []string{"my-source"},
+			"ce-type":        []string{"com.example.push"},
+			"ce-subject":     []string{"main"},
+			"ce-myextension": []string{"my-value"},
+		})
+		env, err := expressionEnvironment(ctx, "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"foo":"bar"}`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"specversion": "1.0",
+			"id":          "1",
+			"source":      "my-source",
+			"type":        "com.example.push",
+			"subject":     "main",
+			"myextension": "my-value",
+		}, env["ce"])
+		assert.Equal(t, map[string]any{"foo": "bar"}, env["payload"])
+	})
+	t.Run("Structured", func(t *testing.T) {
+		env, err := expressionEnvironment(structured(t), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"specversion":"1.0","id":"1","source":"my-source","type":"com.example.push","myextension":"my-value","data":{"foo":"bar"}}`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"specversion": "1.0",
+			"id":          "1",
+			"source":      "my-source",
+			"type":        "com.example.push",
+			"myextension": "my-value",
+		}, env["ce"])
+		assert.Equal(t, map[string]any{"foo": "bar"}, env["payload"])
+	})
+	t.Run("StructuredBase64", func(t *testing.T) {
+		env, err := expressionEnvironment(structured(t), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"specversion":"1.0","id":"1","source":"my-source","type":"com.example.push","data_base64":"eyJmb28iOiJiYXIifQ=="}`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"foo": "bar"}, env["payload"])
+	})
+	t.Run("NotCloudEvent", func(t *testing.T) {
+		env, err := expressionEnvironment(logging.TestContext(t.Context()), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"type":"push"}`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{}, env["ce"])
+		assert.Equal(t, map[string]any{"type": "push"}, env["payload"])
+	})
+	t.Run("JSONContentType", func(t *testing.T) {
+		// an event is only structured mode if its content type says so, so other payloads are kept as they are
+		payload := `{"specversion":"0.3","id":"1","source":"my-source","type":"com.example.push","data":{"foo":"bar"}}`
+		ctx := metadata.NewIncomingContext(logging.TestContext(t.Context()), metadata.MD{"grpcgateway-content-type": []string{"application/json"}})
+		env, err := expressionEnvironment(ctx, "my-ns", "my-d", &wfv1.Item{Value: []byte(payload)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{}, env["ce"])
+		assert.Equal(t, "0.3", env["payload"].(map[string]any)["specversion"])
+	})
+	t.Run("UnsupportedSpecVersion", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(logging.TestContext(t.Context()), metadata.MD{"ce-specversion": []string{"0.3"}})
+		_, err := expressionEnvironment(ctx, "my-ns", "my-d", &wfv1.Item{Value: []byte(`{}`)})
+		require.EqualError(t, err, "unsupported CloudEvents specversion: 0.3")
+	})
+	t.Run("MissingAttribute", func(t *testing.T) {
+		_, err := expressionEnvironment(structured(t), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"specversion":"1.0","id":"1","source":"","type":"com.example.push"}`)})
+		require.EqualError(t, err, `CloudEvent attribute "source" is required`)
+	})
+}
@@ -0,0 +1,71 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+)
+
+// Limits is the state shared by operations to deduplicate and rate limit the events of workflow event bindings
+type Limits struct {
+	deduplicationRepo sqldb.EventDeduplicationRepo
+	mu                sync.Mutex
+	// rateLimiters is the rate limiter of each binding, by namespace and name
+	rateLimiters map[[2]string]*bindingRateLimiter
+}
+
+type bindingRateLimiter struct {
+	rateLimit wfv1.EventRateLimit
+	limiter   *rate.Limiter
+}
+
+// NewLimits returns new limits, which record the keys of events in the repo. Rate limits are held in memory, so they
+// are per replica of the Argo Server.
+func NewLimits(deduplicationRepo sqldb.EventDeduplicationRepo) *Limits {
+	return &Limits{deduplicationRepo: deduplicationRepo, rateLimiters: map[[2]string]*bindingRateLimiter{}}
+}
+
+// deduplicate returns false if an event with the key was received by the binding within the window
+func (l *Limits) deduplicate(ctx context.Context, wfeb wfv1.WorkflowEventBinding, key string) (bool, error) {
+	window, err := wfeb.Spec.Deduplication.GetWindow()
+	if err != nil {
+		return false, err
+	}
+	return l.deduplicationRepo.Record(ctx, wfeb.Namespace, wfeb.Name, key, window)
+}
+
+// forget deletes the key of an event of the binding, so that it is not a duplicate of later events
+func (l *Limits) forget(ctx context.Context, wfeb wfv1.WorkflowEventBinding, key string) error {
+	return l.deduplicationRepo.Delete(ctx, wfeb.Namespace, wfeb.Name, key)
+}
+
+// allow returns false if the event exceeds the rate limit of the binding
+func (l *Limits) allow(wfeb wfv1.WorkflowEventBinding) (bool, error) {
+	rateLimit := *wfeb.Spec.RateLimit
+	unit, err := rateLimit.GetUnit()
+	if err != nil {
+		return false, err
+	}
+	if rateLimit.RequestsPerUnit <= 0 {
+		return false, fmt.Errorf("rate limit requestsPerUnit must be greater than zero")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	k := [2]string{wfeb.Namespace, wfeb.Name}
+	r, ok := l.rateLimiters[k]
+	// the rate limiter is replaced when the binding's rate limit changes
+	if !ok || r.rateLimit != rateLimit {
+		r = &bindingRateLimiter{
+			rateLimit: rateLimit,
+			limiter:   rate.NewLimiter(rate.Every(unit/time.Duration(rateLimit.RequestsPerUnit)), int(rateLimit.RequestsPerUnit)),
+		}
+		l.rateLimiters[k] = r
+	}
+	return r.limiter.Allow(), nil
+}
@@ -3,6 +3,7 @@ package dispatch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/expr-lang/expr"
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -26,6 +28,7 @@ import (
 	waitutil "github.com/argoproj/argo-workflows/v4/util/wait"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
 	"github.com/argoproj/argo-workflows/v4/workflow/creator"
+	"github.com/argoproj/argo-workflows/v4/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v4/workflow/util"
 )
 
@@ -34,6 +37,8 @@ type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
 	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
+	limits            *Limits
 	events            []wfv1.WorkflowEventBinding
 	env               map[string]any
 }
@@ -43,7 +48,7 @@ func (o *Operation) Context() context.Context {
 	return o.ctx
 }
 
-func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
+func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, hydrator hydrator.Interface, limits *Limits, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
 	env, err := expressionEnvironment(ctx, namespace, discriminator, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow template expression environment: %w", err)
@@ -52,6 +57,8 @@ func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eve
 		ctx:               ctx,
 		eventRecorder:     eventRecorder,
 		instanceIDService: instanceIDService,
+		hydrator:          hydrator,
+		limits:            limits,
 		events:            events,
 		env:               env,
 	}, nil
@@ -69,11 +76,21 @@ func (o *Operation) Dispatch(ctx context.Context) error {
 
 	var errs []error
 	for _, event := range o.events {
-		err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
-			_, err := o.dispatch(ctx, event)
-			return !errorsutil.IsTransientErr(ctx, err), err
-		})
+		// the event is admitted once, so that retries are not deduplicated or rate limited
+		admitted, err := o.admit(ctx, event)
+		if err == nil && admitted && event.Spec.Action != nil {
+			// the workflow utilities retry transient errors themselves
+			err = o.act(ctx, event)
+		} else if err == nil && admitted {
+			err = waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
+				_, err := o.dispatch(ctx, event)
+				return !errorsutil.IsTransientErr(ctx, err), err
+			})
+		}
 		if err != nil {
+			if admitted {
+				o.forget(ctx, event)
+			}
 			logger.WithError(err).WithFields(logging.Fields{"namespace": event.Namespace, "event": event.Name}).Error(ctx, "failed to dispatch from event")
 			o.eventRecorder.Event(&event, corev1.EventTypeWarning, "WorkflowEventBindingError", "failed to dispatch event: "+err.Error())
 			errs = append(errs, err)
@@ -85,17 +102,126 @@ func (o *Operation) Dispatch(ctx context.Context) error {
 	return nil
 }
 
-func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (*wfv1.Workflow, error) {
+// admit returns true if the event matches the binding, and is not dropped by its deduplication, rate limit or
+// concurrency policy
+func (o *Operation) admit(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (bool, error) {
 	logger := logging.RequireLoggerFromContext(ctx)
 
 	selector := wfeb.Spec.Event.Selector
 	matched, err := argoexpr.EvalBool(selector, o.env)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
+		return false, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
 	}
 	logger.WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "selector": selector, "matched": matched}).Debug(ctx, "Selector evaluation")
+	if wfeb.Spec.Submit != nil && wfeb.Spec.Action != nil {
+		return false, fmt.Errorf("submit and action are mutually exclusive")
+	}
+	if !matched || (wfeb.Spec.Submit == nil && wfeb.Spec.Action == nil) {
+		return false, nil
+	}
+	// deduplication is first, so that duplicate events do not count towards the rate limit. The key is recorded now,
+	// so that concurrent duplicates are dropped, and forgotten if the event is not dispatched, so that it can be retried.
+	if dedup := wfeb.Spec.Deduplication; dedup != nil {
+		key, err := o.evaluateKeyExpression(dedup.Key)
+		if err != nil {
+			return false, err
+		}
+		recorded, err := o.limits.deduplicate(ctx, wfeb, key)
+		if err != nil {
+			return false, fmt.Errorf("failed to deduplicate event: %w", err)
+		}
+		if !recorded {
+			o.drop(ctx, wfeb, "WorkflowEventBindingDeduplicated", "event dropped as a duplicate of an earlier event")
+			return false, nil
+		}
+	}
+	admitted, err := o.applyLimits(ctx, wfeb)
+	if err != nil || !admitted {
+		o.forget(ctx, wfeb)
+	}
+	return admitted, err
+}
+
+// applyLimits returns false if the event is dropped by the rate limit or concurrency policy of the binding
+func (o *Operation) applyLimits(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (bool, error) {
+	if wfeb.Spec.RateLimit != nil {
+		allowed, err := o.limits.allow(wfeb)
+		if err != nil {
+			return false, err
+		}
+		if !allowed {
+			o.drop(ctx, wfeb, "WorkflowEventBindingRateLimited", "event dropped as it exceeds the rate limit")
+			return false, nil
+		}
+	}
+	return o.applyConcurrencyPolicy(ctx, wfeb)
+}
+
+// forget deletes the deduplication key of an event which was not dispatched, so that a retry of the event is not
+// dropped as a duplicate
+func (o *Operation) forget(ctx context.Context, wfeb wfv1.WorkflowEventBinding) {
+	dedup := wfeb.Spec.Deduplication
+	if dedup == nil {
+		return
+	}
+	key, err := o.evaluateKeyExpression(dedup.Key)
+	if err == nil {
+		err = o.limits.forget(ctx, wfeb, key)
+	}
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name}).Warn(ctx, "failed to forget deduplication key of event")
+	}
+}
+
+// applyConcurrencyPolicy returns false if the event is dropped as a workflow submitted by the binding is running, or
+// stops the running workflows if they are to be replaced
+func (o *Operation) applyConcurrencyPolicy(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (bool, error) {
+	policy := wfeb.Spec.ConcurrencyPolicy
+	if wfeb.Spec.Submit == nil {
+		// the policy only applies to submitting workflows
+		return true, nil
+	}
+	switch policy {
+	case wfv1.AllowConcurrent, "":
+		return true, nil
+	case wfv1.ForbidConcurrent, wfv1.ReplaceConcurrent:
+	default:
+		return false, fmt.Errorf("invalid concurrencyPolicy: %s", policy)
+	}
+	//nolint: contextcheck
+	wfClient := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace)
+	options := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s,%s!=true", common.LabelKeyWorkflowEventBinding, wfeb.Name, common.LabelKeyCompleted)}
+	o.instanceIDService.With(&options)
+	running, err := wfClient.List(ctx, options)
+	if err != nil {
+		return false, fmt.Errorf("failed to list running workflows: %w", err)
+	}
+	if len(running.Items) == 0 {
+		return true, nil
+	}
+	if policy == wfv1.ForbidConcurrent {
+		o.drop(ctx, wfeb, "WorkflowEventBindingConcurrencyForbidden", "event dropped as a workflow submitted by the binding is running")
+		return false, nil
+	}
+	for _, wf := range running.Items {
+		err := util.TerminateWorkflow(ctx, wfClient, wf.Name)
+		var alreadyShutdownErr util.AlreadyShutdownError
+		if err != nil && !apierrors.IsNotFound(err) && !errors.As(err, &alreadyShutdownErr) {
+			return false, fmt.Errorf("failed to stop workflow %s: %w", wf.Name, err)
+		}
+	}
+	return true, nil
+}
+
+// drop records that the event was dropped by the binding
+func (o *Operation) drop(ctx context.Context, wfeb wfv1.WorkflowEventBinding, reason, message string) {
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "reason": reason}).Info(ctx, message)
+	o.eventRecorder.Event(&wfeb, corev1.EventTypeNormal, reason, message)
+}
+
+func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (*wfv1.Workflow, error) {
 	submit := wfeb.Spec.Submit
-	if matched && submit != nil {
+	if submit != nil {
 		//nolint: contextcheck
 		client := auth.GetWfClient(o.ctx)
 		ref := wfeb.Spec.Submit.WorkflowTemplateRef
@@ -143,22 +269,11 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
 		if submit.Arguments != nil {
 			for _, p := range submit.Arguments.Parameters {
-				if p.ValueFrom == nil {
-					return nil, fmt.Errorf("malformed workflow template parameter \"%s\": valueFrom is nil", p.Name)
+				value, err := o.evaluateParameter(p)
+				if err != nil {
+					return nil, err
 				}
-				program, compileErr := expr.Compile(p.ValueFrom.Event, expr.Env(o.env))
-				if compileErr != nil {
-					return nil, fmt.Errorf("failed to compile workflow template parameter %s expression: %w", p.Name, compileErr)
-				}
-				result, runErr := expr.Run(program, o.env)
-				if runErr != nil {
-					return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, runErr)
-				}
-				data, marshalErr := json.Marshal(result)
-				if marshalErr != nil {
-					return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, marshalErr)
-				}
-				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
+				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: value})
 			}
 		}
 		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
@@ -170,6 +285,26 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 	return nil, nil
 }
 
+// evaluateParameter returns the value of the parameter extracted from the event
+func (o *Operation) evaluateParameter(p wfv1.Parameter) (*wfv1.AnyString, error) {
+	if p.ValueFrom == nil {
+		return nil, fmt.Errorf("malformed workflow template parameter \"%s\": valueFrom is nil", p.Name)
+	}
+	program, err := expr.Compile(p.ValueFrom.Event, expr.Env(o.env))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile workflow template parameter %s expression: %w", p.Name, err)
+	}
+	result, err := expr.Run(program, o.env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
+	}
+	return wfv1.AnyStringPtr(wfv1.Item{Value: data}), nil
+}
+
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
 	if len(metadata.Name) > 0 {
 		evalName, err := o.evaluateStringExpression(metadata.Name, "name")
@@ -211,6 +346,22 @@ func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1
 	return nil
 }
 
+// evaluateKeyExpression returns the deduplication key of the event, a key which is not a string is converted to JSON
+func (o *Operation) evaluateKeyExpression(statement string) (string, error) {
+	result, err := expr.Eval(statement, exprenv.GetFuncMap(o.env))
+	if err != nil {
+		return "", fmt.Errorf("failed to evaluate deduplication key expression: %w", err)
+	}
+	if v, ok := result.(string); ok {
+		return v, nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert deduplication key to JSON: %w", err)
+	}
+	return string(data), nil
+}
+
 func (o *Operation) evaluateStringExpression(statement string, errorInfo string) (string, error) {
 	env := exprenv.GetFuncMap(o.env)
 	program, err := expr.Compile(statement, expr.Env(env))
@@ -230,11 +381,22 @@ func (o *Operation) evaluateStringExpression(statement string, errorInfo string)
 }
 
 func expressionEnvironment(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) (map[string]any, error) {
+	// the payload of a CloudEvent is its data, and its attributes are `ce`
+	attributes, data, ok, err := cloudEvent(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		payload = data
+	} else {
+		attributes = map[string]any{}
+	}
 	src := map[string]any{
 		"namespace":     namespace,
 		"discriminator": discriminator,
 		"metadata":      metaData(ctx),
 		"payload":       payload,
+		"ce":            attributes,
 	}
 	return jsonutil.Jsonify(src)
 }
@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
@@ -21,6 +22,7 @@ import (
 	"github.com/argoproj/argo-workflows/v4/util/instanceid"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v4/workflow/hydrator/fake"
 )
 
 func Test_metaData(t *testing.T) {
@@ -64,7 +66,7 @@ func TestNewOperation(t *testing.T) {
 	recorder := record.NewFakeRecorder(6)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, NewLimits(sqldb.NewMemoryEventDeduplicationRepo()), []wfv1.WorkflowEventBinding{
 		// test a malformed binding
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "malformed", Namespace: "my-ns"},
@@ -211,7 +213,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 	recorder := record.NewFakeRecorder(10)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, NewLimits(sqldb.NewMemoryEventDeduplicationRepo()), []wfv1.WorkflowEventBinding{
 		{
 			// No name specified
 			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"},
@@ -427,7 +429,7 @@ func TestDispatchWorkflowMetadata(t *testing.T) {
 		client := fake.NewClientset(tmpl)
 		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
 		ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
-		op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), record.NewFakeRecorder(6),
+		op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), record.NewFakeRecorder(6), hydratorfake.Noop, NewLimits(sqldb.NewMemoryEventDeduplicationRepo()),
 			[]wfv1.WorkflowEventBinding{binding}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{}`)})
 		require.NoError(t, err)
 		require.NoError(t, op.Dispatch(ctx))
@@ -471,6 +473,112 @@ func TestDispatchWorkflowMetadata(t *testing.T) {
 	})
 }
 
+func TestDispatchLimits(t *testing.T) {
+	tmpl := &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+	}
+	binding := func(spec wfv1.WorkflowEventBindingSpec) wfv1.WorkflowEventBinding {
+		spec.Event = wfv1.Event{Selector: "true"}
+		spec.Submit = &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}}
+		return wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"}, Spec: spec}
+	}
+	// dispatch dispatches each payload in turn, and returns the workflows
+	dispatch := func(t *testing.T, wfeb wfv1.WorkflowEventBinding, recorder *record.FakeRecorder, payloads ...string) []wfv1.Workflow {
+		t.Helper()
+		client := fake.NewClientset(tmpl)
+		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+		limits := NewLimits(sqldb.NewMemoryEventDeduplicationRepo())
+		for _, payload := range payloads {
+			op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, limits, []wfv1.WorkflowEventBinding{wfeb}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(payload)})
+			require.NoError(t, err)
+			require.NoError(t, op.Dispatch(ctx))
+		}
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		return list.Items
+	}
+
+	t.Run("Deduplication", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(6)
+		wfs := dispatch(t, binding(wfv1.WorkflowEventBindingSpec{
+			Deduplication: &wfv1.EventDeduplication{Key: "payload.id", Window: "1h"},
+		}), recorder, `{"id": 1}`, `{"id": 2}`, `{"id": 1}`)
+		assert.Len(t, wfs, 2)
+		assert.Equal(t, "Normal WorkflowEventBindingDeduplicated event dropped as a duplicate of an earlier event", <-recorder.Events)
+	})
+	t.Run("DeduplicationRetry", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(6)
+		client := fake.NewClientset()
+		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+		limits := NewLimits(sqldb.NewMemoryEventDeduplicationRepo())
+		wfeb := binding(wfv1.WorkflowEventBindingSpec{Deduplication: &wfv1.EventDeduplication{Key: "payload.id", Window: "1h"}})
+		dispatch := func() error {
+			op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, limits, []wfv1.WorkflowEventBinding{wfeb}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{"id": 1}`)})
+			require.NoError(t, err)
+			return op.Dispatch(ctx)
+		}
+		// the template does not exist, so the event fails to be dispatched
+		require.Error(t, dispatch())
+		assert.Contains(t, <-recorder.Events, "WorkflowEventBindingError")
+		_, err := client.ArgoprojV1alpha1().WorkflowTemplates("my-ns").Create(ctx, tmpl, metav1.CreateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, dispatch(), "the retry is not a duplicate of the failed event")
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 1)
+		require.NoError(t, dispatch())
+		assert.Equal(t, "Normal WorkflowEventBindingDeduplicated event dropped as a duplicate of an earlier event", <-recorder.Events)
+	})
+	t.Run("RateLimit", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(6)
+		wfs := dispatch(t, binding(wfv1.WorkflowEventBindingSpec{
+			RateLimit: &wfv1.EventRateLimit{Unit: wfv1.EventRateLimitUnitHour, RequestsPerUnit: 2},
+		}), recorder, `{}`, `{}`, `{}`)
+		assert.Len(t, wfs, 2)
+		assert.Equal(t, "Normal WorkflowEventBindingRateLimited event dropped as it exceeds the rate limit", <-recorder.Events)
+	})
+	t.Run("DuplicatesAreNotRateLimited", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(6)
+		wfs := dispatch(t, binding(wfv1.WorkflowEventBindingSpec{
+			Deduplication: &wfv1.EventDeduplication{Key: "payload.id", Window: "1h"},
+			RateLimit:     &wfv1.EventRateLimit{Unit: wfv1.EventRateLimitUnitHour, RequestsPerUnit: 2},
+		}), recorder, `{"id": 1}`, `{"id": 1}`, `{"id": 2}`)
+		assert.Len(t, wfs, 2)
+	})
+	t.Run("ConcurrencyPolicy", func(t *testing.T) {
+		for policy, want := range map[wfv1.ConcurrencyPolicy]int{wfv1.AllowConcurrent: 2, wfv1.ForbidConcurrent: 1, wfv1.ReplaceConcurrent: 2} {
+			t.Run(string(policy), func(t *testing.T) {
+				wfs := dispatch(t, binding(wfv1.WorkflowEventBindingSpec{ConcurrencyPolicy: policy}), record.NewFakeRecorder(6), `{}`, `{}`)
+				require.Len(t, wfs, want)
+				if policy == wfv1.ReplaceConcurrent {
+					var stopped int
+					for _, wf := range wfs {
+						if wf.Spec.Shutdown == wfv1.ShutdownStrategyTerminate {
+							stopped++
+						}
+					}
+					assert.Equal(t, 1, stopped, "the running workflow is stopped")
+				}
+			})
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(6)
+		client := fake.NewClientset(tmpl)
+		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+		op, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, hydratorfake.Noop, NewLimits(sqldb.NewMemoryEventDeduplicationRepo()), []wfv1.WorkflowEventBinding{
+			binding(wfv1.WorkflowEventBindingSpec{Deduplication: &wfv1.EventDeduplication{Key: "payload.id", Window: "soon"}}),
+			binding(wfv1.WorkflowEventBindingSpec{RateLimit: &wfv1.EventRateLimit{Unit: "Day", RequestsPerUnit: 1}}),
+			binding(wfv1.WorkflowEventBindingSpec{ConcurrencyPolicy: "Sometimes"}),
+		}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{"id": 1}`)})
+		require.NoError(t, err)
+		require.Error(t, op.Dispatch(ctx))
+		assert.Contains(t, <-recorder.Events, "unable to parse soon as a duration")
+		assert.Contains(t, <-recorder.Events, `invalid rate limit unit "Day"`)
+		assert.Contains(t, <-recorder.Events, "invalid concurrencyPolicy: Sometimes")
+	})
+}
+
 func Test_expressionEnvironment(t *testing.T) {
 	env, err := expressionEnvironment(logging.TestContext(t.Context()), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"foo":"bar"}`)})
 	require.NoError(t, err)
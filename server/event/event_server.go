@@ -8,6 +8,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
@@ -15,6 +16,7 @@ import (
 	"github.com/argoproj/argo-workflows/v4/util/instanceid"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/workflow/events"
+	"github.com/argoproj/argo-workflows/v4/workflow/hydrator"
 
 	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
 )
@@ -22,6 +24,8 @@ import (
 type Controller struct {
 	instanceIDService    instanceid.Service
 	eventRecorderManager events.EventRecorderManager
+	hydrator             hydrator.Interface
+	limits               *dispatch.Limits
 	// a channel for operations to be executed async on
 	operationQueue chan dispatch.Operation
 	workerCount    int
@@ -30,13 +34,15 @@ type Controller struct {
 
 var _ eventpkg.EventServiceServer = &Controller{}
 
-func NewController(ctx context.Context, instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int, asyncDispatch bool) *Controller {
+func NewController(ctx context.Context, instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, hydrator hydrator.Interface, deduplicationRepo sqldb.EventDeduplicationRepo, operationQueueSize, workerCount int, asyncDispatch bool) *Controller {
 	logger := logging.RequireLoggerFromContext(ctx)
 	logger.WithFields(logging.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "asyncDispatch": asyncDispatch}).Info(ctx, "Creating event controller")
 
 	return &Controller{
 		instanceIDService:    instanceIDService,
 		eventRecorderManager: eventRecorderManager,
+		hydrator:             hydrator,
+		limits:               dispatch.NewLimits(deduplicationRepo),
 		//  so we can have `operationQueueSize` operations outstanding before we start putting back pressure on the senders
 		operationQueue: make(chan dispatch.Operation, operationQueueSize),
 		workerCount:    workerCount,
@@ -78,7 +84,7 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
 
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, req.Namespace), s.hydrator, s.limits, list.Items, req.Namespace, req.Discriminator, req.Payload)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
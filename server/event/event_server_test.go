@@ -11,12 +11,14 @@ import (
 	"github.com/stretchr/testify/require"
 	fakekube "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
 	"github.com/argoproj/argo-workflows/v4/util/instanceid"
 	"github.com/argoproj/argo-workflows/v4/workflow/events"
+	hydratorfake "github.com/argoproj/argo-workflows/v4/workflow/hydrator/fake"
 )
 
 func TestController(t *testing.T) {
@@ -25,7 +27,7 @@ func TestController(t *testing.T) {
 	instanceIDService := instanceid.NewService("my-instanceid")
 	eventRecorderManager := events.NewEventRecorderManager(fakekube.NewClientset())
 	newController := func(asyncDispatch bool) *Controller {
-		return NewController(ctx, instanceIDService, eventRecorderManager, 1, 1, asyncDispatch)
+		return NewController(ctx, instanceIDService, eventRecorderManager, hydratorfake.Noop, sqldb.NewMemoryEventDeduplicationRepo(), 1, 1, asyncDispatch)
 	}
 	e1 := &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{}}
 	e2 := &eventpkg.EventRequest{}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -19,9 +20,11 @@ type ListOptions struct {
 	MinStartedAt, MaxStartedAt   time.Time
 	CreatedAfter, FinishedBefore time.Time
 	LabelRequirements            labels.Requirements
-	Limit, Offset                int
-	ShowRemainingItemCount       bool
-	StartedAtAscending           bool
+	// FieldRequirements are requirements on the fields of archived workflows, see BuildArchivedListOptions
+	FieldRequirements      fields.Requirements
+	Limit, Offset          int
+	ShowRemainingItemCount bool
+	StartedAtAscending     bool
 }
 
 func (l ListOptions) WithLimit(limit int) ListOptions {
@@ -174,3 +177,54 @@ func BuildListOptions(options metav1.ListOptions, ns, namePrefix, nameFilter, cr
 		ShowRemainingItemCount: showRemainingItemCount,
 	}, nil
 }
+
+// BuildArchivedListOptions is BuildListOptions for archived workflows, which can also be selected by any field of the
+// workflow, such as "spec.arguments.parameters.region=eu-west-1" or "status.nodes.deploy.phase!=Succeeded"
+func BuildArchivedListOptions(options metav1.ListOptions, ns, namePrefix, nameFilter string) (ListOptions, error) {
+	var selectors []string
+	var requirements fields.Requirements
+	for _, selector := range splitFieldSelector(options.FieldSelector) {
+		if !isArchivedFieldSelector(selector) {
+			selectors = append(selectors, selector)
+			continue
+		}
+		parsed, err := fields.ParseSelector(selector)
+		if err != nil {
+			return ListOptions{}, ToStatusError(err, codes.InvalidArgument)
+		}
+		requirements = append(requirements, parsed.Requirements()...)
+	}
+	options.FieldSelector = strings.Join(selectors, ",")
+	listOptions, err := BuildListOptions(options, ns, namePrefix, nameFilter, "", "")
+	if err != nil {
+		return ListOptions{}, err
+	}
+	listOptions.FieldRequirements = requirements
+	return listOptions, nil
+}
+
+// isArchivedFieldSelector returns true if the selector is for a field of an archived workflow that BuildListOptions does not support
+func isArchivedFieldSelector(selector string) bool {
+	for _, field := range []string{"metadata.namespace", "metadata.name", "spec.startedAt"} {
+		if rest, ok := strings.CutPrefix(selector, field); ok && strings.IndexAny(rest, "=!<>") == 0 {
+			return false
+		}
+	}
+	return strings.HasPrefix(selector, "metadata.") || strings.HasPrefix(selector, "spec.") || strings.HasPrefix(selector, "status.")
+}
+
+// splitFieldSelector splits the field selector at the commas which are not escaped
+func splitFieldSelector(fieldSelector string) []string {
+	var selectors []string
+	start := 0
+	for i := 0; i < len(fieldSelector); i++ {
+		switch fieldSelector[i] {
+		case '\\':
+			i++
+		case ',':
+			selectors = append(selectors, fieldSelector[start:i])
+			start = i + 1
+		}
+	}
+	return append(selectors, fieldSelector[start:])
+}
@@ -9,7 +9,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
 func TestListOptionsMethods(t *testing.T) {
@@ -278,6 +280,26 @@ func TestBuildListOptions(t *testing.T) {
 	}
 }
 
+func TestBuildArchivedListOptions(t *testing.T) {
+	options, err := BuildArchivedListOptions(metav1.ListOptions{
+		FieldSelector: `metadata.name=my-wf,spec.arguments.parameters.region=eu-west-1,status.nodes.deploy.message=*a\, b*,spec.startedAt>2023-01-01T00:00:00Z,spec.serviceAccountName!=my-sa`,
+	}, "my-ns", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "my-wf", options.Name)
+	require.Equal(t, "my-ns", options.Namespace)
+	require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), options.MinStartedAt)
+	require.Equal(t, fields.Requirements{
+		{Field: "spec.arguments.parameters.region", Operator: selection.Equals, Value: "eu-west-1"},
+		{Field: "status.nodes.deploy.message", Operator: selection.Equals, Value: "*a, b*"},
+		{Field: "spec.serviceAccountName", Operator: selection.NotEquals, Value: "my-sa"},
+	}, options.FieldRequirements)
+
+	_, err = BuildArchivedListOptions(metav1.ListOptions{FieldSelector: "spec.arguments.parameters.region"}, "", "", "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = BuildArchivedListOptions(metav1.ListOptions{FieldSelector: "foo=bar"}, "", "", "")
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = unsupported requirement foo=bar")
+}
+
 func mustParseToRequirements(t *testing.T, labelSelector string) labels.Requirements {
 	requirements, err := labels.ParseToRequirements(labelSelector)
 	require.NoError(t, err)
@@ -704,6 +704,63 @@ func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.Workf
 	return wf, nil
 }
 
+func (s *workflowServer) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest) (*wfv1.Workflow, error) {
+	return s.decideApproval(ctx, req.Namespace, req.Name, req.NodeFieldSelector, wfv1.ApprovalDecision{Approved: true, Parameters: req.Parameters, Message: req.Message})
+}
+
+func (s *workflowServer) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest) (*wfv1.Workflow, error) {
+	return s.decideApproval(ctx, req.Namespace, req.Name, req.NodeFieldSelector, wfv1.ApprovalDecision{Approved: false, Message: req.Message})
+}
+
+// decideApproval records the user's decision on the workflow's approval gates, the user must be allowed to update the
+// workflow as well as being one of the approvers of the gates
+func (s *workflowServer) decideApproval(ctx context.Context, namespace, name, nodeFieldSelector string, decision wfv1.ApprovalDecision) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := s.getWorkflow(ctx, wfClient, namespace, name, "", metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	err = s.validateWorkflow(wf)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+
+	decision.User = auth.Approver(ctx)
+	err = util.DecideApproval(ctx, wfClient.ArgoprojV1alpha1().Workflows(namespace), s.hydrator, wf.Name, nodeFieldSelector, decision, func(approvers wfv1.Approvers) bool {
+		return auth.IsApprover(ctx, approvers)
+	})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"name": wf.Name, "user": decision.User, "approved": decision.Approved}).Info(ctx, "Decided approval")
+
+	wf, err = wfClient.ArgoprojV1alpha1().Workflows(namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return wf, nil
+}
+
+// CallbackWorkflow completes the HTTP node waiting for a callback, the caller must be allowed to patch the status of
+// the workflow's task set
+func (s *workflowServer) CallbackWorkflow(ctx context.Context, req *workflowpkg.WorkflowCallbackRequest) (*workflowpkg.WorkflowCallbackResponse, error) {
+	var body []byte
+	if req.Payload != nil {
+		var err error
+		body, err = json.Marshal(req.Payload)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+		}
+	}
+	wfClient := auth.GetWfClient(ctx)
+	err := util.CallbackHTTPNode(ctx, wfClient.ArgoprojV1alpha1().WorkflowTaskSets(req.Namespace), req.Name, req.NodeId, body)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"name": req.Name, "nodeId": req.NodeId}).Info(ctx, "Received HTTP callback")
+	return &workflowpkg.WorkflowCallbackResponse{}, nil
+}
+
 func (s *workflowServer) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest) (*wfv1.Workflow, error) {
 	if req.Workflow == nil {
 		return nil, fmt.Errorf("unable to get a workflow")
@@ -3,23 +3,30 @@ package workflowarchive
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"sort"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 
+	"github.com/argoproj/argo-workflows/v4/persist/archivefile"
 	"github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifactrepositories"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v4/workflow/creator"
 	"github.com/argoproj/argo-workflows/v4/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v4/workflow/util"
@@ -34,11 +41,17 @@ type archivedWorkflowServer struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfDefaults            *wfv1.Workflow
+	artifactRepositories  artifactrepositories.Interface
+	artDriverFactory      artifacts.NewDriverFunc
 }
 
 // NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfDefaults *wfv1.Workflow) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), wfDefaults}
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfDefaults *wfv1.Workflow, artifactRepositories artifactrepositories.Interface) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return newWorkflowArchiveServer(wfArchive, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), wfDefaults, artifactRepositories, artifacts.NewDriver)
+}
+
+func newWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, hydrator hydrator.Interface, wfDefaults *wfv1.Workflow, artifactRepositories artifactrepositories.Interface, artDriverFactory artifacts.NewDriverFunc) *archivedWorkflowServer {
+	return &archivedWorkflowServer{wfArchive, offloadNodeStatusRepo, hydrator, wfDefaults, artifactRepositories, artDriverFactory}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -47,7 +60,7 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		listOptions = *req.ListOptions
 	}
 
-	options, err := sutils.BuildListOptions(listOptions, req.Namespace, req.NamePrefix, req.NameFilter, "", "")
+	options, err := sutils.BuildArchivedListOptions(listOptions, req.Namespace, req.NamePrefix, req.NameFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +213,334 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	return labels, nil
 }
 
+// maxStatsBuckets limits the number of buckets of each series, so that a small bucket does not make the database sort
+// and return a row for every workflow
+const maxStatsBuckets = 1000
+
+func (w *archivedWorkflowServer) GetArchivedWorkflowStats(ctx context.Context, req *workflowarchivepkg.ArchivedWorkflowStatsRequest) (*workflowarchivepkg.ArchivedWorkflowStats, error) {
+	options, err := statsOptions(req)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\".", req.Namespace))
+	}
+	buckets, err := w.wfArchive.WorkflowStats(ctx, options)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	stats := &workflowarchivepkg.ArchivedWorkflowStats{}
+	var series *workflowarchivepkg.ArchivedWorkflowStatsSeries
+	for _, b := range buckets {
+		if series == nil || series.Group != b.Group {
+			series = &workflowarchivepkg.ArchivedWorkflowStatsSeries{Group: b.Group}
+			stats.Series = append(stats.Series, series)
+		}
+		phases := map[string]int64{}
+		for phase, count := range b.Phases() {
+			phases[string(phase)] = count
+		}
+		series.Buckets = append(series.Buckets, &workflowarchivepkg.ArchivedWorkflowStatsBucket{
+			StartTime:         &metav1.Time{Time: b.StartTime},
+			Total:             b.Total,
+			Phases:            phases,
+			SuccessRate:       b.SuccessRate(),
+			DurationP50:       b.DurationP50,
+			DurationP90:       b.DurationP90,
+			DurationP99:       b.DurationP99,
+			ResourcesDuration: map[string]int64{string(corev1.ResourceCPU): b.CPU, string(corev1.ResourceMemory): b.Memory},
+		})
+	}
+	return stats, nil
+}
+
+func statsOptions(req *workflowarchivepkg.ArchivedWorkflowStatsRequest) (sqldb.WorkflowStatsOptions, error) {
+	options := sqldb.WorkflowStatsOptions{
+		Namespace: req.Namespace,
+		GroupBy:   sqldb.WorkflowStatsGroupBy(req.GroupBy),
+		LabelKey:  req.LabelKey,
+		EndTime:   time.Now(),
+	}
+	var err error
+	if req.EndTime != "" {
+		if options.EndTime, err = time.Parse(time.RFC3339, req.EndTime); err != nil {
+			return options, fmt.Errorf("invalid end time: %w", err)
+		}
+	}
+	options.StartTime = options.EndTime.Add(-7 * 24 * time.Hour)
+	if req.StartTime != "" {
+		if options.StartTime, err = time.Parse(time.RFC3339, req.StartTime); err != nil {
+			return options, fmt.Errorf("invalid start time: %w", err)
+		}
+	}
+	if !options.StartTime.Before(options.EndTime) {
+		return options, fmt.Errorf("start time must be before end time")
+	}
+	options.Bucket = options.EndTime.Sub(options.StartTime)
+	if req.Bucket != "" {
+		if options.Bucket, err = time.ParseDuration(req.Bucket); err != nil {
+			return options, fmt.Errorf("invalid bucket: %w", err)
+		}
+		if options.Bucket < time.Second {
+			return options, fmt.Errorf("bucket must be at least 1s")
+		}
+	}
+	if options.EndTime.Sub(options.StartTime)/options.Bucket >= maxStatsBuckets {
+		return options, fmt.Errorf("the time range must have fewer than %d buckets", maxStatsBuckets)
+	}
+	if options.LabelRequirements, err = labels.ParseToRequirements(req.LabelSelector); err != nil {
+		return options, err
+	}
+	return options, options.Validate()
+}
+
+// exportPageSize is the number of archived workflows listed at a time when exporting
+const exportPageSize = 100
+
+func (w *archivedWorkflowServer) ExportArchivedWorkflows(req *workflowarchivepkg.ExportArchivedWorkflowsRequest, stream workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsServer) error {
+	_, err := w.exportArchivedWorkflows(stream.Context(), req, stream.Send)
+	return err
+}
+
+func (w *archivedWorkflowServer) ExportArchivedWorkflowsToArtifactRepository(ctx context.Context, req *workflowarchivepkg.ExportArchivedWorkflowsRequest) (*workflowarchivepkg.ExportArchivedWorkflowsResponse, error) {
+	format, err := archivefile.ParseFormat(req.Format)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	// writing to the artifact repository is something that only workflows can otherwise do
+	if err := w.canCreateWorkflows(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	art, driver, err := w.artifactRepositoryFile(ctx, req.Namespace, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	done := make(chan error, 1)
+	var exported int64
+	go func() {
+		var err error
+		exported, err = w.exportArchivedWorkflowsTo(ctx, req, writer, format)
+		_ = writer.CloseWithError(err)
+		done <- err
+	}()
+	saveErr := driver.SaveStream(ctx, reader, art)
+	// if the save failed, this stops the export from waiting for it to read
+	_ = reader.CloseWithError(saveErr)
+	if err := <-done; err != nil {
+		return nil, err
+	}
+	if saveErr != nil {
+		return nil, sutils.ToStatusError(saveErr, codes.Internal)
+	}
+	return &workflowarchivepkg.ExportArchivedWorkflowsResponse{Artifact: art, Exported: exported}, nil
+}
+
+func (w *archivedWorkflowServer) exportArchivedWorkflowsTo(ctx context.Context, req *workflowarchivepkg.ExportArchivedWorkflowsRequest, out io.Writer, format archivefile.Format) (int64, error) {
+	writer, err := archivefile.NewWriter(out, format)
+	if err != nil {
+		return 0, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	exported, err := w.exportArchivedWorkflows(ctx, req, writer.Write)
+	if err != nil {
+		return 0, err
+	}
+	if err := writer.Close(); err != nil {
+		return 0, sutils.ToStatusError(err, codes.Internal)
+	}
+	return exported, nil
+}
+
+// exportArchivedWorkflows calls send with each of the selected archived workflows, with their offloaded node status,
+// and returns the number of workflows. All the selected workflows are exported, the limit and continue of the list
+// options are ignored.
+func (w *archivedWorkflowServer) exportArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ExportArchivedWorkflowsRequest, send func(wf *wfv1.Workflow) error) (int64, error) {
+	listOptions := metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOptions = *req.ListOptions
+	}
+	listOptions.Limit = 0
+	listOptions.Continue = ""
+	options, err := sutils.BuildArchivedListOptions(listOptions, req.Namespace, "", "")
+	if err != nil {
+		return 0, err
+	}
+	for _, verb := range []string{"list", "get"} {
+		allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, options.Namespace, "")
+		if err != nil {
+			return 0, sutils.ToStatusError(err, codes.Internal)
+		}
+		if !allowed {
+			return 0, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to %s workflows in namespace \"%s\".", verb, options.Namespace))
+		}
+	}
+	options.Limit = exportPageSize
+	var exported int64
+	for {
+		items, err := w.wfArchive.ListWorkflows(ctx, options)
+		if err != nil {
+			return exported, sutils.ToStatusError(err, codes.Internal)
+		}
+		for _, item := range items {
+			// the list only has a summary of each workflow
+			wf, err := w.wfArchive.GetWorkflow(ctx, string(item.UID), "", "")
+			if err != nil {
+				return exported, sutils.ToStatusError(err, codes.Internal)
+			}
+			if wf == nil {
+				// deleted since it was listed
+				continue
+			}
+			if err := w.hydrator.Hydrate(ctx, wf); err != nil {
+				return exported, sutils.ToStatusError(err, codes.Internal)
+			}
+			if err := send(wf); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+		if len(items) < options.Limit {
+			return exported, nil
+		}
+		options.Offset += options.Limit
+	}
+}
+
+func (w *archivedWorkflowServer) ImportArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ImportArchivedWorkflowsRequest) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	importer := w.newImporter()
+	for _, wf := range req.Workflows {
+		if err := importer.importWorkflow(ctx, wf); err != nil {
+			return nil, err
+		}
+	}
+	return &workflowarchivepkg.ImportArchivedWorkflowsResponse{Imported: importer.imported}, nil
+}
+
+func (w *archivedWorkflowServer) ImportArchivedWorkflowsFromArtifactRepository(ctx context.Context, req *workflowarchivepkg.ImportArchivedWorkflowsFromArtifactRepositoryRequest) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	format, err := archivefile.ParseFormat(req.Format)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	// reading from the artifact repository is something that only workflows can otherwise do
+	if err := w.canCreateWorkflows(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	art, driver, err := w.artifactRepositoryFile(ctx, req.Namespace, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := driver.OpenStream(ctx, art)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	defer stream.Close()
+	var in io.Reader = stream
+	if format == archivefile.Parquet {
+		// Parquet is read from a file rather than memory, as it needs random access
+		file, err := os.CreateTemp("", "archived-workflows-*.parquet")
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		defer func() {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}()
+		if _, err := io.Copy(file, stream); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		in = file
+	}
+	importer := w.newImporter()
+	if err := archivefile.Read(in, format, func(wf *wfv1.Workflow) error { return importer.importWorkflow(ctx, wf) }); err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	return &workflowarchivepkg.ImportArchivedWorkflowsResponse{Imported: importer.imported}, nil
+}
+
+type importer struct {
+	w *archivedWorkflowServer
+	// the namespaces which the user is allowed to import workflows into
+	allowed  map[string]bool
+	imported int64
+}
+
+func (w *archivedWorkflowServer) newImporter() *importer {
+	return &importer{w: w, allowed: map[string]bool{}}
+}
+
+// importWorkflow archives the workflow as if it completed in this cluster, so it has this server's cluster name and
+// instance ID. A workflow which is already in the archive is replaced, so importing is idempotent.
+func (i *importer) importWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
+	if wf.UID == "" || wf.Name == "" || wf.Namespace == "" {
+		return status.Error(codes.InvalidArgument, "workflows must have a uid, name and namespace")
+	}
+	if !wf.Status.Fulfilled() {
+		return status.Errorf(codes.InvalidArgument, "workflow %s/%s has not completed", wf.Namespace, wf.Name)
+	}
+	if !i.allowed[wf.Namespace] {
+		if err := i.w.canCreateWorkflows(ctx, wf.Namespace); err != nil {
+			return err
+		}
+		i.allowed[wf.Namespace] = true
+	}
+	if err := i.w.hydrator.Hydrate(ctx, wf); err != nil {
+		return status.Errorf(codes.InvalidArgument, "workflow %s/%s does not have its offloaded node status: %v", wf.Namespace, wf.Name, err)
+	}
+	if wf.Labels == nil {
+		wf.Labels = map[string]string{}
+	}
+	if err := i.w.wfArchive.ArchiveWorkflow(ctx, wf); err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
+	i.imported++
+	return nil
+}
+
+func (w *archivedWorkflowServer) canCreateWorkflows(ctx context.Context, namespace string) error {
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, namespace, "")
+	if err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to create workflows in namespace \"%s\".", namespace))
+	}
+	return nil
+}
+
+// artifactRepositoryFile returns the artifact and driver of the file with the key in the namespace's artifact repository
+func (w *archivedWorkflowServer) artifactRepositoryFile(ctx context.Context, namespace, key string) (*wfv1.Artifact, artifactscommon.ArtifactDriver, error) {
+	if key == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+	ref, err := w.artifactRepositories.Resolve(ctx, nil, namespace)
+	if err != nil {
+		return nil, nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	repo, err := w.artifactRepositories.Get(ctx, ref)
+	if err != nil {
+		return nil, nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if repo == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "there is no artifact repository")
+	}
+	art := &wfv1.Artifact{ArtifactLocation: *repo.ToArtifactLocation()}
+	if err := art.SetKey(key); err != nil {
+		return nil, nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	driver, err := w.artDriverFactory(ctx, art, resources{auth.GetKubeClient(ctx), namespace})
+	if err != nil {
+		return nil, nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return art, driver, nil
+}
+
 func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 
@@ -1,7 +1,10 @@
 package workflowarchive
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -10,12 +13,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -26,7 +31,11 @@ import (
 	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v4/server/auth"
 	sutils "github.com/argoproj/argo-workflows/v4/server/utils"
+	armocks "github.com/argoproj/argo-workflows/v4/workflow/artifactrepositories/mocks"
+	artifactscommon "github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v4/workflow/hydrator/fake"
 )
 
 func Test_archivedWorkflowServer(t *testing.T) {
@@ -36,7 +45,7 @@ func Test_archivedWorkflowServer(t *testing.T) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	w := NewWorkflowArchiveServer(repo, offloadNodeStatusRepo, nil)
+	w := NewWorkflowArchiveServer(repo, offloadNodeStatusRepo, nil, nil)
 	allowed := true
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
@@ -134,6 +143,13 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		},
 	}, nil)
 
+	statsStart, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+	repo.On("WorkflowStats", mock.Anything, sqldb.WorkflowStatsOptions{Namespace: "user-ns", StartTime: statsStart, EndTime: statsStart.Add(2 * time.Hour), Bucket: time.Hour}).Return([]sqldb.WorkflowStatsBucket{
+		{Group: "my-tmpl", StartTime: statsStart, Total: 4, Succeeded: 3, Failed: 1, DurationP50: 10, CPU: 5},
+		{Group: "my-tmpl", Bucket: 1, StartTime: statsStart.Add(time.Hour), Total: 1, Errored: 1},
+		{Group: "other-tmpl", StartTime: statsStart, Total: 1, Succeeded: 1},
+	}, nil)
+
 	ctx := context.WithValue(context.WithValue(logging.TestContext(t.Context()), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
 	t.Run("ListArchivedWorkflows", func(t *testing.T) {
 		allowed = false
@@ -262,6 +278,31 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, resp.Items)
 	})
+	t.Run("GetArchivedWorkflowStats", func(t *testing.T) {
+		req := &workflowarchivepkg.ArchivedWorkflowStatsRequest{Namespace: "user-ns", StartTime: "2020-01-01T00:00:00Z", EndTime: "2020-01-01T02:00:00Z", Bucket: "1h"}
+		stats, err := w.GetArchivedWorkflowStats(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, stats.Series, 2)
+		assert.Equal(t, "my-tmpl", stats.Series[0].Group)
+		require.Len(t, stats.Series[0].Buckets, 2)
+		bucket := stats.Series[0].Buckets[0]
+		assert.Equal(t, statsStart, bucket.StartTime.Time)
+		assert.Equal(t, map[string]int64{"Succeeded": 3, "Failed": 1, "Error": 0}, bucket.Phases)
+		assert.InDelta(t, 0.75, bucket.SuccessRate, 0.001)
+		assert.Equal(t, int64(10), bucket.DurationP50)
+		assert.Equal(t, map[string]int64{"cpu": 5, "memory": 0}, bucket.ResourcesDuration)
+		assert.Equal(t, "other-tmpl", stats.Series[1].Group)
+
+		_, err = w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.ArchivedWorkflowStatsRequest{Namespace: "user-ns", Bucket: "1s"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.ArchivedWorkflowStatsRequest{Namespace: "user-ns", GroupBy: "label"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		allowed = false
+		_, err = w.GetArchivedWorkflowStats(ctx, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+	})
 	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
 		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
 		assert.Equal(t, err, status.Error(codes.AlreadyExists, "Workflow already exists on cluster, use argo retry {name} instead"))
@@ -272,3 +313,131 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		assert.NotNil(t, wf)
 	})
 }
+
+type exportStream struct {
+	grpc.ServerStream
+	//nolint:containedctx
+	ctx       context.Context
+	workflows []*v1alpha1.Workflow
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(wf *v1alpha1.Workflow) error {
+	s.workflows = append(s.workflows, wf)
+	return nil
+}
+
+type memoryArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	files map[string][]byte
+}
+
+func (d *memoryArtifactDriver) SaveStream(_ context.Context, reader io.Reader, art *v1alpha1.Artifact) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	d.files[art.S3.Key] = data
+	return nil
+}
+
+func (d *memoryArtifactDriver) OpenStream(_ context.Context, art *v1alpha1.Artifact) (io.ReadCloser, error) {
+	data, ok := d.files[art.S3.Key]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", art.S3.Key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func Test_archivedWorkflowServer_exportImport(t *testing.T) {
+	repo := &mocks.WorkflowArchive{}
+	kubeClient := &kubefake.Clientset{}
+	allowed := map[string]bool{"list": true, "get": true, "create": true}
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed[review.Spec.ResourceAttributes.Verb]},
+		}, nil
+	})
+	driver := &memoryArtifactDriver{files: map[string][]byte{}}
+	artifactRepositories := armocks.DummyArtifactRepositories(&v1alpha1.ArtifactRepository{
+		S3: &v1alpha1.S3ArtifactRepository{S3Bucket: v1alpha1.S3Bucket{Bucket: "my-bucket"}},
+	})
+	w := newWorkflowArchiveServer(repo, nil, hydratorfake.Noop, nil, artifactRepositories, func(context.Context, *v1alpha1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	})
+
+	workflows := make(v1alpha1.Workflows, exportPageSize+1)
+	for i := range workflows {
+		uid := fmt.Sprintf("uid-%d", i)
+		workflows[i] = v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("wf-%d", i), Namespace: "my-ns", UID: types.UID(uid)},
+			Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded},
+		}
+		repo.On("GetWorkflow", mock.Anything, uid, "", "").Return(workflows[i].DeepCopy(), nil)
+	}
+	repo.On("ListWorkflows", mock.Anything, sutils.ListOptions{Namespace: "my-ns", Limit: exportPageSize}).Return(workflows[:exportPageSize], nil)
+	repo.On("ListWorkflows", mock.Anything, sutils.ListOptions{Namespace: "my-ns", Limit: exportPageSize, Offset: exportPageSize}).Return(workflows[exportPageSize:], nil)
+	var imported []string
+	repo.On("ArchiveWorkflow", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		imported = append(imported, string(args.Get(1).(*v1alpha1.Workflow).UID))
+	}).Return(nil)
+
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	t.Run("ExportArchivedWorkflows", func(t *testing.T) {
+		// the limit is ignored
+		req := &workflowarchivepkg.ExportArchivedWorkflowsRequest{Namespace: "my-ns", ListOptions: &metav1.ListOptions{Limit: 1}}
+		stream := &exportStream{ctx: ctx}
+		require.NoError(t, w.ExportArchivedWorkflows(req, stream))
+		require.Len(t, stream.workflows, len(workflows))
+		assert.Equal(t, types.UID("uid-100"), stream.workflows[100].UID)
+
+		allowed["get"] = false
+		err := w.ExportArchivedWorkflows(req, &exportStream{ctx: ctx})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed["get"] = true
+	})
+	for _, format := range []string{"ndjson", "parquet"} {
+		t.Run(format, func(t *testing.T) {
+			imported = nil
+			key := "archive." + format
+			resp, err := w.ExportArchivedWorkflowsToArtifactRepository(ctx, &workflowarchivepkg.ExportArchivedWorkflowsRequest{Namespace: "my-ns", Format: format, Key: key})
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(workflows)), resp.Exported)
+			assert.Equal(t, key, resp.Artifact.S3.Key)
+			assert.Contains(t, driver.files, key)
+
+			importResp, err := w.ImportArchivedWorkflowsFromArtifactRepository(ctx, &workflowarchivepkg.ImportArchivedWorkflowsFromArtifactRepositoryRequest{Namespace: "my-ns", Format: format, Key: key})
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(workflows)), importResp.Imported)
+			require.Len(t, imported, len(workflows))
+			assert.Equal(t, "uid-0", imported[0])
+		})
+	}
+	t.Run("ExportArchivedWorkflowsToArtifactRepository", func(t *testing.T) {
+		_, err := w.ExportArchivedWorkflowsToArtifactRepository(ctx, &workflowarchivepkg.ExportArchivedWorkflowsRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.ExportArchivedWorkflowsToArtifactRepository(ctx, &workflowarchivepkg.ExportArchivedWorkflowsRequest{Namespace: "my-ns", Key: "my-key", Format: "csv"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("ImportArchivedWorkflows", func(t *testing.T) {
+		imported = nil
+		resp, err := w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Workflows: []*v1alpha1.Workflow{workflows[0].DeepCopy()}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.Imported)
+		assert.Equal(t, []string{"uid-0"}, imported)
+
+		running := workflows[1].DeepCopy()
+		running.Status.Phase = v1alpha1.WorkflowRunning
+		_, err = w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Workflows: []*v1alpha1.Workflow{running}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Workflows: []*v1alpha1.Workflow{{Status: workflows[0].Status}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		allowed["create"] = false
+		_, err = w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Workflows: []*v1alpha1.Workflow{workflows[0].DeepCopy()}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed["create"] = true
+	})
+}
@@ -34,6 +34,8 @@ export function artifactURN<A extends Artifact>(a: A, ar: ArtifactRepository) {
         return 'artifact:raw:' + a.raw.data;
     } else if (a.azure) {
         return 'artifact:azure:' + (a.azure.endpoint || ar?.azure?.endpoint) + ':' + (a.azure.container || ar?.azure?.container) + ':' + a.azure.blob;
+    } else if (a.oci) {
+        return 'artifact:oci:' + (a.oci.registry || ar?.oci?.registry) + ':' + (a.oci.repository || ar?.oci?.repository) + ':' + a.oci.key;
     }
     return 'artifact:unknown';
 }
@@ -53,6 +55,8 @@ export function artifactRepoHasLocation(ar: ArtifactRepository) {
         return true;
     } else if (ar.azure) {
         return ar.azure.container !== '' && ar.azure.blob !== '';
+    } else if (ar.oci) {
+        return ar.oci.registry !== '';
     }
 }
 
@@ -71,6 +75,8 @@ export function artifactKey<A extends Artifact>(a: A) {
         return 'raw';
     } else if (a.azure?.blob) {
         return a.azure.blob;
+    } else if (a.oci?.key) {
+        return a.oci.key;
     }
     return 'unknown';
 }
@@ -40,6 +40,12 @@ export interface S3ArtifactRepository {
     key: string;
 }
 
+export interface OCIArtifactRepository {
+    registry: string;
+    repository: string;
+    keyFormat?: string;
+}
+
 export interface OSSArtifactRepository {
     endpoint: string;
     bucket: string;
@@ -65,6 +71,7 @@ export interface ArtifactRepository {
     s3?: S3ArtifactRepository;
     oss?: OSSArtifactRepository;
     azure?: AzureArtifactRepository;
+    oci?: OCIArtifactRepository;
     git?: GitArtifactRepository;
     http?: HTTPArtifactRepository;
     raw?: RawArtifactRepository;
@@ -85,6 +92,14 @@ export interface GCSArtifact {
     key: string;
 }
 
+export interface OCIArtifact {
+    registry?: string;
+    repository?: string;
+    key: string;
+    digest?: string;
+    mediaType?: string;
+}
+
 export interface GitArtifact {
     repo: string;
     branch?: string;
@@ -138,6 +153,7 @@ export interface Artifact {
     raw?: RawArtifact;
     s3?: S3Artifact;
     azure?: AzureArtifact;
+    oci?: OCIArtifact;
     archive?: {
         none?: any;
     };
@@ -585,7 +601,7 @@ export function isArchivedWorkflow(wf?: Workflow): boolean {
     return labelValue === 'Archived' || labelValue === 'Persisted';
 }
 
-export type NodeType = 'Pod' | 'Container' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend';
+export type NodeType = 'Pod' | 'Container' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Loop' | 'Skipped' | 'TaskGroup' | 'Suspend';
 
 export interface NodeStatus {
     /**
@@ -4,6 +4,7 @@ export const genres = {
     Container: true,
     DAG: true,
     HTTP: true,
+    Loop: true,
     Pod: true,
     Plugin: true,
     Retry: true,
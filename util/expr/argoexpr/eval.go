@@ -7,17 +7,26 @@ import (
 )
 
 func EvalBool(input string, env any) (bool, error) {
-	program, err := expr.Compile(input, expr.Env(env))
+	result, err := Eval(input, env)
 	if err != nil {
 		return false, err
 	}
-	result, err := expr.Run(program, env)
-	if err != nil {
-		return false, fmt.Errorf("unable to evaluate expression '%s': %w", input, err)
-	}
 	resultBool, ok := result.(bool)
 	if !ok {
 		return false, fmt.Errorf("unable to cast expression result '%s' to bool", result)
 	}
 	return resultBool, nil
 }
+
+// Eval evaluates the expression, and returns its result
+func Eval(input string, env any) (any, error) {
+	program, err := expr.Compile(input, expr.Env(env))
+	if err != nil {
+		return nil, err
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression '%s': %w", input, err)
+	}
+	return result, nil
+}
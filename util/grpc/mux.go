@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"net/textproto"
 	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 )
 
 func IncomingHeaderMatcher(key string) (string, bool) {
@@ -28,6 +30,11 @@ func IncomingHeaderMatcher(key string) (string, bool) {
 		"Upgrade":
 		return key, false
 
+	// Content-Type is reserved by gRPC, so it is forwarded with the prefix grpc-gateway uses for permanent headers,
+	// e.g. so that the event endpoint can recognize CloudEvents in structured mode
+	case "Content-Type":
+		return runtime.MetadataPrefix + key, true
+
 	default:
 		return key, true
 	}
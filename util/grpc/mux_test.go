@@ -48,6 +48,12 @@ func TestIncomingHeaderMatcher(t *testing.T) {
 	}
 }
 
+func TestIncomingHeaderMatcherContentType(t *testing.T) {
+	key, valid := IncomingHeaderMatcher("Content-Type")
+	assert.Equal(t, "grpcgateway-Content-Type", key)
+	assert.True(t, valid)
+}
+
 func TestNewMuxHandler(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	grpcHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
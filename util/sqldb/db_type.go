@@ -1,6 +1,11 @@
 package sqldb
 
-import "github.com/argoproj/argo-workflows/v4/config"
+import (
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+)
 
 type DBType string
 
@@ -18,6 +23,15 @@ func (t DBType) IntType() string {
 	return "int"
 }
 
+// OlderThan returns a condition that the timestamp column is more than d before the current time
+func (t DBType) OlderThan(column string, d time.Duration) string {
+	if t == SQLite {
+		// SQLite stores timestamps as text, which datetime() normalizes to UTC so they can be compared
+		return fmt.Sprintf("datetime(%s) < datetime('now', '-%d seconds')", column, int(d.Seconds()))
+	}
+	return fmt.Sprintf("%s < current_timestamp - interval '%d' second", column, int(d.Seconds()))
+}
+
 func dbTypeFromConfig(cfg *config.DBConfig) DBType {
 	if cfg.PostgreSQL != nil {
 		return Postgres
@@ -25,5 +39,8 @@ func dbTypeFromConfig(cfg *config.DBConfig) DBType {
 	if cfg.MySQL != nil {
 		return MySQL
 	}
+	if cfg.SQLite != nil {
+		return SQLite
+	}
 	return Invalid
 }
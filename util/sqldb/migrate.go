@@ -44,35 +44,9 @@ func Migrate(ctx context.Context, session db.Session, dbType DBType, versionTabl
 			return err
 		}
 
-		// Ensure the schema_history table has a primary key, creating it if necessary
-		// This logic is implemented separately from regular migrations to improve compatibility with databases running in strict or HA modes
-		dbIdentifierColumn := "table_schema"
-		if dbType == Postgres {
-			dbIdentifierColumn = "table_catalog"
-		}
-
-		// Check if primary key exists
-		rows, err := session.SQL().Query(
-			fmt.Sprintf("select 1 from information_schema.table_constraints where constraint_type = 'PRIMARY KEY' and table_name = '%s' and %s = ?",
-				versionTableName, dbIdentifierColumn),
-			session.Name())
-		if err != nil {
+		if err := ensureVersionPrimaryKey(session, dbType, versionTableName); err != nil {
 			return err
 		}
-		defer func() {
-			tmpErr := rows.Close()
-			if err == nil {
-				err = tmpErr
-			}
-		}()
-		if !rows.Next() {
-			_, alterErr := session.SQL().Exec(fmt.Sprintf("alter table %s add primary key(schema_version)", versionTableName))
-			if alterErr != nil {
-				return alterErr
-			}
-		} else if rowsErr := rows.Err(); rowsErr != nil {
-			return rowsErr
-		}
 
 		rs, err := session.SQL().Query(fmt.Sprintf("select schema_version from %s", versionTableName))
 		if err != nil {
@@ -106,6 +80,43 @@ func Migrate(ctx context.Context, session db.Session, dbType DBType, versionTabl
 	return nil
 }
 
+// ensureVersionPrimaryKey ensures the schema_history table has a primary key, creating it if necessary
+// This logic is implemented separately from regular migrations to improve compatibility with databases running in strict or HA modes
+func ensureVersionPrimaryKey(session db.Session, dbType DBType, versionTableName string) (err error) {
+	if dbType == SQLite {
+		// SQLite databases were always created with the primary key, and SQLite cannot add one to a table
+		return nil
+	}
+	dbIdentifierColumn := "table_schema"
+	if dbType == Postgres {
+		dbIdentifierColumn = "table_catalog"
+	}
+
+	// Check if primary key exists
+	rows, err := session.SQL().Query(
+		fmt.Sprintf("select 1 from information_schema.table_constraints where constraint_type = 'PRIMARY KEY' and table_name = '%s' and %s = ?",
+			versionTableName, dbIdentifierColumn),
+		session.Name())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmpErr := rows.Close()
+		if err == nil {
+			err = tmpErr
+		}
+	}()
+	if !rows.Next() {
+		_, alterErr := session.SQL().Exec(fmt.Sprintf("alter table %s add primary key(schema_version)", versionTableName))
+		if alterErr != nil {
+			return alterErr
+		}
+	} else if rowsErr := rows.Err(); rowsErr != nil {
+		return rowsErr
+	}
+	return nil
+}
+
 func applyChange(ctx context.Context, session db.Session, changeSchemaVersion int, versionTableName string, c Change) error {
 	// https://upper.io/blog/2020/08/29/whats-new-on-upper-v4/#transactions-enclosed-by-functions
 	logger := logging.RequireLoggerFromContext(ctx)
@@ -135,3 +146,25 @@ func applyChange(ctx context.Context, session db.Session, changeSchemaVersion in
 	})
 	return err
 }
+
+// ChangeList is a change made up of other changes, which are applied in order
+type ChangeList []Change
+
+func (l ChangeList) Apply(ctx context.Context, session db.Session) error {
+	for _, c := range l {
+		if err := c.Apply(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Baseline replaces the changes up to and including version with baseline, which creates the schema as it is at
+// that version. It is for databases which are always created at that version, and so which never need, or are not
+// able, to apply the earlier changes. The changes after version are applied as they are.
+func Baseline(changes []Change, version int, baseline Change) []Change {
+	baselined := make([]Change, len(changes))
+	baselined[version] = baseline
+	copy(baselined[version+1:], changes[version+1:])
+	return baselined
+}
@@ -174,6 +174,9 @@ func (sp *SessionProxy) connect(ctx context.Context) error {
 	var err error
 
 	switch {
+	case sp.dbConfig != nil && sp.dbConfig.SQLite != nil:
+		// SQLite has no authentication
+		sess, _, err = CreateDBSession(ctx, nil, "", *sp.dbConfig)
 	case sp.kubectlConfig != nil && sp.namespace != "" && sp.dbConfig != nil:
 		// Use Kubernetes secrets for authentication
 		sess, _, err = CreateDBSession(ctx, sp.kubectlConfig, sp.namespace, *sp.dbConfig)
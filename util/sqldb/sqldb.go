@@ -14,6 +14,7 @@ import (
 	"github.com/upper/db/v4"
 	mysqladp "github.com/upper/db/v4/adapter/mysql"
 	postgresqladp "github.com/upper/db/v4/adapter/postgresql"
+	sqliteadp "github.com/upper/db/v4/adapter/sqlite"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
 	"k8s.io/client-go/kubernetes"
@@ -23,6 +24,7 @@ import (
 
 	// Database drivers - imported for side effects
 	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
 )
 
 func CreateDBSession(ctx context.Context, kubectlConfig kubernetes.Interface, namespace string, dbConfig config.DBConfig) (db.Session, DBType, error) {
@@ -38,6 +40,12 @@ func CreateDBSession(ctx context.Context, kubectlConfig kubernetes.Interface, na
 			return nil, Invalid, err
 		}
 		return session, MySQL, err
+	} else if dbConfig.SQLite != nil {
+		session, err := createSQLiteDBSession(dbConfig.SQLite, dbConfig.ConnectionPool)
+		if err != nil {
+			return nil, Invalid, err
+		}
+		return session, SQLite, nil
 	}
 	return nil, "", fmt.Errorf("no databases are configured")
 }
@@ -56,6 +64,13 @@ func CreateDBSessionWithCreds(dbConfig config.DBConfig, username, password strin
 			return nil, Invalid, err
 		}
 		return session, MySQL, err
+	} else if dbConfig.SQLite != nil {
+		// SQLite has no credentials, so they are ignored
+		session, err := createSQLiteDBSession(dbConfig.SQLite, dbConfig.ConnectionPool)
+		if err != nil {
+			return nil, Invalid, err
+		}
+		return session, SQLite, nil
 	}
 	return nil, "", fmt.Errorf("no databases are configured")
 }
@@ -289,6 +304,38 @@ func createMySQLDBSessionWithCreds(cfg *config.MySQLConfig, persistPool *config.
 	return session, nil
 }
 
+// buildSQLiteDSN constructs the DSN of a SQLite database file. Writers wait for the lock held by other connections
+// (including those of other processes), rather than failing, and transactions take the write lock when they begin, so
+// that a transaction never fails to upgrade a read lock. Timestamps are written in a format which SQLite's date and time
+// functions understand.
+func buildSQLiteDSN(cfg *config.SQLiteConfig) string {
+	query := url.Values{}
+	query.Add("_pragma", "busy_timeout(10000)")
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Set("_time_format", "sqlite")
+	query.Set("_txlock", "immediate")
+	return (&url.URL{Scheme: "file", Opaque: cfg.Path, RawQuery: query.Encode()}).String()
+}
+
+// createSQLiteDBSession creates a SQLite DB session
+func createSQLiteDBSession(cfg *config.SQLiteConfig, persistPool *config.ConnectionPool) (db.Session, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("sqlite path must be set")
+	}
+	// Create traced *sql.DB using otelsql, with the pure Go driver so that CGO is not needed
+	sqlDB, err := otelsql.Open("sqlite", buildSQLiteDSN(cfg), otelSQLOptions(semconv.DBSystemNameSQLite, cfg.Path)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open traced sqlite connection: %w", err)
+	}
+	session, err := sqliteadp.New(sqlDB)
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to create upper/db session: %w", err)
+	}
+	return ConfigureDBSession(session, persistPool), nil
+}
+
 // otelSQLOptions returns the common otelsql tracing options for a database connection.
 func otelSQLOptions(systemName attribute.KeyValue, database string) []otelsql.Option {
 	return []otelsql.Option{
@@ -0,0 +1,45 @@
+package sqldb
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestSQLiteSession(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	proxy, err := NewSessionProxy(ctx, SessionProxyConfig{
+		DBConfig: config.DBConfig{SQLite: &config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db")}},
+	})
+	require.NoError(t, err)
+	defer proxy.Close()
+	assert.Equal(t, SQLite, proxy.DBType())
+
+	changes := Baseline([]Change{
+		AnsiSQLChange(`create table my_table (name varchar(64))`),
+		AnsiSQLChange(`alter table my_table alter column name set not null`),
+		AnsiSQLChange(`create index my_table_i1 on my_table (updatedat)`),
+	}, 1, ChangeList{
+		AnsiSQLChange(`create table my_table (name varchar(64) not null, updatedat timestamp not null default current_timestamp)`),
+	})
+	for range 2 {
+		require.NoError(t, Migrate(ctx, proxy.Session(), SQLite, "my_schema_history", changes), "migration is idempotent")
+	}
+
+	sess := proxy.Session()
+	_, err = sess.SQL().Exec(`insert into my_table (name, updatedat) values (?, ?), (?, ?)`,
+		"old", time.Now().Add(-time.Hour), "new", time.Now().In(time.FixedZone("my-zone", 3600)))
+	require.NoError(t, err)
+	var names []struct {
+		Name string `db:"name"`
+	}
+	require.NoError(t, sess.SQL().Select("name").From("my_table").Where(SQLite.OlderThan("updatedat", time.Minute)).All(&names))
+	require.Len(t, names, 1)
+	assert.Equal(t, "old", names[0].Name)
+}
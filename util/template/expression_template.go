@@ -30,6 +30,7 @@ func init() {
 var (
 	variablesToCheck = []string{
 		varkeys.Item.Template(),
+		varkeys.LoopIteration.Template(),
 		varkeys.Retries.Template(),
 		varkeys.RetriesLastExitCode.Template(),
 		varkeys.RetriesLastStatus.Template(),
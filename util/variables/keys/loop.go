@@ -21,3 +21,6 @@ var (
 	Item      = item("item", "string or json", "Current loop iteration value (withItems/withParam). JSON for map/list items.")
 	ItemByKey = item("item.<key>", "string", "Accessor into a map-typed loop iteration value")
 )
+
+// loop.iteration — bound only in the arguments of a step or task with a loop.
+var LoopIteration = item("loop.iteration", "int", "0-based index of the current iteration of a step or task with a loop")
@@ -19,4 +19,6 @@ var (
 	NodeName  = nodeCtx("node.name", "Full node name", anyTmpl)
 	StepsName = nodeCtx("steps.name", "Name of the current step (inside a Steps template body)", []v.TemplateKind{v.TmplSteps})
 	TasksName = nodeCtx("tasks.name", "Name of the current task (inside a DAG template body)", []v.TemplateKind{v.TmplDAG})
+	// http.callbackURL is only in scope for HTTP templates which wait for a callback.
+	HTTPCallbackURL = nodeCtx("http.callbackURL", "Argo Server URL which completes an HTTP template waiting for a callback", []v.TemplateKind{v.TmplHTTP})
 )
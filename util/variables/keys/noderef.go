@@ -10,6 +10,8 @@ type NodeRefKeys struct {
 	OutputsResult                           *v.Key
 	OutputsParameterByName                  *v.Key
 	OutputsArtifactByName                   *v.Key
+	ContainerOutputsParameterByName         *v.Key
+	ContainerOutputsArtifactByName          *v.Key
 }
 
 var (
@@ -49,12 +51,23 @@ func nodeRef(pfx string, inKind v.TemplateKind) NodeRefKeys {
 			AppliesTo: applies, Phases: []v.LifecyclePhase{v.PhAfterNodeSucceeded},
 			Description: "Named output artifact of the referenced node",
 		}),
+		ContainerOutputsParameterByName: v.Define(v.Spec{
+			Template: pfx + ".<name>.containerSet.<c>.outputs.parameters.<p>", Kind: v.KindNodeRef, ValueType: "string",
+			AppliesTo: applies, Phases: []v.LifecyclePhase{v.PhAfterNodeComplete},
+			Description: "Named output parameter of a container of the referenced container set node",
+		}),
+		ContainerOutputsArtifactByName: v.Define(v.Spec{
+			Template: pfx + ".<name>.containerSet.<c>.outputs.artifacts.<a>", Kind: v.KindNodeRef, ValueType: "wfv1.Artifact",
+			AppliesTo: applies, Phases: []v.LifecyclePhase{v.PhAfterNodeComplete},
+			Description: "Named output artifact of a container of the referenced container set node",
+		}),
 	}
 }
 
 // AggregateKeys are the per-loop outputs of a withItems/withParam group.
 type AggregateKeys struct {
 	Result, Parameters, ParameterByName *v.Key
+	ArtifactByName                      *v.Key
 }
 
 var (
@@ -75,6 +88,11 @@ func aggregate(pfx string, inKind v.TemplateKind) AggregateKeys {
 		Result:          def(".outputs.result", "JSON array of child results (withItems/withParam)"),
 		Parameters:      def(".outputs.parameters", "JSON array of per-child output-parameter maps"),
 		ParameterByName: def(".outputs.parameters.<p>", "JSON array of values for a named parameter across all children"),
+		ArtifactByName: v.Define(v.Spec{
+			Template: pfx + ".<loopName>.outputs.artifacts.<a>", Kind: v.KindNodeRef, ValueType: "wfv1.Artifact",
+			AppliesTo: applies, Phases: ph,
+			Description: "Aggregated artifact of a named output artifact across all children, loaded as a directory of shards",
+		}),
 	}
 }
 
@@ -8,11 +8,13 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/azure"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/encryption"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/gcs"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/git"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/http"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/oci"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/oss"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/plugin"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/raw"
@@ -31,9 +33,30 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 	if err != nil {
 		return nil, err
 	}
+	if art.IsEncrypted() {
+		if drv, err = newEncryptionDriver(ctx, drv, art.Encryption, ri); err != nil {
+			return nil, err
+		}
+	}
 	return logging.New(drv), nil
 }
 
+// newEncryptionDriver wraps the driver so that it encrypts artifacts with the key held in the secret
+func newEncryptionDriver(ctx context.Context, drv common.ArtifactDriver, enc *wfv1.ArtifactEncryption, ri resource.Interface) (common.ArtifactDriver, error) {
+	if enc.KeySecret == nil || enc.KeySecret.Name == "" {
+		return nil, fmt.Errorf("artifact encryption requires a keySecret")
+	}
+	value, err := ri.GetSecret(ctx, enc.KeySecret.Name, enc.KeySecret.Key)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := encryption.ParseKey(value)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.New(drv, kek), nil
+}
+
 func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
 	if art.S3 != nil {
 		var accessKey string
@@ -277,6 +300,25 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.OCI != nil {
+		driver := oci.ArtifactDriver{Insecure: art.OCI.Insecure}
+		if art.OCI.UsernameSecret != nil && art.OCI.UsernameSecret.Name != "" {
+			usernameBytes, err := ri.GetSecret(ctx, art.OCI.UsernameSecret.Name, art.OCI.UsernameSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Username = usernameBytes
+		}
+		if art.OCI.PasswordSecret != nil && art.OCI.PasswordSecret.Name != "" {
+			passwordBytes, err := ri.GetSecret(ctx, art.OCI.PasswordSecret.Name, art.OCI.PasswordSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Password = passwordBytes
+		}
+		return &driver, nil
+	}
+
 	if art.Plugin != nil {
 		// Get the socket path from the driver configuration
 		// This would typically come from the workflow controller configuration
@@ -86,3 +86,21 @@ func TestNewDriverS3AddressingStyle(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDriverEncryption(t *testing.T) {
+	art := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{
+			Raw: &wfv1.RawArtifact{Data: "my-data"},
+			Encryption: &wfv1.ArtifactEncryption{
+				KeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-key"}, Key: "key"},
+			},
+		},
+	}
+	// the mock secret is not a valid key
+	_, err := NewDriver(logging.TestContext(t.Context()), art, &mockResourceInterface{})
+	require.ErrorContains(t, err, "artifact encryption key is not base64 encoded")
+
+	art.Encryption.KeySecret = nil
+	_, err = NewDriver(logging.TestContext(t.Context()), art, &mockResourceInterface{})
+	require.EqualError(t, err, "artifact encryption requires a keySecret")
+}
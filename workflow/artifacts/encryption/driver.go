@@ -0,0 +1,174 @@
+package encryption
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+// driver encrypts artifacts before they are saved by the underlying driver, and decrypts them after they are loaded,
+// so that encryption works the same for every driver. Artifacts which were saved before encryption was enabled,
+// and so do not start with the header of an encrypted artifact, are loaded as they are.
+type driver struct {
+	common.ArtifactDriver
+	kek []byte
+}
+
+// New returns a driver which encrypts the artifacts of d with the key-encryption key
+func New(d common.ArtifactDriver, kek []byte) common.ArtifactDriver {
+	return &driver{d, kek}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (d *driver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	rc, err := d.ArtifactDriver.OpenStream(ctx, inputArtifact)
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(rc)
+	if !IsEncrypted(r) {
+		logging.RequireLoggerFromContext(ctx).WithField("artifactName", inputArtifact.Name).Warn(ctx, "Artifact is not encrypted, it was saved before encryption was enabled")
+		return readCloser{r, rc}, nil
+	}
+	decrypted, err := NewReader(r, d.kek)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return readCloser{decrypted, rc}, nil
+}
+
+func (d *driver) Load(ctx context.Context, inputArtifact *wfv1.Artifact, path string) error {
+	// the artifact is loaded next to its path, which is on a volume sized for it
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(path), ".encrypted-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	encrypted := filepath.Join(tmp, filepath.Base(path))
+	if err := d.ArtifactDriver.Load(ctx, inputArtifact, encrypted); err != nil {
+		return err
+	}
+	return transformPath(encrypted, path, d.decryptFile)
+}
+
+func (d *driver) Save(ctx context.Context, path string, outputArtifact *wfv1.Artifact) error {
+	tmp, err := os.MkdirTemp("", "encrypted-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	// the file name is kept, as some drivers name the object after it
+	encrypted := filepath.Join(tmp, filepath.Base(path))
+	if err := transformPath(path, encrypted, d.encryptFile); err != nil {
+		return err
+	}
+	return d.ArtifactDriver.Save(ctx, encrypted, outputArtifact)
+}
+
+func (d *driver) SaveStream(ctx context.Context, reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	pr, pw := io.Pipe()
+	go func() {
+		w, err := NewWriter(pw, d.kek)
+		if err == nil {
+			_, err = io.Copy(w, reader)
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	err := d.ArtifactDriver.SaveStream(ctx, pr, outputArtifact)
+	// unblocks the writer if the driver did not read the whole stream
+	_ = pr.CloseWithError(err)
+	return err
+}
+
+// transformPath transforms the file, or each file of the directory, at src to dst
+func transformPath(src, dst string, transform func(src, dst string) error) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return transform(src, dst)
+	}
+	return filepath.WalkDir(src, func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		if e.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), 0o755)
+		}
+		if !e.Type().IsRegular() {
+			return nil
+		}
+		return transform(p, filepath.Join(dst, rel))
+	})
+}
+
+func (d *driver) encryptFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	w, err := NewWriter(out, d.kek)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, in); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+func (d *driver) decryptFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	br := bufio.NewReader(in)
+	var r io.Reader = br
+	if IsEncrypted(br) {
+		if r, err = NewReader(br, d.kek); err != nil {
+			return err
+		}
+	}
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, r); err != nil {
+		return err
+	}
+	return out.Close()
+}
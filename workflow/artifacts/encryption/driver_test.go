@@ -0,0 +1,129 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+// memoryDriver stores files in memory, keyed by their path relative to the saved path
+type memoryDriver struct {
+	common.ArtifactDriver
+	objects map[string][]byte
+}
+
+func (d *memoryDriver) Save(_ context.Context, path string, _ *wfv1.Artifact) error {
+	return filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(path, p)
+		data, err := os.ReadFile(p)
+		d.objects[rel] = data
+		return err
+	})
+}
+
+func (d *memoryDriver) Load(_ context.Context, _ *wfv1.Artifact, path string) error {
+	for rel, data := range d.objects {
+		p := filepath.Join(path, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(p, data, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *memoryDriver) SaveStream(_ context.Context, reader io.Reader, _ *wfv1.Artifact) error {
+	data, err := io.ReadAll(reader)
+	d.objects["."] = data
+	return err
+}
+
+func (d *memoryDriver) OpenStream(_ context.Context, _ *wfv1.Artifact) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(d.objects["."])), nil
+}
+
+func TestDriver(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	art := &wfv1.Artifact{Name: "my-art"}
+	tmp := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		mem := &memoryDriver{objects: map[string][]byte{}}
+		drv := New(mem, newKey(t))
+		src := filepath.Join(tmp, "file.txt")
+		require.NoError(t, os.WriteFile(src, []byte("my-secret"), 0o600))
+		require.NoError(t, drv.Save(ctx, src, art))
+		assert.True(t, bytes.HasPrefix(mem.objects["."], []byte(Magic)))
+		assert.NotContains(t, string(mem.objects["."]), "my-secret")
+
+		dst := filepath.Join(tmp, "loaded", "file.txt")
+		require.NoError(t, drv.Load(ctx, art, dst))
+		data, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "my-secret", string(data))
+
+		rc, err := drv.OpenStream(ctx, art)
+		require.NoError(t, err)
+		data, err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, "my-secret", string(data))
+	})
+	t.Run("Directory", func(t *testing.T) {
+		mem := &memoryDriver{objects: map[string][]byte{}}
+		drv := New(mem, newKey(t))
+		src := filepath.Join(tmp, "dir")
+		require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "file.txt"), []byte("my-secret"), 0o600))
+		require.NoError(t, drv.Save(ctx, src, art))
+		assert.True(t, bytes.HasPrefix(mem.objects[filepath.Join("sub", "file.txt")], []byte(Magic)))
+
+		dst := filepath.Join(tmp, "loaded-dir")
+		require.NoError(t, drv.Load(ctx, art, dst))
+		data, err := os.ReadFile(filepath.Join(dst, "sub", "file.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "my-secret", string(data))
+	})
+	t.Run("SaveStream", func(t *testing.T) {
+		mem := &memoryDriver{objects: map[string][]byte{}}
+		drv := New(mem, newKey(t))
+		require.NoError(t, drv.SaveStream(ctx, strings.NewReader("my-secret"), art))
+		assert.True(t, bytes.HasPrefix(mem.objects["."], []byte(Magic)))
+		rc, err := drv.OpenStream(ctx, art)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "my-secret", string(data))
+	})
+	t.Run("NotEncrypted", func(t *testing.T) {
+		mem := &memoryDriver{objects: map[string][]byte{".": []byte("saved before encryption")}}
+		drv := New(mem, newKey(t))
+		rc, err := drv.OpenStream(ctx, art)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "saved before encryption", string(data))
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		mem := &memoryDriver{objects: map[string][]byte{}}
+		require.NoError(t, New(mem, newKey(t)).SaveStream(ctx, strings.NewReader("my-secret"), art))
+		_, err := New(mem, newKey(t)).OpenStream(ctx, art)
+		require.ErrorIs(t, err, ErrWrongKey)
+	})
+}
@@ -0,0 +1,258 @@
+// Package encryption implements the client-side envelope encryption of artifacts.
+//
+// An encrypted artifact starts with a header, containing the ID of the key-encryption key and the data key
+// wrapped by it, followed by the content sealed in chunks with AES-256-GCM. Each chunk is authenticated with
+// the header, its index and whether it is the last, so that chunks cannot be reordered, dropped, or truncated.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// Magic starts every encrypted artifact
+	Magic = "ARGOENC1"
+	// ChunkSize is the size of the plaintext of each sealed chunk
+	ChunkSize = 64 * 1024
+
+	keySize       = 32
+	keyIDSize     = 8
+	nonceSize     = 12
+	tagSize       = 16
+	wrappedKeyLen = nonceSize + keySize + tagSize
+	headerSize    = len(Magic) + keyIDSize + wrappedKeyLen + nonceSize
+)
+
+// ErrWrongKey is returned when an artifact was encrypted with a different key-encryption key
+var ErrWrongKey = errors.New("artifact was encrypted with a different key")
+
+// ParseKey parses a base64 encoded 256-bit key-encryption key, as held in a secret
+func ParseKey(value string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("artifact encryption key is not base64 encoded: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("artifact encryption key must be %d bytes, not %d", keySize, len(key))
+	}
+	return key, nil
+}
+
+// KeyID returns the ID of a key-encryption key, which is stored with the artifacts it encrypts
+func KeyID(kek []byte) []byte {
+	sum := sha256.Sum256(kek)
+	return sum[:keyIDSize]
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce derives the nonce of a chunk from the artifact's base nonce and the chunk's index
+func chunkNonce(base []byte, index uint64) []byte {
+	nonce := bytes.Clone(base)
+	counter := binary.BigEndian.Uint64(nonce[nonceSize-8:]) ^ index
+	binary.BigEndian.PutUint64(nonce[nonceSize-8:], counter)
+	return nonce
+}
+
+// chunkAAD is the additional data authenticated with a chunk
+func chunkAAD(header []byte, index uint64, last bool) []byte {
+	aad := make([]byte, 0, len(header)+9)
+	aad = append(aad, header...)
+	aad = binary.BigEndian.AppendUint64(aad, index)
+	if last {
+		return append(aad, 1)
+	}
+	return append(aad, 0)
+}
+
+type writer struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	buf    []byte
+	index  uint64
+	closed bool
+}
+
+// NewWriter returns a writer which encrypts what is written to it with a new data key, wrapped by the
+// key-encryption key. Close must be called to write the last chunk; it does not close w.
+func NewWriter(w io.Writer, kek []byte) (io.WriteCloser, error) {
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+	wrapper, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	wrapNonce := make([]byte, nonceSize)
+	if _, err := rand.Read(wrapNonce); err != nil {
+		return nil, err
+	}
+	header := make([]byte, 0, headerSize)
+	header = append(header, Magic...)
+	header = append(header, KeyID(kek)...)
+	header = append(header, wrapNonce...)
+	header = wrapper.Seal(header, wrapNonce, dek, []byte(Magic))
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &writer{w: w, aead: aead, header: header, nonce: nonce, buf: make([]byte, 0, ChunkSize)}, nil
+}
+
+func (e *writer) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed encryption writer")
+	}
+	n := 0
+	for len(p) > 0 {
+		// a full chunk is only sealed once more is written, as the last chunk is sealed differently
+		if len(e.buf) == ChunkSize {
+			if err := e.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := copy(e.buf[len(e.buf):ChunkSize], p)
+		e.buf = e.buf[:len(e.buf)+m]
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+func (e *writer) seal(last bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.nonce, e.index), e.buf, chunkAAD(e.header, e.index, last))
+	e.index++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(sealed)
+	return err
+}
+
+func (e *writer) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+type reader struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	buf    []byte
+	chunk  []byte
+	index  uint64
+	done   bool
+}
+
+// NewReader returns a reader which decrypts an artifact encrypted by a writer with the same key-encryption key
+func NewReader(r io.Reader, kek []byte) (io.Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.New("artifact is not encrypted")
+		}
+		return nil, err
+	}
+	if string(header[:len(Magic)]) != Magic {
+		return nil, errors.New("artifact is not encrypted")
+	}
+	offset := len(Magic)
+	if !bytes.Equal(header[offset:offset+keyIDSize], KeyID(kek)) {
+		return nil, ErrWrongKey
+	}
+	offset += keyIDSize
+	wrapper, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	wrapNonce := header[offset : offset+nonceSize]
+	offset += nonceSize
+	dek, err := wrapper.Open(nil, wrapNonce, header[offset:offset+keySize+tagSize], []byte(Magic))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap artifact data key: %w", err)
+	}
+	offset += keySize + tagSize
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		r:      bufio.NewReaderSize(r, ChunkSize+tagSize+1),
+		aead:   aead,
+		header: header,
+		nonce:  header[offset:],
+		chunk:  make([]byte, ChunkSize+tagSize),
+	}, nil
+}
+
+func (d *reader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *reader) open() error {
+	n, err := io.ReadFull(d.r, d.chunk)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+	// a chunk is the last unless it is full and followed by more
+	last := n < len(d.chunk)
+	if !last {
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		}
+	}
+	plain, err := d.aead.Open(d.chunk[:0], chunkNonce(d.nonce, d.index), d.chunk[:n], chunkAAD(d.header, d.index, last))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt artifact, it is corrupt or truncated: %w", err)
+	}
+	d.index++
+	d.buf = plain
+	d.done = last
+	return nil
+}
+
+// IsEncrypted returns whether the reader starts with the header of an encrypted artifact, without consuming it
+func IsEncrypted(r *bufio.Reader) bool {
+	magic, err := r.Peek(len(Magic))
+	return err == nil && string(magic) == Magic
+}
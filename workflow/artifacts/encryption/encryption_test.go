@@ -0,0 +1,84 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func encrypt(t *testing.T, kek, plain []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, kek)
+	require.NoError(t, err)
+	_, err = w.Write(plain)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(kek, encrypted []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(encrypted), kek)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestEncryption(t *testing.T) {
+	kek := newKey(t)
+	for name, size := range map[string]int{"Empty": 0, "Small": 10, "Chunk": ChunkSize, "Chunks": 3*ChunkSize + 7} {
+		t.Run(name, func(t *testing.T) {
+			plain := make([]byte, size)
+			_, _ = rand.Read(plain)
+			encrypted := encrypt(t, kek, plain)
+			if size > 0 {
+				assert.NotContains(t, string(encrypted), string(plain[:min(size, 10)]))
+			}
+			decrypted, err := decrypt(kek, encrypted)
+			require.NoError(t, err)
+			assert.Equal(t, plain, decrypted)
+		})
+	}
+	t.Run("WrongKey", func(t *testing.T) {
+		_, err := decrypt(newKey(t), encrypt(t, kek, []byte("foo")))
+		require.ErrorIs(t, err, ErrWrongKey)
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		encrypted := encrypt(t, kek, []byte("foo"))
+		encrypted[len(encrypted)-1] ^= 1
+		_, err := decrypt(kek, encrypted)
+		require.ErrorContains(t, err, "corrupt or truncated")
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		encrypted := encrypt(t, kek, make([]byte, 2*ChunkSize))
+		// drops the empty last chunk, leaving only full chunks
+		_, err := decrypt(kek, encrypted[:len(encrypted)-tagSize])
+		require.ErrorContains(t, err, "corrupt or truncated")
+	})
+	t.Run("NotEncrypted", func(t *testing.T) {
+		_, err := decrypt(kek, []byte("foo"))
+		require.EqualError(t, err, "artifact is not encrypted")
+	})
+}
+
+func TestParseKey(t *testing.T) {
+	key, err := ParseKey(base64.StdEncoding.EncodeToString(make([]byte, keySize)) + "\n")
+	require.NoError(t, err)
+	assert.Len(t, key, keySize)
+	_, err = ParseKey("not-base64!")
+	require.Error(t, err)
+	_, err = ParseKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	require.EqualError(t, err, "artifact encryption key must be 32 bytes, not 5")
+}
@@ -0,0 +1,509 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	argoerrors "github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+const (
+	// ArtifactType is the artifact type of the manifests pushed by the driver
+	ArtifactType = "application/vnd.argoproj.workflow.artifact.v1"
+	// DefaultMediaType is the media type of the layers of an artifact which does not set one
+	DefaultMediaType = "application/octet-stream"
+	// AnnotationDirectory marks the manifest of an artifact saved from a directory, which has a layer per file
+	AnnotationDirectory = "workflows.argoproj.io/directory"
+	// AnnotationKey is the key of the artifact, which keeps the manifests of different artifacts distinct
+	AnnotationKey = "workflows.argoproj.io/key"
+	// annotationTitle is the standard annotation of the file name of a layer
+	annotationTitle    = "org.opencontainers.image.title"
+	emptyJSONMediaType = "application/vnd.oci.empty.v1+json"
+)
+
+// ArtifactDriver is a driver for OCI registries, which pushes and pulls artifacts as OCI artifacts.
+// The directory of an artifact's key is appended to the repository, and its base name is the tag.
+type ArtifactDriver struct {
+	Username string
+	Password string
+	Insecure bool
+}
+
+var _ common.ArtifactDriver = &ArtifactDriver{}
+
+// artifactManifest is a manifest to put to the registry
+type artifactManifest struct {
+	raw []byte
+}
+
+func (m artifactManifest) RawManifest() ([]byte, error) { return m.raw, nil }
+
+func (m artifactManifest) MediaType() (types.MediaType, error) { return types.OCIManifestSchema1, nil }
+
+// fileLayer is a layer read from a local file, so that large artifacts are not held in memory
+type fileLayer struct {
+	path      string
+	digest    v1.Hash
+	size      int64
+	mediaType types.MediaType
+}
+
+var _ v1.Layer = &fileLayer{}
+
+func newFileLayer(filePath string, mediaType types.MediaType) (*fileLayer, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	digest, size, err := v1.SHA256(f)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLayer{path: filePath, digest: digest, size: size, mediaType: mediaType}, nil
+}
+
+func (l *fileLayer) Digest() (v1.Hash, error) { return l.digest, nil }
+
+// DiffID is the digest, as layers are pushed uncompressed
+func (l *fileLayer) DiffID() (v1.Hash, error) { return l.digest, nil }
+
+func (l *fileLayer) Compressed() (io.ReadCloser, error) { return os.Open(l.path) }
+
+func (l *fileLayer) Uncompressed() (io.ReadCloser, error) { return os.Open(l.path) }
+
+func (l *fileLayer) Size() (int64, error) { return l.size, nil }
+
+func (l *fileLayer) MediaType() (types.MediaType, error) { return l.mediaType, nil }
+
+func (l *fileLayer) descriptor(title string) v1.Descriptor {
+	return v1.Descriptor{MediaType: l.mediaType, Size: l.size, Digest: l.digest, Annotations: map[string]string{annotationTitle: title}}
+}
+
+// resolved is the manifest an artifact key resolves to
+type resolved struct {
+	repo     name.Repository
+	tag      string
+	digest   v1.Hash
+	manifest *v1.Manifest
+	// file is the path of the key within the directory artifact of the manifest, if the key is not the artifact itself
+	file string
+}
+
+func (r *resolved) isDirectory() bool {
+	if r.file != "" {
+		return slices.ContainsFunc(r.manifest.Layers, func(l v1.Descriptor) bool {
+			return strings.HasPrefix(l.Annotations[annotationTitle], r.file+"/")
+		})
+	}
+	return r.manifest.Annotations[AnnotationDirectory] == "true"
+}
+
+// layers returns the layers of the file, or the files of the directory, the key resolved to
+func (r *resolved) layers() []v1.Descriptor {
+	if r.file == "" {
+		return r.manifest.Layers
+	}
+	var layers []v1.Descriptor
+	for _, l := range r.manifest.Layers {
+		title := l.Annotations[annotationTitle]
+		if title == r.file || strings.HasPrefix(title, r.file+"/") {
+			layers = append(layers, l)
+		}
+	}
+	return layers
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
+
+func (d *ArtifactDriver) nameOptions() []name.Option {
+	if d.Insecure {
+		return []name.Option{name.Insecure}
+	}
+	return nil
+}
+
+func (d *ArtifactDriver) remoteOptions(ctx context.Context) []remote.Option {
+	var auth authn.Authenticator = authn.Anonymous
+	if d.Username != "" || d.Password != "" {
+		auth = &authn.Basic{Username: d.Username, Password: d.Password}
+	}
+	opts := []remote.Option{remote.WithContext(ctx), remote.WithAuth(auth)}
+	if d.Insecure {
+		t := remote.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402 -- opted in to by the artifact
+		opts = append(opts, remote.WithTransport(t))
+	}
+	return opts
+}
+
+// reference returns the repository and tag of a key
+func (d *ArtifactDriver) reference(art *wfv1.OCIArtifact, key string) (name.Repository, string, error) {
+	dir, tag := path.Split(strings.Trim(key, "/"))
+	repoPath := path.Join(art.Repository, strings.ToLower(dir))
+	if repoPath == "" || art.Registry == "" {
+		return name.Repository{}, "", argoerrors.Errorf(argoerrors.CodeBadRequest, "OCI artifact %s must have a registry and a repository", key)
+	}
+	repo, err := name.NewRepository(art.Registry+"/"+repoPath, d.nameOptions()...)
+	if err != nil {
+		return name.Repository{}, "", argoerrors.Errorf(argoerrors.CodeBadRequest, "invalid OCI repository for key %s: %v", key, err)
+	}
+	if _, err := name.NewTag(repo.String()+":"+tag, d.nameOptions()...); err != nil {
+		return name.Repository{}, "", argoerrors.Errorf(argoerrors.CodeBadRequest, "invalid OCI tag for key %s: %v", key, err)
+	}
+	return repo, tag, nil
+}
+
+// getManifest gets the manifest of a key, pinned to the artifact's digest if it has one
+func (d *ArtifactDriver) getManifest(ctx context.Context, art *wfv1.OCIArtifact, key string) (*resolved, error) {
+	repo, tag, err := d.reference(art, key)
+	if err != nil {
+		return nil, err
+	}
+	var ref name.Reference = repo.Tag(tag)
+	if art.Digest != "" {
+		ref = repo.Digest(art.Digest)
+	}
+	desc, err := remote.Get(ref, d.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	return &resolved{repo: repo, tag: tag, digest: desc.Digest, manifest: manifest}, nil
+}
+
+// resolve returns the manifest of the artifact's key. A key within a directory artifact, e.g. of a file
+// listed by ListObjects, resolves to the manifest of the directory.
+func (d *ArtifactDriver) resolve(ctx context.Context, art *wfv1.Artifact) (*resolved, error) {
+	key := strings.Trim(art.OCI.Key, "/")
+	r, err := d.getManifest(ctx, art.OCI, key)
+	if err == nil || !isNotFound(err) {
+		return r, err
+	}
+	for dir := path.Dir(key); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		parent, parentErr := d.getManifest(ctx, art.OCI, dir)
+		if parentErr != nil {
+			if isNotFound(parentErr) {
+				continue
+			}
+			// the directory has no repository, so neither do its parents
+			break
+		}
+		parent.file = strings.TrimPrefix(key, dir+"/")
+		if len(parent.layers()) > 0 {
+			return parent, nil
+		}
+		break
+	}
+	return nil, argoerrors.Errorf(argoerrors.CodeNotFound, "OCI artifact %s not found: %v", art.OCI.Key, err)
+}
+
+func (d *ArtifactDriver) openLayer(ctx context.Context, r *resolved, layer v1.Descriptor) (io.ReadCloser, error) {
+	l, err := remote.Layer(r.repo.Digest(layer.Digest.String()), d.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	// layers are blobs pushed as is, and the reader verifies their digest
+	return l.Compressed()
+}
+
+func (d *ArtifactDriver) loadLayer(ctx context.Context, r *resolved, layer v1.Descriptor, localPath string) error {
+	rc, err := d.openLayer(ctx, r, layer)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+// Load pulls the artifact to the path, as a directory if it was saved from one
+func (d *ArtifactDriver) Load(ctx context.Context, inputArtifact *wfv1.Artifact, localPath string) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	logger.WithFields(logging.Fields{"registry": inputArtifact.OCI.Registry, "key": inputArtifact.OCI.Key, "digest": inputArtifact.OCI.Digest}).Info(ctx, "OCI Load")
+	r, err := d.resolve(ctx, inputArtifact)
+	if err != nil {
+		return err
+	}
+	if !r.isDirectory() {
+		layers := r.layers()
+		if len(layers) != 1 {
+			return fmt.Errorf("OCI artifact %s has %d layers, expected 1", inputArtifact.OCI.Key, len(layers))
+		}
+		return d.loadLayer(ctx, r, layers[0], localPath)
+	}
+	if err := os.MkdirAll(localPath, 0o755); err != nil {
+		return err
+	}
+	for _, layer := range r.layers() {
+		rel := strings.TrimPrefix(strings.TrimPrefix(layer.Annotations[annotationTitle], r.file), "/")
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("OCI artifact %s has a file outside of its directory: %s", inputArtifact.OCI.Key, rel)
+		}
+		if err := d.loadLayer(ctx, r, layer, filepath.Join(localPath, rel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OpenStream opens the layer of an artifact saved from a file, or of a file within a directory artifact
+func (d *ArtifactDriver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	r, err := d.resolve(ctx, inputArtifact)
+	if err != nil {
+		return nil, err
+	}
+	layers := r.layers()
+	if r.isDirectory() || len(layers) != 1 {
+		return nil, argoerrors.Errorf(argoerrors.CodeBadRequest, "OCI artifact %s is a directory", inputArtifact.OCI.Key)
+	}
+	return d.openLayer(ctx, r, layers[0])
+}
+
+// Save pushes the file or directory as an OCI artifact, with a layer per file, and sets the artifact's digest
+// to the digest of its manifest
+func (d *ArtifactDriver) Save(ctx context.Context, localPath string, outputArtifact *wfv1.Artifact) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	art := outputArtifact.OCI
+	logger.WithFields(logging.Fields{"registry": art.Registry, "key": art.Key}).Info(ctx, "OCI Save")
+	repo, tag, err := d.reference(art, art.Key)
+	if err != nil {
+		return err
+	}
+	mediaType := types.MediaType(DefaultMediaType)
+	if art.MediaType != "" {
+		mediaType = types.MediaType(art.MediaType)
+	}
+	annotations := map[string]string{AnnotationKey: strings.Trim(art.Key, "/")}
+	for k, v := range art.Annotations {
+		annotations[k] = v
+	}
+	var layers []*fileLayer
+	var descriptors []v1.Descriptor
+	addFile := func(filePath, title string) error {
+		l, err := newFileLayer(filePath, mediaType)
+		if err != nil {
+			return err
+		}
+		layers = append(layers, l)
+		descriptors = append(descriptors, l.descriptor(title))
+		return nil
+	}
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		annotations[AnnotationDirectory] = "true"
+		err = filepath.WalkDir(localPath, func(p string, e fs.DirEntry, err error) error {
+			if err != nil || !e.Type().IsRegular() {
+				return err
+			}
+			rel, err := filepath.Rel(localPath, p)
+			if err != nil {
+				return err
+			}
+			return addFile(p, filepath.ToSlash(rel))
+		})
+	} else {
+		err = addFile(localPath, tag)
+	}
+	if err != nil {
+		return err
+	}
+	opts := d.remoteOptions(ctx)
+	config := static.NewLayer([]byte("{}"), emptyJSONMediaType)
+	if err := remote.WriteLayer(repo, config, opts...); err != nil {
+		return fmt.Errorf("failed to push OCI artifact %s: %w", art.Key, err)
+	}
+	for _, l := range layers {
+		if err := remote.WriteLayer(repo, l, opts...); err != nil {
+			return fmt.Errorf("failed to push OCI artifact %s: %w", art.Key, err)
+		}
+	}
+	configDigest, _ := config.Digest()
+	raw, err := json.Marshal(v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		ArtifactType:  ArtifactType,
+		Config:        v1.Descriptor{MediaType: emptyJSONMediaType, Size: 2, Digest: configDigest},
+		Layers:        descriptors,
+		Annotations:   annotations,
+	})
+	if err != nil {
+		return err
+	}
+	if err := remote.Put(repo.Tag(tag), artifactManifest{raw: raw}, opts...); err != nil {
+		return fmt.Errorf("failed to push OCI artifact %s: %w", art.Key, err)
+	}
+	digest, _, err := v1.SHA256(bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	art.Digest = digest.String()
+	logger.WithFields(logging.Fields{"key": art.Key, "digest": art.Digest}).Info(ctx, "Pushed OCI artifact")
+	return nil
+}
+
+// SaveStream pushes the stream as an OCI artifact, staging it in a temporary file as the digest of a layer
+// must be known before it is pushed
+func (d *ArtifactDriver) SaveStream(ctx context.Context, reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	dir, err := os.MkdirTemp("", "oci-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	localPath := filepath.Join(dir, path.Base(outputArtifact.OCI.Key))
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return d.Save(ctx, localPath, outputArtifact)
+}
+
+// Delete deletes the manifest of the artifact, and its tag for registries which keep tags of deleted manifests.
+// The registry garbage collects the layers which are no longer referenced.
+func (d *ArtifactDriver) Delete(ctx context.Context, artifact *wfv1.Artifact) error {
+	r, err := d.resolve(ctx, artifact)
+	if argoerrors.IsCode(argoerrors.CodeNotFound, err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if r.file != "" {
+		return argoerrors.Errorf(argoerrors.CodeBadRequest, "cannot delete %s, which is within the OCI artifact %s", artifact.OCI.Key, r.tag)
+	}
+	opts := d.remoteOptions(ctx)
+	if err := remote.Delete(r.repo.Digest(r.digest.String()), opts...); err != nil && !isNotFound(err) {
+		return err
+	}
+	if err := remote.Delete(r.repo.Tag(r.tag), opts...); err != nil && !isNotFound(err) {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithField("key", artifact.OCI.Key).Debug(ctx, "Failed to delete OCI tag")
+	}
+	return nil
+}
+
+// ListObjects lists the keys of the files of a directory artifact, or the keys of the artifacts under the key,
+// which are the tags of its repository and, if the registry's catalog is available, of the repositories under it
+func (d *ArtifactDriver) ListObjects(ctx context.Context, artifact *wfv1.Artifact) ([]string, error) {
+	key := strings.Trim(artifact.OCI.Key, "/")
+	r, err := d.resolve(ctx, artifact)
+	if err == nil {
+		if !r.isDirectory() {
+			return []string{key}, nil
+		}
+		var files []string
+		for _, layer := range r.layers() {
+			rel := strings.TrimPrefix(strings.TrimPrefix(layer.Annotations[annotationTitle], r.file), "/")
+			files = append(files, path.Join(key, rel))
+		}
+		return files, nil
+	}
+	if !argoerrors.IsCode(argoerrors.CodeNotFound, err) {
+		return nil, err
+	}
+	repo, _, err := d.reference(artifact.OCI, path.Join(key, "_"))
+	if err != nil {
+		return nil, err
+	}
+	opts := d.remoteOptions(ctx)
+	files, err := d.listTags(ctx, repo, key)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := remote.Catalog(ctx, repo.Registry, opts...)
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithField("registry", repo.RegistryStr()).Debug(ctx, "Unable to list the OCI registry catalog, listing only the repository of the key")
+		return files, nil
+	}
+	for _, repoName := range repos {
+		rel, ok := strings.CutPrefix(repoName, repo.RepositoryStr()+"/")
+		if !ok {
+			continue
+		}
+		keys, err := d.listTags(ctx, repo.Registry.Repo(repoName), path.Join(key, rel))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, keys...)
+	}
+	return files, nil
+}
+
+// listTags returns the keys of the artifacts tagged in the repository
+func (d *ArtifactDriver) listTags(ctx context.Context, repo name.Repository, key string) ([]string, error) {
+	tags, err := remote.List(repo, d.remoteOptions(ctx)...)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(tags))
+	for i, tag := range tags {
+		keys[i] = path.Join(key, tag)
+	}
+	return keys, nil
+}
+
+// IsDirectory returns whether the artifact was saved from a directory, or there are artifacts under its key
+func (d *ArtifactDriver) IsDirectory(ctx context.Context, artifact *wfv1.Artifact) (bool, error) {
+	r, err := d.resolve(ctx, artifact)
+	if err == nil {
+		return r.isDirectory(), nil
+	}
+	if !argoerrors.IsCode(argoerrors.CodeNotFound, err) {
+		return false, err
+	}
+	repo, _, err := d.reference(artifact.OCI, path.Join(artifact.OCI.Key, "_"))
+	if err != nil {
+		return false, err
+	}
+	keys, err := d.listTags(ctx, repo, artifact.OCI.Key)
+	return len(keys) > 0, err
+}
@@ -0,0 +1,154 @@
+package oci
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoerrors "github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func newArtifact(host, key string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{OCI: &wfv1.OCIArtifact{
+		OCIRegistry: wfv1.OCIRegistry{Registry: host, Repository: "my-org/artifacts"},
+		Key:         key,
+	}}}
+}
+
+func TestArtifactDriver(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	driver := &ArtifactDriver{}
+	tmp := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		src := filepath.Join(tmp, "main.log")
+		require.NoError(t, os.WriteFile(src, []byte("my-log"), 0o600))
+		art := newArtifact(host, "my-wf/my-pod/main.log")
+		art.OCI.MediaType = "text/plain"
+		art.OCI.Annotations = map[string]string{"my-annotation": "my-value"}
+		require.NoError(t, driver.Save(ctx, src, art))
+		assert.True(t, strings.HasPrefix(art.OCI.Digest, "sha256:"))
+
+		ref, err := name.ParseReference(host + "/my-org/artifacts/my-wf/my-pod:main.log")
+		require.NoError(t, err)
+		desc, err := remote.Get(ref)
+		require.NoError(t, err)
+		assert.Equal(t, art.OCI.Digest, desc.Digest.String())
+		manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+		require.NoError(t, err)
+		assert.Equal(t, ArtifactType, manifest.ArtifactType)
+		assert.Equal(t, "my-value", manifest.Annotations["my-annotation"])
+		require.Len(t, manifest.Layers, 1)
+		assert.Equal(t, "text/plain", string(manifest.Layers[0].MediaType))
+		assert.Equal(t, "main.log", manifest.Layers[0].Annotations[annotationTitle])
+
+		dst := filepath.Join(tmp, "loaded.log")
+		require.NoError(t, driver.Load(ctx, art, dst))
+		data, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "my-log", string(data))
+
+		rc, err := driver.OpenStream(ctx, art)
+		require.NoError(t, err)
+		data, err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, "my-log", string(data))
+
+		isDir, err := driver.IsDirectory(ctx, art)
+		require.NoError(t, err)
+		assert.False(t, isDir)
+	})
+	t.Run("Digest", func(t *testing.T) {
+		art := newArtifact(host, "my-wf/my-pod/pinned.txt")
+		require.NoError(t, driver.SaveStream(ctx, strings.NewReader("v1"), art))
+		pinned := art.DeepCopy()
+		require.NoError(t, driver.SaveStream(ctx, strings.NewReader("v2"), art))
+		assert.NotEqual(t, pinned.OCI.Digest, art.OCI.Digest)
+
+		rc, err := driver.OpenStream(ctx, pinned)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, "v1", string(data), "loads the pinned digest rather than the tag")
+	})
+	t.Run("Directory", func(t *testing.T) {
+		src := filepath.Join(tmp, "dir")
+		require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+		art := newArtifact(host, "my-wf/my-pod/dir")
+		require.NoError(t, driver.Save(ctx, src, art))
+
+		isDir, err := driver.IsDirectory(ctx, art)
+		require.NoError(t, err)
+		assert.True(t, isDir)
+		keys, err := driver.ListObjects(ctx, art)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"my-wf/my-pod/dir/a.txt", "my-wf/my-pod/dir/sub/b.txt"}, keys)
+
+		dst := filepath.Join(tmp, "loaded-dir")
+		require.NoError(t, driver.Load(ctx, art, dst))
+		data, err := os.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+
+		file := newArtifact(host, "my-wf/my-pod/dir/sub/b.txt")
+		rc, err := driver.OpenStream(ctx, file)
+		require.NoError(t, err, "opens a file within the directory")
+		data, err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, "b", string(data))
+
+		_, err = driver.OpenStream(ctx, art)
+		require.Error(t, err)
+	})
+	t.Run("ListObjects", func(t *testing.T) {
+		for _, key := range []string{"my-wf/my-stream/part-000000", "my-wf/my-stream/part-000001", "my-wf/my-stream/nested/complete"} {
+			require.NoError(t, driver.SaveStream(ctx, strings.NewReader(key), newArtifact(host, key)))
+		}
+		keys, err := driver.ListObjects(ctx, newArtifact(host, "my-wf/my-stream"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"my-wf/my-stream/part-000000", "my-wf/my-stream/part-000001", "my-wf/my-stream/nested/complete"}, keys)
+
+		keys, err = driver.ListObjects(ctx, newArtifact(host, "my-wf/missing"))
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		art := newArtifact(host, "my-wf/my-pod/deleted.txt")
+		require.NoError(t, driver.SaveStream(ctx, strings.NewReader("deleted"), art))
+		require.NoError(t, driver.Delete(ctx, art))
+		err := driver.Load(ctx, art, filepath.Join(tmp, "deleted.txt"))
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err))
+		require.NoError(t, driver.Delete(ctx, art), "deleting a missing artifact succeeds")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(ctx, newArtifact(host, "my-wf/my-pod/missing.txt"), filepath.Join(tmp, "missing.txt"))
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err))
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		art := newArtifact(host, "my-wf/my-pod/"+strings.Repeat("x", 129))
+		err := driver.SaveStream(ctx, strings.NewReader(""), art)
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeBadRequest, err))
+	})
+}
@@ -0,0 +1,185 @@
+// Package stream implements streaming artifacts, which are read by consumers while the producer is still writing them.
+//
+// A stream is stored under the artifact's key as a sequence of parts, which are written in order with SaveStream and
+// read in order with OpenStream. Once every part is written, a completion marker is written after them. If the producer
+// gives up, an abort marker is written instead. As the markers are written after the parts, a listing of the key
+// which contains a marker also contains every part.
+package stream
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+const (
+	completeMarker = "complete"
+	abortMarker    = "aborted"
+)
+
+// PollInterval is how often readers list the stream for new parts
+var PollInterval = 5 * time.Second
+
+func partName(i int) string {
+	return fmt.Sprintf("part-%06d", i)
+}
+
+func objectArtifact(art *wfv1.Artifact, name string) (*wfv1.Artifact, error) {
+	key, err := art.GetKey()
+	if err != nil {
+		return nil, err
+	}
+	objectArt := art.DeepCopy()
+	if err := objectArt.SetKey(path.Join(key, name)); err != nil {
+		return nil, err
+	}
+	return objectArt, nil
+}
+
+// Writer writes a stream. Writes are buffered until Flush, which saves them as the next part.
+type Writer struct {
+	ctx    context.Context
+	driver common.ArtifactDriver
+	art    *wfv1.Artifact
+	buf    bytes.Buffer
+	parts  int
+}
+
+// NewWriter returns a writer of the stream at the artifact's key
+func NewWriter(ctx context.Context, driver common.ArtifactDriver, art *wfv1.Artifact) *Writer {
+	return &Writer{ctx: ctx, driver: driver, art: art}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+// Flush saves any buffered writes as the next part of the stream
+func (w *Writer) Flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	if err := w.save(partName(w.parts), w.buf.Bytes()); err != nil {
+		return err
+	}
+	w.parts++
+	w.buf.Reset()
+	return nil
+}
+
+// Close flushes the writer and marks the stream complete
+func (w *Writer) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.save(completeMarker, []byte(fmt.Sprint(w.parts)))
+}
+
+// Abort marks the stream aborted, so readers return an error rather than wait for parts which will never be written
+func (w *Writer) Abort() error {
+	return w.save(abortMarker, nil)
+}
+
+func (w *Writer) save(name string, data []byte) error {
+	objectArt, err := objectArtifact(w.art, name)
+	if err != nil {
+		return err
+	}
+	return w.driver.SaveStream(w.ctx, bytes.NewReader(data), objectArt)
+}
+
+// Reader reads a stream, waiting for parts which have not yet been written, until the stream is complete
+type Reader struct {
+	ctx    context.Context
+	driver common.ArtifactDriver
+	art    *wfv1.Artifact
+	part   io.ReadCloser
+	next   int
+}
+
+// NewReader returns a reader of the stream at the artifact's key
+func NewReader(ctx context.Context, driver common.ArtifactDriver, art *wfv1.Artifact) *Reader {
+	return &Reader{ctx: ctx, driver: driver, art: art}
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	for {
+		if r.part == nil {
+			part, err := r.openNext()
+			if err != nil {
+				return 0, err
+			}
+			r.part = part
+		}
+		n, err := r.part.Read(p)
+		if err == io.EOF {
+			if err := r.closePart(); err != nil {
+				return n, err
+			}
+			if n == 0 {
+				continue
+			}
+			return n, nil
+		}
+		return n, err
+	}
+}
+
+// openNext waits until the next part has been written, then opens it. It returns io.EOF if the stream is complete.
+func (r *Reader) openNext() (io.ReadCloser, error) {
+	name := partName(r.next)
+	for {
+		objects, err := r.driver.ListObjects(r.ctx, r.art)
+		// some drivers, such as S3, report a prefix with no objects as not found, which just means nothing has been written yet
+		if err != nil && !errors.IsCode(errors.CodeNotFound, err) {
+			return nil, err
+		}
+		written := map[string]bool{}
+		for _, object := range objects {
+			written[path.Base(object)] = true
+		}
+		switch {
+		case written[name]:
+			objectArt, err := objectArtifact(r.art, name)
+			if err != nil {
+				return nil, err
+			}
+			part, err := r.driver.OpenStream(r.ctx, objectArt)
+			if err != nil {
+				return nil, err
+			}
+			r.next++
+			return part, nil
+		case written[completeMarker]:
+			return nil, io.EOF
+		case written[abortMarker]:
+			return nil, errors.Errorf(errors.CodeNotFound, "stream %s was aborted by its producer", r.art.Name)
+		}
+		select {
+		case <-r.ctx.Done():
+			return nil, r.ctx.Err()
+		case <-time.After(PollInterval):
+		}
+	}
+}
+
+func (r *Reader) closePart() error {
+	err := r.part.Close()
+	r.part = nil
+	return err
+}
+
+// Close closes the part being read, if any
+func (r *Reader) Close() error {
+	if r.part == nil {
+		return nil
+	}
+	return r.closePart()
+}
@@ -0,0 +1,122 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
+)
+
+// memoryDriver stores objects in memory, keyed by their S3 key
+type memoryDriver struct {
+	common.ArtifactDriver
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (d *memoryDriver) SaveStream(_ context.Context, reader io.Reader, art *wfv1.Artifact) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.objects[art.S3.Key] = data
+	return nil
+}
+
+func (d *memoryDriver) OpenStream(_ context.Context, art *wfv1.Artifact) (io.ReadCloser, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return io.NopCloser(bytes.NewReader(d.objects[art.S3.Key])), nil
+}
+
+func (d *memoryDriver) ListObjects(_ context.Context, art *wfv1.Artifact) ([]string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var objects []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, art.S3.Key+"/") {
+			objects = append(objects, key)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, errors.New(errors.CodeNotFound, "no key found of name "+art.S3.Key)
+	}
+	return objects, nil
+}
+
+func TestStream(t *testing.T) {
+	PollInterval = 10 * time.Millisecond
+	ctx := logging.TestContext(t.Context())
+	art := &wfv1.Artifact{Name: "my-stream", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-stream.stream"}}}
+
+	t.Run("ReadWhileWriting", func(t *testing.T) {
+		driver := &memoryDriver{objects: map[string][]byte{}}
+		w := NewWriter(ctx, driver, art)
+		read := make(chan []byte)
+		go func() {
+			data, err := io.ReadAll(NewReader(ctx, driver, art))
+			assert.NoError(t, err)
+			read <- data
+		}()
+		for _, line := range []string{"foo\n", "bar\n", "", "baz\n"} {
+			_, err := w.Write([]byte(line))
+			require.NoError(t, err)
+			require.NoError(t, w.Flush())
+		}
+		require.NoError(t, w.Close())
+		assert.Equal(t, "foo\nbar\nbaz\n", string(<-read))
+		assert.Equal(t, []byte("3"), driver.objects["my-wf/my-stream.stream/complete"])
+	})
+	t.Run("ReadBeforeWriting", func(t *testing.T) {
+		driver := &memoryDriver{objects: map[string][]byte{}}
+		read := make(chan []byte)
+		go func() {
+			data, err := io.ReadAll(NewReader(ctx, driver, art))
+			assert.NoError(t, err)
+			read <- data
+		}()
+		time.Sleep(5 * PollInterval)
+		w := NewWriter(ctx, driver, art)
+		_, err := w.Write([]byte("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		assert.Equal(t, "foo\n", string(<-read))
+	})
+	t.Run("Empty", func(t *testing.T) {
+		driver := &memoryDriver{objects: map[string][]byte{}}
+		require.NoError(t, NewWriter(ctx, driver, art).Close())
+		data, err := io.ReadAll(NewReader(ctx, driver, art))
+		require.NoError(t, err)
+		assert.Empty(t, data)
+	})
+	t.Run("Aborted", func(t *testing.T) {
+		driver := &memoryDriver{objects: map[string][]byte{}}
+		w := NewWriter(ctx, driver, art)
+		_, _ = w.Write([]byte("foo"))
+		require.NoError(t, w.Flush())
+		require.NoError(t, w.Abort())
+		data, err := io.ReadAll(NewReader(ctx, driver, art))
+		require.EqualError(t, err, "stream my-stream was aborted by its producer")
+		assert.Equal(t, "foo", string(data))
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		driver := &memoryDriver{objects: map[string][]byte{}}
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := io.ReadAll(NewReader(ctx, driver, art))
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -26,6 +26,7 @@ const (
 	TaskResultSkipped      TaskResult = "Skipped"
 	TaskResultOmitted      TaskResult = "Omitted"
 	TaskResultDaemoned     TaskResult = "Daemoned"
+	TaskResultStreaming    TaskResult = "Streaming"
 	TaskResultAnySucceeded TaskResult = "AnySucceeded"
 	TaskResultAllFailed    TaskResult = "AllFailed"
 )
@@ -98,7 +99,7 @@ func ValidateTaskResults(dagTask *wfv1.DAGTask) error {
 		split := strings.Split(matchGroup[1], ".")
 		taskName, taskResult := split[0], TaskResult(split[1])
 		switch taskResult {
-		case TaskResultSucceeded, TaskResultFailed, TaskResultSkipped, TaskResultOmitted, TaskResultErrored, TaskResultDaemoned, TaskResultAnySucceeded, TaskResultAllFailed:
+		case TaskResultSucceeded, TaskResultFailed, TaskResultSkipped, TaskResultOmitted, TaskResultErrored, TaskResultDaemoned, TaskResultStreaming, TaskResultAnySucceeded, TaskResultAllFailed:
 			// Do nothing
 		default:
 			return fmt.Errorf("task result '%s' for task '%s' is invalid", taskResult, taskName)
@@ -182,6 +182,10 @@ const (
 	EnvVarPluginAddresses = "ARGO_PLUGIN_ADDRESSES"
 	// EnvVarPluginNames is a list of plugin names
 	EnvVarPluginNames = "ARGO_PLUGIN_NAMES"
+	// EnvVarPluginVersions is a list of plugin versions
+	EnvVarPluginVersions = "ARGO_PLUGIN_VERSIONS"
+	// EnvVarPluginCapabilities is a list of plugin capabilities
+	EnvVarPluginCapabilities = "ARGO_PLUGIN_CAPABILITIES"
 	// EnvVarContainerName container the container's name for the current pod
 	EnvVarContainerName = "ARGO_CONTAINER_NAME"
 	// EnvVarDeadline is the deadline for the pod
@@ -300,7 +304,7 @@ const (
 var AnnotationKeyKillCmd = func(containerName string) string { return workflow.WorkflowFullName + "/kill-cmd-" + containerName }
 
 // GlobalVarValidWorkflowVariablePrefix is a list of root tags in workflow which could be used for variable reference.
-var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
+var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "loop.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
 
 func UnstructuredHasCompletedLabel(obj any) bool {
 	if wf, ok := obj.(*unstructured.Unstructured); ok {
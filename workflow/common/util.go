@@ -80,6 +80,17 @@ func FindVolumeMountNestedUnderPath(tmpl *wfv1.Template, path string) *apiv1.Vol
 	return nil
 }
 
+// ContainerOutputsDir returns the directory, relative to the shared VarRunArgoPath volume, that the emissary saves
+// the outputs of the named container to. The main container saves the template's outputs, and any of its own, to
+// "outputs"; the other containers of a container set each save theirs to a directory of their own, so that the
+// same path in different containers does not collide.
+func ContainerOutputsDir(containerName string) string {
+	if containerName == MainContainerName {
+		return "outputs"
+	}
+	return filepath.Join("outputs", "containers", containerName)
+}
+
 // ExecPodContainer runs a command in a container in a pod and returns the remotecommand.Executor
 func ExecPodContainer(ctx context.Context, restConfig *rest.Config, namespace string, pod string, container string, stdout bool, stderr bool, command ...string) (exec remotecommand.Executor, err error) {
 	log := logging.RequireLoggerFromContext(ctx)
@@ -131,7 +131,7 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		return nil, err
 	}
 
-	pluginSidecars, pluginVolumes, err := woc.getExecutorPlugins(ctx)
+	plugins, pluginSidecars, pluginVolumes, err := woc.getExecutorPlugins(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +142,8 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		{Name: common.EnvAgentPatchRate, Value: env.LookupEnvStringOr(common.EnvAgentPatchRate, GetRequeueTime().String())},
 		{Name: common.EnvVarPluginAddresses, Value: wfv1.MustMarshallJSON(addresses(pluginSidecars))},
 		{Name: common.EnvVarPluginNames, Value: wfv1.MustMarshallJSON(names(pluginSidecars))},
+		{Name: common.EnvVarPluginVersions, Value: wfv1.MustMarshallJSON(versions(plugins))},
+		{Name: common.EnvVarPluginCapabilities, Value: wfv1.MustMarshallJSON(capabilities(plugins))},
 	}
 
 	// If the default number of task workers is overridden, then pass it to the agent pod.
@@ -293,48 +295,44 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 	return created, nil
 }
 
-func (woc *wfOperationCtx) getExecutorPlugins(ctx context.Context) ([]apiv1.Container, []apiv1.Volume, error) {
-	var sidecars []apiv1.Container
-	var volumes []apiv1.Volume
+// getExecutorPlugins returns the executor plugins of the agent pod, with their sidecars and volumes
+func (woc *wfOperationCtx) getExecutorPlugins(ctx context.Context) ([]spec.Plugin, []apiv1.Container, []apiv1.Volume, error) {
+	var plugins []spec.Plugin
 	namespaces := map[string]bool{} // de-dupes executorPlugins when their namespaces are the same
 	namespaces[woc.controller.namespace] = true
 	namespaces[woc.wf.Namespace] = true
 	wFPlugins, err := woc.execWf.Spec.AsExecutorPluginSpec()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	isGetPluginsFromWorkflow := len(wFPlugins) > 0
 	if isGetPluginsFromWorkflow && !woc.controller.enableWorkflowLevelExecutorPlugins {
-		return nil, nil, fmt.Errorf(
+		return nil, nil, nil, fmt.Errorf(
 			"workflow-level executor plugins are disabled in the controller. To enable them, set the environment variable ARGO_WORKFLOW_LEVEL_EXECUTOR_PLUGINS=true",
 		)
 	}
 	if isGetPluginsFromWorkflow {
-		for _, plugin := range wFPlugins {
-			sidecar, pluginVolume, err := woc.getExecutorPluginComponents(ctx, plugin)
-			if err != nil {
-				return nil, nil, err
-			}
-			sidecars = append(sidecars, *sidecar)
-			if pluginVolume != nil {
-				volumes = append(volumes, *pluginVolume)
-			}
-		}
+		plugins = wFPlugins
 	} else {
 		for namespace := range namespaces {
 			for _, plug := range woc.controller.executorPlugins[namespace] {
-				sidecar, pluginVolume, err := woc.getExecutorPluginComponents(ctx, *plug)
-				if err != nil {
-					return nil, nil, err
-				}
-				sidecars = append(sidecars, *sidecar)
-				if pluginVolume != nil {
-					volumes = append(volumes, *pluginVolume)
-				}
+				plugins = append(plugins, *plug)
 			}
 		}
 	}
-	return sidecars, volumes, nil
+	var sidecars []apiv1.Container
+	var volumes []apiv1.Volume
+	for _, plug := range plugins {
+		sidecar, pluginVolume, err := woc.getExecutorPluginComponents(ctx, plug)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		sidecars = append(sidecars, *sidecar)
+		if pluginVolume != nil {
+			volumes = append(volumes, *pluginVolume)
+		}
+	}
+	return plugins, sidecars, volumes, nil
 }
 
 func (woc *wfOperationCtx) getExecutorPluginComponents(ctx context.Context, plug spec.Plugin) (*apiv1.Container, *apiv1.Volume, error) {
@@ -373,3 +371,19 @@ func names(containers []apiv1.Container) []string {
 	}
 	return pluginNames
 }
+
+func versions(plugins []spec.Plugin) []string {
+	var pluginVersions []string
+	for _, p := range plugins {
+		pluginVersions = append(pluginVersions, p.Spec.Version)
+	}
+	return pluginVersions
+}
+
+func capabilities(plugins []spec.Plugin) [][]string {
+	var pluginCapabilities [][]string
+	for _, p := range plugins {
+		pluginCapabilities = append(pluginCapabilities, p.Spec.Capabilities)
+	}
+	return pluginCapabilities
+}
@@ -416,7 +416,7 @@ spec:
 
 		controller.Config.InstanceID = "testID"
 		woc := newWorkflowOperationCtx(ctx, wf, controller)
-		sidecars, volumes, err := woc.getExecutorPlugins(ctx)
+		_, sidecars, volumes, err := woc.getExecutorPlugins(ctx)
 		require.NoError(t, err)
 		assert.Len(t, sidecars, 1)
 		assert.Equal(t, "test-sidecar", sidecars[0].Name)
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	wfutil "github.com/argoproj/argo-workflows/v4/workflow/util"
+)
+
+// executeApprovalGate waits for the approval gate of a suspend node to be approved or rejected, which the Argo Server
+// records on the node, and applies the gate's timeout action if it times out
+func (woc *wfOperationCtx) executeApprovalGate(ctx context.Context, nodeName string, node *wfv1.NodeStatus, gate *wfv1.ApprovalGate) (*wfv1.NodeStatus, error) {
+	if node.Approval == nil {
+		node.Approval = &wfv1.ApprovalStatus{Approvers: gate.Approvers, Quorum: gate.GetQuorum()}
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		woc.updated = true
+	}
+
+	var requeueTime *time.Time
+	if gate.Timeout != "" {
+		timeout, err := wfv1.ParseStringToDuration(gate.Timeout)
+		if err != nil {
+			return node, err
+		}
+		deadline := node.StartedAt.Add(timeout)
+		if node.Approval.Escalated {
+			if gate.Escalation == nil || gate.Escalation.Timeout == "" {
+				deadline = time.Time{}
+			} else {
+				escalationTimeout, err := wfv1.ParseStringToDuration(gate.Escalation.Timeout)
+				if err != nil {
+					return node, err
+				}
+				deadline = deadline.Add(escalationTimeout)
+			}
+		}
+		if !deadline.IsZero() && !time.Now().UTC().Before(deadline) {
+			switch {
+			case node.Approval.Escalated:
+				woc.log.WithField("nodeName", nodeName).Info(ctx, "escalated approval timed out")
+				return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, "Approval timed out after escalation"), nil
+			case gate.GetTimeoutAction() == wfv1.ApprovalTimeoutActionResume:
+				woc.log.WithField("nodeName", nodeName).Info(ctx, "approval timed out, resuming node")
+				if err := wfutil.OverrideOutputParametersWithDefault(node.Outputs); err != nil {
+					return node, err
+				}
+				woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+				return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded, "Approval timed out"), nil
+			case gate.GetTimeoutAction() == wfv1.ApprovalTimeoutActionEscalate && gate.Escalation != nil:
+				woc.log.WithFields(logging.Fields{"nodeName": nodeName, "approvers": gate.Escalation.Approvers}).Info(ctx, "approval timed out, escalating")
+				node.Approval.Escalated = true
+				node.Approval.Approvers = node.Approval.Approvers.Add(gate.Escalation.Approvers)
+				woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+				woc.updated = true
+				woc.markNodePhase(ctx, nodeName, wfv1.NodeRunning, "Approval escalated")
+				return woc.executeApprovalGate(ctx, nodeName, node, gate)
+			default:
+				woc.log.WithField("nodeName", nodeName).Info(ctx, "approval timed out")
+				return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, "Approval timed out"), nil
+			}
+		}
+		if !deadline.IsZero() {
+			requeueTime = &deadline
+		}
+	}
+
+	if workflowDeadline := woc.getWorkflowDeadline(); workflowDeadline != nil {
+		if requeueTime == nil || workflowDeadline.Before(*requeueTime) {
+			requeueTime = workflowDeadline
+		}
+	}
+	if requeueTime != nil {
+		woc.requeueAfter(time.Until(*requeueTime))
+	}
+
+	return woc.markNodePhase(ctx, nodeName, wfv1.NodeRunning), nil
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/util"
+)
+
+var approvalGateWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: approval-gate
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: approve
+        template: approve
+  - name: approve
+    inputs:
+      parameters:
+      - name: version
+        default: "1.0.0"
+    suspend:
+      approval:
+        approvers:
+          users: [alice]
+    outputs:
+      parameters:
+      - name: version
+        valueFrom:
+          supplied: {}
+`
+
+func newApprovalGateWorkflow(gate wfv1.ApprovalGate) *wfv1.Workflow {
+	wf := wfv1.MustUnmarshalWorkflow(approvalGateWf)
+	wf.Spec.Templates[1].Suspend.Approval = &gate
+	return wf
+}
+
+func TestApprovalGate(t *testing.T) {
+	t.Run("Waiting", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+		wf, err := wfcset.Create(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{Approvers: wfv1.Approvers{Users: []string{"alice", "bob"}}, Quorum: 2, Timeout: "1h"}), metav1.CreateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.True(t, util.IsWorkflowSuspended(woc.wf))
+		require.NotNil(t, node.Approval)
+		assert.Equal(t, []string{"alice", "bob"}, node.Approval.Approvers.Users)
+		assert.Equal(t, int32(2), node.Approval.Quorum)
+		assert.False(t, node.Approval.Escalated)
+	})
+
+	t.Run("Approved", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+		wf, err := wfcset.Create(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{Approvers: wfv1.Approvers{Users: []string{"alice"}}}), metav1.CreateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+
+		err = util.DecideApproval(ctx, wfcset, controller.hydrator, wf.Name, "", wfv1.ApprovalDecision{User: "alice", Approved: true, Parameters: map[string]string{"version": "2.0.0"}}, func(wfv1.Approvers) bool { return true })
+		require.NoError(t, err)
+		wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		woc = newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "2.0.0", node.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("TimeoutFail", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{Timeout: "0s"}), controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "Approval timed out", node.Message)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	})
+
+	t.Run("TimeoutResume", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{Timeout: "0s", TimeoutAction: wfv1.ApprovalTimeoutActionResume}), controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "Approval timed out", node.Message)
+		assert.Equal(t, "1.0.0", node.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("TimeoutEscalate", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{
+			Approvers:     wfv1.Approvers{Users: []string{"alice"}},
+			Timeout:       "0s",
+			TimeoutAction: wfv1.ApprovalTimeoutActionEscalate,
+			Escalation:    &wfv1.ApprovalEscalation{Approvers: wfv1.Approvers{Groups: []string{"managers"}}, Timeout: "1h"},
+		}), controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, "Approval escalated", node.Message)
+		require.NotNil(t, node.Approval)
+		assert.True(t, node.Approval.Escalated)
+		assert.Equal(t, []string{"alice"}, node.Approval.Approvers.Users)
+		assert.Equal(t, []string{"managers"}, node.Approval.Approvers.Groups)
+	})
+
+	t.Run("TimeoutAfterEscalation", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, newApprovalGateWorkflow(wfv1.ApprovalGate{
+			Timeout:       "0s",
+			TimeoutAction: wfv1.ApprovalTimeoutActionEscalate,
+			Escalation:    &wfv1.ApprovalEscalation{Approvers: wfv1.Approvers{Groups: []string{"managers"}}, Timeout: "0s"},
+		}), controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "Approval timed out after escalation", node.Message)
+		assert.True(t, node.Approval.Escalated)
+	})
+}
@@ -134,7 +134,8 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 
 	woc.log.WithField("strategy", strategy).Debug(ctx, "processing Artifact GC Strategy")
 
-	// Search for artifacts
+	// Search for artifacts. Content-addressed artifacts which other workflows share are kept by the artifact GC pod,
+	// which checks that they are no longer referenced when it deletes them.
 	artifactSearchResults := woc.findArtifactsToGC(strategy)
 	if len(artifactSearchResults) == 0 {
 		woc.log.WithField("strategy", strategy).Debug(ctx, "No Artifact Search Results returned from strategy")
@@ -10,11 +10,13 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v4"
+	"github.com/argoproj/argo-workflows/v4/persist/archivestore"
 	persist "github.com/argoproj/argo-workflows/v4/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v4/util/instanceid"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/util/sqldb"
 	"github.com/argoproj/argo-workflows/v4/workflow/artifactrepositories"
+	"github.com/argoproj/argo-workflows/v4/workflow/events"
 	"github.com/argoproj/argo-workflows/v4/workflow/hydrator"
 )
 
@@ -28,6 +30,7 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = persist.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = persist.NullWorkflowArchive
+	wfc.artifactLineageRepo = persist.NullArtifactLineageRepo
 	wfc.archiveLabelSelector = labels.Everything()
 	if wfc.throttler != nil {
 		wfc.throttler.UpdateParallelism(wfc.Config.Parallelism)
@@ -70,8 +73,17 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
-			wfc.wfArchive = persist.NewWorkflowArchive(wfc.sessionProxy, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			var workflowStore persist.WorkflowStore
+			workflowStore, err = archivestore.New(wfc.kubeclientset, wfc.namespace, persistence, &wfc.Config.ArtifactRepository)
+			if err != nil {
+				return err
+			}
+			wfc.wfArchive = persist.NewWorkflowArchive(wfc.sessionProxy, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService, workflowStore)
 			logger.Info(ctx, "Workflow archiving is enabled")
+			if persistence.ArtifactLineage {
+				wfc.artifactLineageRepo = persist.NewArtifactLineageRepo(wfc.sessionProxy, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+				logger.Info(ctx, "Artifact lineage is enabled")
+			}
 		} else {
 			logger.Info(ctx, "Workflow archiving is disabled")
 		}
@@ -80,6 +92,8 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 	}
 
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
+	// the sink is swapped atomically, as workflows can be operated on while the configuration is updated
+	wfc.cloudEventSink.Swap(events.NewCloudEventSink(ctx, wfc.Config.CloudEvents)).Close()
 	wfc.updateEstimatorFactory(ctx)
 	wfc.rateLimiter = wfc.newRateLimiter()
 	wfc.maxStackDepth = wfc.getMaxStackDepth()
@@ -3,6 +3,10 @@ package controller
 import (
 	"context"
 	"fmt"
+	"reflect"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 )
@@ -47,3 +51,100 @@ func (woc *wfOperationCtx) executeContainerSet(ctx context.Context, nodeName str
 
 	return node, nil
 }
+
+// assessContainerNodes updates the nodes of the containers of a container set from the statuses of the containers of
+// its pod, including the time each container started and finished. A container whose dependencies have yet to
+// complete is waiting for them rather than running, so it is pending, and it starts once they finish.
+func (woc *wfOperationCtx) assessContainerNodes(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template, podNode *wfv1.NodeStatus) {
+	terminated := make(map[string]*apiv1.ContainerStateTerminated)
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.State.Terminated != nil {
+			terminated[c.Name] = c.State.Terminated
+		}
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		ctrNodeName := fmt.Sprintf("%s.%s", podNode.Name, c.Name)
+		if _, err := woc.wf.GetNodeByName(ctrNodeName); err != nil {
+			continue
+		}
+		dependenciesFinishedAt, dependenciesCompleted := containerDependenciesFinishedAt(tmpl, c.Name, terminated)
+		switch {
+		case c.State.Terminated != nil:
+			exitCode := int(c.State.Terminated.ExitCode)
+			message := fmt.Sprintf("%s: %s (exit code %d): %s", c.Name, c.State.Terminated.Reason, exitCode, c.State.Terminated.Message)
+			woc.setContainerNodeStatus(ctx, ctrNodeName, maxTime(c.State.Terminated.StartedAt, dependenciesFinishedAt), c.State.Terminated.FinishedAt, new(fmt.Sprint(exitCode)))
+			switch exitCode {
+			case 0:
+				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeSucceeded)
+			case 64:
+				// special emissary exit code indicating the emissary errors, rather than the sub-process failure,
+				// (unless the sub-process coincidentally exits with code 64 of course)
+				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeError, message)
+			default:
+				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeFailed, message)
+			}
+		case pod.Status.Phase == apiv1.PodFailed:
+			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeFailed, `Pod Failed whilst container running`)
+		case c.State.Waiting != nil:
+			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodePending)
+		case c.State.Running != nil:
+			if !dependenciesCompleted {
+				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodePending)
+				continue
+			}
+			woc.setContainerNodeStatus(ctx, ctrNodeName, maxTime(c.State.Running.StartedAt, dependenciesFinishedAt), metav1.Time{}, nil)
+			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeRunning)
+		}
+	}
+}
+
+// containerDependenciesFinishedAt returns the time the last of the dependencies of the named container of a container
+// set finished, and whether all of them have finished
+func containerDependenciesFinishedAt(tmpl *wfv1.Template, containerName string, terminated map[string]*apiv1.ContainerStateTerminated) (metav1.Time, bool) {
+	var finishedAt metav1.Time
+	ctr := tmpl.ContainerSet.GetContainerNode(containerName)
+	if ctr == nil {
+		return finishedAt, true
+	}
+	for _, dep := range ctr.Dependencies {
+		t, ok := terminated[dep]
+		if !ok {
+			return finishedAt, false
+		}
+		finishedAt = maxTime(finishedAt, t.FinishedAt)
+	}
+	return finishedAt, true
+}
+
+func maxTime(a, b metav1.Time) metav1.Time {
+	if b.After(a.Time) {
+		return b
+	}
+	return a
+}
+
+// setContainerNodeStatus sets the times a container node started and finished, and its exit code. The time it
+// started is only set once, when it leaves the pending phase.
+func (woc *wfOperationCtx) setContainerNodeStatus(ctx context.Context, nodeName string, startedAt, finishedAt metav1.Time, exitCode *string) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		return
+	}
+	updated := node.DeepCopy()
+	if node.Phase == wfv1.NodePending && !startedAt.IsZero() {
+		updated.StartedAt = startedAt
+	}
+	if !finishedAt.IsZero() && node.FinishedAt.IsZero() {
+		updated.FinishedAt = finishedAt
+	}
+	if exitCode != nil {
+		if updated.Outputs == nil {
+			updated.Outputs = &wfv1.Outputs{}
+		}
+		updated.Outputs.ExitCode = exitCode
+	}
+	if !reflect.DeepEqual(node, updated) {
+		woc.wf.Status.Nodes.Set(ctx, updated.ID, *updated)
+		woc.updated = true
+	}
+}
@@ -2,10 +2,12 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
@@ -217,3 +219,96 @@ spec:
 		}
 	}
 }
+
+func TestContainerSetTemplatePerContainerStatus(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: pod
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: set
+            template: set
+        - - name: print
+            template: print
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{steps.set.containerSet.a.outputs.parameters.p}}"
+    - name: set
+      containerSet:
+        containers:
+          - name: a
+            image: argoproj/argosay:v2
+            outputs:
+              parameters:
+                - name: p
+                  valueFrom:
+                    path: /tmp/p
+          - name: main
+            image: argoproj/argosay:v2
+            dependencies:
+              - a
+    - name: print
+      inputs:
+        parameters:
+          - name: message
+      container:
+        image: argoproj/argosay:v2
+        args: ["{{inputs.parameters.message}}"]
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	podStartedAt := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	aFinishedAt := metav1.NewTime(podStartedAt.Add(10 * time.Second))
+	mainFinishedAt := metav1.NewTime(podStartedAt.Add(20 * time.Second))
+
+	makePodsPhase(ctx, woc, corev1.PodRunning, withContainerStatuses(
+		corev1.ContainerStatus{Name: "a", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: podStartedAt}}},
+		corev1.ContainerStatus{Name: "main", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: podStartedAt}}},
+	))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	a := woc.wf.Status.Nodes.FindByName("pod[0].set.a")
+	require.NotNil(t, a)
+	assert.Equal(t, wfv1.NodeRunning, a.Phase)
+	assert.Equal(t, podStartedAt, a.StartedAt)
+	mainNode := woc.wf.Status.Nodes.FindByName("pod[0].set.main")
+	require.NotNil(t, mainNode)
+	assert.Equal(t, wfv1.NodePending, mainNode.Phase, "waiting for its dependency")
+
+	makePodsPhase(ctx, woc, corev1.PodSucceeded, withContainerStatuses(
+		corev1.ContainerStatus{Name: "a", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{StartedAt: podStartedAt, FinishedAt: aFinishedAt}}},
+		corev1.ContainerStatus{Name: "main", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{StartedAt: podStartedAt, FinishedAt: mainFinishedAt}}},
+	), withContainerOutputs(ctx, map[string]wfv1.Outputs{
+		"a": {Parameters: []wfv1.Parameter{{Name: "p", Value: wfv1.AnyStringPtr("hello")}}},
+	}))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	a = woc.wf.Status.Nodes.FindByName("pod[0].set.a")
+	require.NotNil(t, a)
+	assert.Equal(t, wfv1.NodeSucceeded, a.Phase)
+	assert.Equal(t, aFinishedAt, a.FinishedAt)
+	require.NotNil(t, a.Outputs)
+	assert.Equal(t, "0", *a.Outputs.ExitCode)
+	assert.Equal(t, "hello", a.Outputs.Parameters[0].Value.String())
+
+	mainNode = woc.wf.Status.Nodes.FindByName("pod[0].set.main")
+	require.NotNil(t, mainNode)
+	assert.Equal(t, wfv1.NodeSucceeded, mainNode.Phase)
+	assert.Equal(t, aFinishedAt, mainNode.StartedAt, "started when its dependency finished")
+	assert.Equal(t, mainFinishedAt, mainNode.FinishedAt)
+
+	printNode := woc.wf.Status.Nodes.FindByName("pod[1].print")
+	require.NotNil(t, printNode)
+	require.NotNil(t, printNode.Inputs)
+	assert.Equal(t, "hello", printNode.Inputs.Parameters[0].Value.String())
+}
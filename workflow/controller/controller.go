@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strconv"
 	gosync "sync"
+	"sync/atomic"
 	"time"
 
 	syncpkg "github.com/argoproj/pkg/sync"
@@ -149,11 +150,13 @@ type WorkflowController struct {
 	offloadNodeStatusRepo      sqldb.OffloadNodeStatusRepo
 	hydrator                   hydrator.Interface
 	wfArchive                  sqldb.WorkflowArchive
+	artifactLineageRepo        sqldb.ArtifactLineageRepo
 	estimatorFactory           estimation.EstimatorFactory
 	syncManager                *sync.Manager
 	metrics                    *metrics.Metrics
 	tracing                    *tracing.Tracing
 	eventRecorderManager       events.EventRecorderManager
+	cloudEventSink             atomic.Pointer[events.CloudEventSink]
 	archiveLabelSelector       labels.Selector
 	cacheFactory               controllercache.Factory
 	wfTaskSetInformer          wfextvv1alpha1.WorkflowTaskSetInformer
@@ -878,8 +881,9 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(ctx context.Cont
 		return
 	}
 	ttl := wfc.Config.Persistence.ArchiveTTL
-	if ttl == config.TTL(0) {
-		logger.Info(ctx, "Archived workflows TTL zero - so archived workflow GC disabled - you must restart the controller if you enable this")
+	tiering := wfc.Config.Persistence.ArchiveTiering
+	if ttl == config.TTL(0) && tiering == nil {
+		logger.Info(ctx, "Archived workflows TTL zero and archive tiering disabled - so archived workflow GC disabled - you must restart the controller if you enable this")
 		return
 	}
 	logger.WithFields(logging.Fields{"ttl": ttl, "periodicity": periodicity}).Info(ctx, "Performing archived workflow GC")
@@ -891,9 +895,21 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(ctx context.Cont
 			return
 		case <-ticker.C:
 			logger.Info(ctx, "Performing archived workflow GC")
-			err := wfc.wfArchive.DeleteExpiredWorkflows(ctx, time.Duration(ttl))
-			if err != nil {
-				logger.WithField("err", err).Error(ctx, "Failed to delete archived workflows")
+			if ttl != config.TTL(0) {
+				err := wfc.wfArchive.DeleteExpiredWorkflows(ctx, time.Duration(ttl))
+				if err != nil {
+					logger.WithField("err", err).Error(ctx, "Failed to delete archived workflows")
+				}
+				err = wfc.artifactLineageRepo.DeleteExpiredRecords(ctx, time.Duration(ttl))
+				if err != nil {
+					logger.WithField("err", err).Error(ctx, "Failed to delete artifact lineage records")
+				}
+			}
+			if tiering != nil {
+				err := wfc.wfArchive.TierWorkflows(ctx, time.Duration(tiering.After))
+				if err != nil {
+					logger.WithField("err", err).Error(ctx, "Failed to move archived workflows to the artifact repository")
+				}
 			}
 		}
 	}
@@ -1388,6 +1404,11 @@ func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj any)
 	if err != nil {
 		return fmt.Errorf("failed to archive workflow: %w", err)
 	}
+	// lineage is secondary to the archive, so failing to record it does not fail archiving, which would be retried
+	if err := wfc.artifactLineageRepo.RecordWorkflow(ctx, wf); err != nil {
+		logger.WithFields(logging.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID}).WithError(err).Error(ctx, "failed to record artifact lineage")
+		wfc.metrics.ArtifactLineageError(ctx)
+	}
 	data, err := json.Marshal(map[string]any{
 		"metadata": metav1.ObjectMeta{
 			Labels: map[string]string{
@@ -306,6 +306,7 @@ func newController(ctx context.Context, options ...any) (context.CancelFunc, *Wo
 		wfclientset:               wfclientset,
 		workflowKeyLock:           sync.NewKeyLock(),
 		wfArchive:                 sqldb.NullWorkflowArchive,
+		artifactLineageRepo:       sqldb.NullArtifactLineageRepo,
 		hydrator:                  hydratorfake.Noop,
 		estimatorFactory:          estimation.DummyEstimatorFactory,
 		eventRecorderManager:      &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(64)},
@@ -503,6 +504,35 @@ func withOutputs(ctx context.Context, outputs wfv1.Outputs) with {
 	}
 }
 
+func withContainerOutputs(ctx context.Context, containerOutputs map[string]wfv1.Outputs) with {
+	return func(pod *apiv1.Pod, woc *wfOperationCtx) {
+		nodeID := woc.nodeID(pod)
+		taskResult := &wfv1.WorkflowTaskResult{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeID,
+				Labels: map[string]string{
+					common.LabelKeyWorkflow:               woc.wf.Name,
+					common.LabelKeyReportOutputsCompleted: "true",
+				},
+			},
+			NodeResult: wfv1.NodeResult{
+				Phase:            wfv1.NodeSucceeded,
+				ContainerOutputs: containerOutputs,
+			},
+		}
+		err := woc.controller.taskResultInformer.GetIndexer().Add(taskResult)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func withContainerStatuses(statuses ...apiv1.ContainerStatus) with {
+	return func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		pod.Status.ContainerStatuses = statuses
+	}
+}
+
 func withExitCode(v int32) with {
 	return func(pod *apiv1.Pod, _ *wfOperationCtx) {
 		for _, c := range pod.Spec.Containers {
@@ -907,6 +937,33 @@ func TestWorkflowController_archiveWorkflowAux_ReturnsArchiveError(t *testing.T)
 	archive.AssertNumberOfCalls(t, "ArchiveWorkflow", 1)
 }
 
+// TestWorkflowController_archiveWorkflowAux_IgnoresLineageError pins that failing to record the artifact lineage of a
+// workflow does not fail archiving it, so the workflow is still marked archived rather than archived again.
+func TestWorkflowController_archiveWorkflowAux_IgnoresLineageError(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "argo"},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded},
+	}
+	ctx := logging.TestContext(t.Context())
+	archive := sqldbmocks.NewWorkflowArchive(t)
+	archive.EXPECT().ArchiveWorkflow(mock.Anything, mock.Anything).Return(nil)
+	lineage := sqldbmocks.NewArtifactLineageRepo(t)
+	lineage.EXPECT().RecordWorkflow(mock.Anything, mock.Anything).Return(errors.New("lineage unavailable"))
+	cancel, controller := newController(ctx, wf, func(wfc *WorkflowController) {
+		wfc.wfArchive = archive
+		wfc.artifactLineageRepo = lineage
+	})
+	defer cancel()
+
+	un, err := util.ToUnstructured(wf)
+	require.NoError(t, err)
+
+	require.NoError(t, controller.archiveWorkflowAux(ctx, un))
+	archived, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Get(ctx, "my-wf", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Archived", archived.Labels[common.LabelKeyWorkflowArchivingStatus])
+}
+
 // pendingArchiveWorkflow returns a completed workflow labelled the way the
 // operator labels one it has queued for archiving, which is what the archive
 // queue's informer filter matches on.
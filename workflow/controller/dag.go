@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -58,6 +59,10 @@ type dagContext struct {
 	// are only computed once per operation
 	dependsLogic map[string]string
 
+	// generatedTasks maps the tasks with generateTasks whose generated tasks were spliced into tasks to the names of
+	// the tasks they generated
+	generatedTasks map[string][]string
+
 	// taskGroupsToComplete collects the names of TaskGroup nodes that assessDAGPhase
 	// found stuck Running with all of their children fulfilled, mapped to the phase
 	// they should complete with. executeDAG marks them once assessment is done.
@@ -206,7 +211,8 @@ func (d *dagContext) assessDAGPhase(ctx context.Context, targetTasks []string, n
 			}
 		}
 
-		if node.Type == wfv1.NodeTypeRetry {
+		if node.Type == wfv1.NodeTypeRetry || node.Type == wfv1.NodeTypeLoop {
+			// the phase of a retry or loop node, not of its attempts or iterations, is the phase of the branch
 			uniqueQueue.add(generatePhaseNodes(getRetryNodeChildrenIds(node, nodes), branchPhase)...)
 		} else {
 			uniqueQueue.add(generatePhaseNodes(node.Children, branchPhase)...)
@@ -297,18 +303,24 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		dependsLogic:   make(map[string]string),
 		log:            woc.log,
 	}
+	if err := woc.spliceGeneratedTasks(ctx, dagCtx); err != nil {
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, err.Error()), nil
+	}
 
 	// Identify our target tasks. If user did not specify any, then we choose all tasks which have
 	// no dependants.
 	var targetTasks []string
 	if tmpl.DAG.Target == "" {
-		targetTasks = dagCtx.findLeafTaskNames(ctx, tmpl.DAG.Tasks)
+		targetTasks = dagCtx.findLeafTaskNames(ctx, dagCtx.tasks)
 	} else {
-		targetTasks = strings.Split(tmpl.DAG.Target, " ")
+		for _, taskName := range strings.Split(tmpl.DAG.Target, " ") {
+			targetTasks = append(targetTasks, taskName)
+			targetTasks = append(targetTasks, dagCtx.generatedTasks[taskName]...)
+		}
 	}
 
 	// pre-execute daemoned tasks
-	for _, task := range tmpl.DAG.Tasks {
+	for _, task := range dagCtx.tasks {
 		taskNode := dagCtx.getTaskNode(ctx, task.Name)
 		if err != nil {
 			continue
@@ -381,7 +393,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 
 	// set outputs from tasks in order for DAG templates to support outputs
 	scope := createScope(tmpl)
-	for _, task := range tmpl.DAG.Tasks {
+	for _, task := range dagCtx.tasks {
 		taskNode := dagCtx.getTaskNode(ctx, task.Name)
 		if taskNode == nil {
 			// Can happen when dag.target was specified
@@ -492,6 +504,18 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 
 	if node != nil && node.Phase.Fulfilled(node.TaskResultSynced) {
+		if task.Switch != nil {
+			var switchCase *wfv1.SwitchCase
+			if node.Switch != nil && node.Type != wfv1.NodeTypeSkipped {
+				switchCase, _ = task.Switch.Choose(node.Switch.Value)
+			}
+			if switchCase == nil {
+				// the task did not execute a case
+				return
+			}
+			caseTask := task.WithSwitchCase(*switchCase)
+			task = &caseTask
+		}
 		// Collect the completed task metrics
 		_, tmpl, _, tmplErr := dagCtx.tmplCtx.ResolveTemplate(ctx, task)
 		if tmplErr != nil {
@@ -600,9 +624,15 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	if dagCtx.isWaitingForGeneratedTasks(ctx, taskName) {
+		// A task this task depends on generated tasks this task also depends on, they are spliced into the DAG in the
+		// next pass
+		return
+	}
+
 	// All our dependencies were satisfied and successful. It's our turn to run
 	// First resolve/substitute params/artifacts from our dependencies
-	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
+	newTask, switchStatus, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
 	if err != nil {
 		if errors.Is(err, ErrRequeue) {
 			return
@@ -661,10 +691,21 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 				_, _ = woc.initializeNode(ctx, taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, skipReason)
 				continue
 			}
+			if t.Switch != nil {
+				// the switch matched no case
+				_, _ = woc.initializeNode(ctx, taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, noSwitchCaseMessage(switchStatus))
+				woc.setNodeSwitchStatus(ctx, taskNodeName, switchStatus)
+				continue
+			}
 		}
 
 		// Finally execute the template
-		node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		opts := &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate}
+		if t.Loop != nil {
+			node, err = woc.executeLoop(ctx, taskNodeName, &t, t.Loop, dagCtx.tmplCtx, t.Arguments, opts)
+		} else {
+			node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, opts)
+		}
 		if err != nil {
 			switch {
 			case errors.Is(err, ErrDeadlineExceeded):
@@ -685,6 +726,9 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		if node == nil {
 			return
 		}
+		if switchStatus != nil {
+			woc.setNodeSwitchStatus(ctx, taskNodeName, switchStatus)
+		}
 		if node.Completed() {
 			scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 			if err != nil {
@@ -759,17 +803,17 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *
 
 // resolveDependencyReferences replaces any references to outputs of task dependencies, or artifacts in the inputs
 // NOTE: by now, input parameters should have been substituted throughout the template
-func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, error) {
+func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, *wfv1.SwitchStatus, error) {
 	scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Perform replacement
 	// Replace woc.volumes
 	err = woc.substituteParamsInVolumes(ctx, scope.getParametersAny(nil))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Replace task's parameters
@@ -788,21 +832,21 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 		var whenBytes []byte
 		whenBytes, err = json.Marshal(tempTask.When)
 		if err != nil {
-			return nil, argoerrors.InternalWrapError(err)
+			return nil, nil, argoerrors.InternalWrapError(err)
 		}
 		var resolvedWhenStr string
 		resolvedWhenStr, err = template.ReplaceStrictAny(ctx, string(whenBytes), mergedParams, []string{"tasks", "steps"})
 		if err != nil {
 			if template.IsMissingVariableErr(err) {
 				woc.requeue()
-				return nil, ErrRequeue
+				return nil, nil, ErrRequeue
 			}
-			return nil, err
+			return nil, nil, err
 		}
 		var resolvedWhen string
 		err = json.Unmarshal([]byte(resolvedWhenStr), &resolvedWhen)
 		if err != nil {
-			return nil, argoerrors.InternalWrapError(err)
+			return nil, nil, argoerrors.InternalWrapError(err)
 		}
 		var proceed bool
 		proceed, err = shouldExecute(resolvedWhen)
@@ -811,14 +855,33 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 			// Since we don't perform task-expansion until later and task-expansion parameters won't get resolved here,
 			// we continue execution as normal
 			if !tempTask.ShouldExpand() {
-				return nil, err
+				return nil, nil, err
 			}
 		} else if !proceed {
 			// Task won't execute; return early without resolving the rest of the task
 			tempTask.When = resolvedWhen
 			tempTask.Hooks = originalHooks
-			return &tempTask, nil
+			return &tempTask, nil, nil
+		}
+	}
+
+	// Choose the case of the switch before resolving the task, so that only the chosen case is resolved
+	var switchStatus *wfv1.SwitchStatus
+	if tempTask.Switch != nil {
+		var switchCase *wfv1.SwitchCase
+		switchCase, switchStatus, err = chooseSwitchCase(ctx, tempTask.Switch, dagCtx.getTaskNode(ctx, tempTask.Name), mergedParams)
+		if err != nil {
+			if template.IsMissingVariableErr(err) {
+				woc.requeue()
+				return nil, nil, ErrRequeue
+			}
+			return nil, nil, err
+		}
+		if switchCase == nil {
+			tempTask.Hooks = originalHooks
+			return &tempTask, switchStatus, nil
 		}
+		tempTask = tempTask.WithSwitchCase(*switchCase)
 	}
 
 	// Replace arguments that are pure references to a skipped/omitted dependency's output with no
@@ -829,7 +892,7 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 
 	taskBytes, err := json.Marshal(tempTask)
 	if err != nil {
-		return nil, argoerrors.InternalWrapError(err)
+		return nil, nil, argoerrors.InternalWrapError(err)
 	}
 	// We use ReplaceStrict to ensure that any references to dependencies (tasks.*, steps.*) are resolved.
 	// If they are not resolved, it indicates a missing output (e.g. due to race condition), and we should error out
@@ -840,14 +903,14 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 		if template.IsMissingVariableErr(err) {
 			woc.requeue()
 			woc.log.WithError(err).Warn(ctx, "was unable to find variable")
-			return nil, ErrRequeue
+			return nil, nil, ErrRequeue
 		}
-		return nil, err
+		return nil, nil, err
 	}
 	var newTask wfv1.DAGTask
 	err = json.Unmarshal([]byte(newTaskStr), &newTask)
 	if err != nil {
-		return nil, argoerrors.InternalWrapError(err)
+		return nil, nil, argoerrors.InternalWrapError(err)
 	}
 	// Restore Hooks
 	newTask.Hooks = originalHooks
@@ -865,13 +928,13 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 				woc.log.WithField("name", art.Name).Warn(ctx, "Optional artifact was not found; it won't be available as an input")
 				continue
 			}
-			return nil, err
+			return nil, nil, err
 		}
 		resolvedArt.Name = art.Name
 		artifacts = append(artifacts, *resolvedArt)
 	}
 	newTask.Arguments.Artifacts = artifacts
-	return &newTask, nil
+	return &newTask, switchStatus, nil
 }
 
 // findLeafTaskNames finds the names of all tasks whom no other nodes depend on.
@@ -955,6 +1018,21 @@ func expandTask(ctx context.Context, task wfv1.DAGTask, globalScope map[string]a
 	return expandedTasks, nil
 }
 
+// streamingDependencyRegex matches the references to the Streaming result of tasks in depends logic, capturing the
+// name of the task
+var streamingDependencyRegex = regexp.MustCompile(`([^\s&|!()]+)\.` + string(common.TaskResultStreaming) + `\b`)
+
+// dependsOnStreaming returns whether the depends logic refers to the task's Streaming result, in which case the
+// dependent task may start while the task is still running
+func dependsOnStreaming(dependsLogic, taskName string) bool {
+	for _, match := range streamingDependencyRegex.FindAllStringSubmatch(dependsLogic, -1) {
+		if match[1] == taskName {
+			return true
+		}
+	}
+	return false
+}
+
 type TaskResults struct {
 	Succeeded    bool `json:"Succeeded"`
 	Failed       bool `json:"Failed"`
@@ -962,6 +1040,7 @@ type TaskResults struct {
 	Skipped      bool `json:"Skipped"`
 	Omitted      bool `json:"Omitted"`
 	Daemoned     bool `json:"Daemoned"`
+	Streaming    bool `json:"Streaming"`
 	AnySucceeded bool `json:"AnySucceeded"`
 	AllFailed    bool `json:"AllFailed"`
 }
@@ -975,11 +1054,16 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 	}
 
 	evalScope := make(map[string]TaskResults)
+	dependsLogic := d.GetTaskDependsLogic(ctx, taskName)
 
 	for _, taskName := range d.GetTaskDependencies(ctx, taskName) {
-		// If the task is still running, we should not proceed.
+		// If the task is still running, we should not proceed, unless we only need its streams to have started
 		depNode := d.getTaskNode(ctx, taskName)
-		if depNode == nil || !depNode.Fulfilled() || !common.CheckAllHooksFullfilled(depNode, d.wf.Status.Nodes) {
+		if depNode == nil {
+			return false, false, nil
+		}
+		streaming := depNode.IsStreaming() && dependsOnStreaming(dependsLogic, taskName)
+		if (!depNode.Fulfilled() || !common.CheckAllHooksFullfilled(depNode, d.wf.Status.Nodes)) && !streaming {
 			return false, false, nil
 		}
 
@@ -1013,12 +1097,13 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 			Skipped:      depNode.Phase == wfv1.NodeSkipped,
 			Omitted:      depNode.Phase == wfv1.NodeOmitted,
 			Daemoned:     depNode.IsDaemoned() && depNode.Phase != wfv1.NodePending,
+			Streaming:    streaming || depNode.Phase == wfv1.NodeSucceeded && depNode.Outputs.HasStreams(),
 			AnySucceeded: anySucceeded,
 			AllFailed:    allFailed,
 		}
 	}
 
-	evalLogic := strings.ReplaceAll(d.GetTaskDependsLogic(ctx, taskName), "-", "_")
+	evalLogic := strings.ReplaceAll(dependsLogic, "-", "_")
 	execute, err := argoexpr.EvalBool(evalLogic, evalScope)
 	if err != nil {
 		return false, false, fmt.Errorf("unable to evaluate expression '%s': %w", evalLogic, err)
@@ -516,6 +516,78 @@ func TestEvaluateDependsLogicWhenDaemonFailed(t *testing.T) {
 	assert.True(t, execute)
 }
 
+func TestEvaluateDependsLogicWhenTaskStreaming(t *testing.T) {
+	testTasks := []wfv1.DAGTask{
+		{
+			Name: "A",
+		},
+		{
+			Name:    "B",
+			Depends: "A.Streaming",
+		},
+		{
+			Name:    "C",
+			Depends: "A",
+		},
+	}
+
+	ctx := logging.TestContext(t.Context())
+	d := &dagContext{
+		boundaryName: "test",
+		tasks:        testTasks,
+		wf:           &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "test-wf"}},
+		dependencies: make(map[string][]string),
+		dependsLogic: make(map[string]string),
+		log:          logging.RequireLoggerFromContext(ctx),
+	}
+
+	// Task A is running, but has not yet reported its stream
+	d.wf = &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-wf"},
+		Status: wfv1.WorkflowStatus{
+			Nodes: map[string]wfv1.NodeStatus{
+				d.taskNodeID("A"): {Phase: wfv1.NodeRunning},
+			},
+		},
+	}
+	_, proceed, err := d.evaluateDependsLogic(ctx, "B")
+	require.NoError(t, err)
+	assert.False(t, proceed)
+
+	// Task A is streaming
+	outputs := &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "out", Stream: true}}}
+	d.wf.Status.Nodes[d.taskNodeID("A")] = wfv1.NodeStatus{Phase: wfv1.NodeRunning, Outputs: outputs}
+
+	// Task B should proceed and execute
+	execute, proceed, err := d.evaluateDependsLogic(ctx, "B")
+	require.NoError(t, err)
+	assert.True(t, proceed)
+	assert.True(t, execute)
+
+	// Task C does not depend on A's stream, so should not proceed
+	_, proceed, err = d.evaluateDependsLogic(ctx, "C")
+	require.NoError(t, err)
+	assert.False(t, proceed)
+	assert.False(t, dependsOnStreaming("AA.Streaming && A.Succeeded", "A"))
+	assert.True(t, dependsOnStreaming("AA.Streaming || (A-B.Streaming)", "A-B"))
+	assert.True(t, dependsOnStreaming("!A.Failed&&A.Streaming", "A"))
+	assert.False(t, dependsOnStreaming("A.StreamingX", "A"))
+
+	// Task A succeeded before task B was evaluated
+	d.wf.Status.Nodes[d.taskNodeID("A")] = wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Outputs: outputs}
+	execute, proceed, err = d.evaluateDependsLogic(ctx, "B")
+	require.NoError(t, err)
+	assert.True(t, proceed)
+	assert.True(t, execute)
+
+	// Task A failed
+	d.wf.Status.Nodes[d.taskNodeID("A")] = wfv1.NodeStatus{Phase: wfv1.NodeFailed, Outputs: outputs}
+	execute, proceed, err = d.evaluateDependsLogic(ctx, "B")
+	require.NoError(t, err)
+	assert.True(t, proceed)
+	assert.False(t, execute)
+}
+
 func TestEvaluateDependsLogicWhenTaskOmitted(t *testing.T) {
 	testTasks := []wfv1.DAGTask{
 		{
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/template"
+	"github.com/argoproj/argo-workflows/v4/workflow/validate"
+)
+
+// spliceGeneratedTasks splices the tasks generated by the succeeded tasks of the DAG with generateTasks into its
+// tasks. The generated tasks are parsed and validated once, on the first pass after their generating task succeeds,
+// and recorded in its node's status, from which they are spliced on every pass after, so the DAG is the same each
+// time. Generated tasks may generate tasks themselves.
+// It returns an error if a task generated invalid tasks, which fails the DAG. The generating task keeps its phase.
+func (woc *wfOperationCtx) spliceGeneratedTasks(ctx context.Context, dagCtx *dagContext) error {
+	dagCtx.generatedTasks = make(map[string][]string)
+	for i := 0; i < len(dagCtx.tasks); i++ {
+		generator := dagCtx.tasks[i]
+		if generator.GenerateTasks == nil {
+			continue
+		}
+		node := dagCtx.getTaskNode(ctx, generator.Name)
+		if node == nil {
+			continue
+		}
+		status := node.GenerateTasks
+		if status == nil {
+			if node.Phase != wfv1.NodeSucceeded || !node.Phase.Fulfilled(node.TaskResultSynced) {
+				continue
+			}
+			status = &wfv1.GenerateTasksStatus{}
+			tasks, err := woc.parseGeneratedTasks(ctx, dagCtx, generator, node)
+			if err != nil {
+				woc.log.WithError(err).WithField("taskName", generator.Name).Warn(ctx, "invalid generated tasks")
+				status.Message = err.Error()
+			} else {
+				status.Tasks = tasks
+			}
+			node.GenerateTasks = status
+			woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+			woc.updated = true
+		}
+		if status.Message != "" {
+			return fmt.Errorf("task %s generated invalid tasks: %s", generator.Name, status.Message)
+		}
+		dagCtx.tasks, dagCtx.generatedTasks[generator.Name] = spliceTasks(ctx, dagCtx, generator.Name, status.Tasks)
+		// dependencies have changed
+		dagCtx.dependencies = make(map[string][]string)
+		dagCtx.dependsLogic = make(map[string]string)
+	}
+	return nil
+}
+
+// parseGeneratedTasks returns the tasks generated by generator, with the workflow's global variables substituted, and
+// with a dependency on generator if they have no dependencies of their own. It returns an error if the DAG with the
+// tasks spliced in is invalid.
+func (woc *wfOperationCtx) parseGeneratedTasks(ctx context.Context, dagCtx *dagContext, generator wfv1.DAGTask, node *wfv1.NodeStatus) ([]wfv1.DAGTask, error) {
+	output, ok := generator.GenerateTasks.GetTasksOutput(node.Outputs)
+	if !ok {
+		return nil, fmt.Errorf("task has no output to generate tasks from")
+	}
+	var generated []wfv1.DAGTask
+	if err := yaml.UnmarshalStrict([]byte(output), &generated); err != nil {
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	if len(generated) == 0 {
+		return nil, nil
+	}
+	generated, err := woc.substituteGlobalsInTasks(ctx, generated)
+	if err != nil {
+		return nil, err
+	}
+
+	usingDepends := false
+	for _, task := range slices.Concat(dagCtx.tasks, generated) {
+		if task.Depends != "" {
+			usingDepends = true
+		}
+	}
+	for i := range generated {
+		if generated[i].Depends != "" || len(generated[i].Dependencies) > 0 {
+			continue
+		}
+		if usingDepends {
+			generated[i].Depends = generator.Name
+		} else {
+			generated[i].Dependencies = []string{generator.Name}
+		}
+	}
+
+	tasks, _ := spliceTasks(ctx, dagCtx, generator.Name, generated)
+	tmpl := dagCtx.tmpl.DeepCopy()
+	tmpl.DAG.Tasks = tasks
+	if err := validate.GeneratedDAGTasks(ctx, woc.execWf, dagCtx.tmplCtx, tmpl, woc.globalParams()); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// spliceTasks returns the tasks of the DAG with the tasks generated by the named generator spliced in, and the names
+// of the generated tasks. The tasks which depend on the generator also depend on the tasks it generated.
+func spliceTasks(ctx context.Context, dagCtx *dagContext, generatorName string, generated []wfv1.DAGTask) ([]wfv1.DAGTask, []string) {
+	if len(generated) == 0 {
+		return dagCtx.tasks, nil
+	}
+	names := make([]string, len(generated))
+	for i := range generated {
+		names[i] = generated[i].Name
+	}
+	tasks := make([]wfv1.DAGTask, 0, len(dagCtx.tasks)+len(generated))
+	for _, task := range dagCtx.tasks {
+		if slices.Contains(dagCtx.GetTaskDependencies(ctx, task.Name), generatorName) {
+			// dependents of the generator also depend on the tasks it generated
+			if task.Depends != "" {
+				task.Depends = fmt.Sprintf("(%s) && %s", task.Depends, strings.Join(names, " && "))
+			} else {
+				task.Dependencies = slices.Concat(task.Dependencies, names)
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	return append(tasks, generated...), names
+}
+
+// substituteGlobalsInTasks substitutes the workflow's global variables into generated tasks, which, unlike the tasks
+// of the workflow, have not had them substituted when the workflow started
+func (woc *wfOperationCtx) substituteGlobalsInTasks(ctx context.Context, tasks []wfv1.DAGTask) ([]wfv1.DAGTask, error) {
+	data, err := json.Marshal(tasks)
+	if err != nil {
+		return nil, err
+	}
+	s, err := template.Replace(ctx, string(data), template.ToAnyMap(woc.globalParams()), true)
+	if err != nil {
+		return nil, err
+	}
+	var substituted []wfv1.DAGTask
+	if err := json.Unmarshal([]byte(s), &substituted); err != nil {
+		return nil, err
+	}
+	return substituted, nil
+}
+
+// isWaitingForGeneratedTasks returns whether taskName depends on a generator whose tasks are yet to be spliced into
+// the DAG, which happens when the generator succeeded during this pass
+func (d *dagContext) isWaitingForGeneratedTasks(ctx context.Context, taskName string) bool {
+	for _, depName := range d.GetTaskDependencies(ctx, taskName) {
+		if d.GetTask(ctx, depName).GenerateTasks == nil {
+			continue
+		}
+		if _, ok := d.generatedTasks[depName]; ok {
+			continue
+		}
+		if node := d.getTaskNode(ctx, depName); node != nil && node.Phase == wfv1.NodeSucceeded {
+			return true
+		}
+	}
+	return false
+}
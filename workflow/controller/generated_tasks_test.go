@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+var dagGenerateTasks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-generate-tasks
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: greeting
+      value: hello
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+        generateTasks: {}
+      - name: after
+        template: echo
+        depends: generate
+        arguments:
+          parameters:
+          - name: message
+            value: after
+  - name: generate
+    script:
+      image: my-image
+      command: [sh]
+      source: echo
+  - name: echo
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: my-image
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestDAGGenerateTasks(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagGenerateTasks)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	_, err := woc.wf.GetNodeByName("dag-generate-tasks.generate")
+	require.NoError(t, err)
+
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.generate", &wfv1.Outputs{Result: new(`[
+  {"name": "a", "template": "echo", "arguments": {"parameters": [{"name": "message", "value": "{{workflow.parameters.greeting}}"}]}},
+  {"name": "b", "template": "echo", "depends": "a", "arguments": {"parameters": [{"name": "message", "value": "b"}]}}
+]`)})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	a, err := woc.wf.GetNodeByName("dag-generate-tasks.a")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", a.Inputs.GetParameterByName("message").Value.String())
+	// the dependents of the generator wait for the generated tasks
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.after")
+	require.Error(t, err)
+
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.a", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.b")
+	require.NoError(t, err)
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.after")
+	require.Error(t, err)
+
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.b", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.after")
+	require.NoError(t, err)
+
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.after", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+func TestDAGGenerateTasksInvalid(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagGenerateTasks)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.generate", &wfv1.Outputs{Result: new(`[{"name": "a", "template": "missing"}]`)})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	generate, err := woc.wf.GetNodeByName("dag-generate-tasks.generate")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, generate.Phase, "the generating task keeps its phase")
+	require.NotNil(t, generate.GenerateTasks)
+	assert.Contains(t, generate.GenerateTasks.Message, "missing")
+	dag, err := woc.wf.GetNodeByName("dag-generate-tasks")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, dag.Phase)
+	assert.Contains(t, dag.Message, "task generate generated invalid tasks")
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.a")
+	require.Error(t, err)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestDAGGenerateTasksParsedOnce(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagGenerateTasks)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.generate", &wfv1.Outputs{Result: new(`[{"name": "a", "template": "echo", "arguments": {"parameters": [{"name": "message", "value": "a"}]}}]`)})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	generate, err := woc.wf.GetNodeByName("dag-generate-tasks.generate")
+	require.NoError(t, err)
+	require.NotNil(t, generate.GenerateTasks)
+	require.Len(t, generate.GenerateTasks.Tasks, 1)
+	assert.Equal(t, "generate", generate.GenerateTasks.Tasks[0].Depends)
+
+	// the recorded tasks are spliced from then on, so later changes to the output are ignored
+	generate.Outputs.Result = new(`not tasks`)
+	woc.wf.Status.Nodes.Set(ctx, generate.ID, *generate)
+	succeedIteration(ctx, t, woc, "dag-generate-tasks.a", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	generate, err = woc.wf.GetNodeByName("dag-generate-tasks.generate")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, generate.Phase)
+	assert.Empty(t, generate.GenerateTasks.Message)
+	_, err = woc.wf.GetNodeByName("dag-generate-tasks.after")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+}
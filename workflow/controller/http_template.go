@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 )
@@ -11,8 +13,26 @@ func (woc *wfOperationCtx) executeHTTPTemplate(ctx context.Context, nodeName str
 	if err != nil {
 		_, node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
 	}
+	if !node.Fulfilled() {
+		node, err = woc.checkTaskSetNodeDeadline(ctx, node, tmpl)
+		if err != nil {
+			return woc.markNodeError(ctx, nodeName, err)
+		}
+	}
+	if !node.Fulfilled() && node.Phase == wfv1.NodePending && tmpl.HTTP.Async != nil && tmpl.HTTP.Async.Callback != nil {
+		// the agent does not report nodes waiting for a callback as running, see AgentExecutor.startHTTPJob
+		node = woc.markNodePhase(ctx, nodeName, wfv1.NodeRunning, "Waiting for callback")
+	}
 	if !node.Fulfilled() {
 		woc.taskSet[node.ID] = *tmpl
 	}
 	return node
 }
+
+// httpCallbackURL returns the URL of the Argo Server endpoint which completes the HTTP node waiting for a callback
+func (woc *wfOperationCtx) httpCallbackURL(nodeName string) (string, error) {
+	if woc.controller.Config.ArgoServerURL == "" {
+		return "", fmt.Errorf("argoServerURL must be configured to wait for HTTP callbacks")
+	}
+	return fmt.Sprintf("%s/api/v1/workflows/%s/%s/callback/%s", strings.TrimSuffix(woc.controller.Config.ArgoServerURL, "/"), woc.wf.Namespace, woc.wf.Name, woc.wf.NodeID(nodeName)), nil
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	argoerrors "github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v4/util/expr/env"
+	"github.com/argoproj/argo-workflows/v4/util/intstr"
+	"github.com/argoproj/argo-workflows/v4/util/template"
+	varkeys "github.com/argoproj/argo-workflows/v4/util/variables/keys"
+	"github.com/argoproj/argo-workflows/v4/workflow/templateresolution"
+)
+
+// executeLoop executes a step or task with a loop. The loop node is the parent of a node per iteration, named
+// <nodeName>(<iteration>), which are executed one after another until the loop's until expression holds. The loop
+// node takes the outputs of its last iteration.
+func (woc *wfOperationCtx) executeLoop(ctx context.Context, nodeName string, orgTmpl wfv1.TemplateReferenceHolder, loop *wfv1.Loop, tmplCtx *templateresolution.TemplateContext, args wfv1.Arguments, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		_, node = woc.initializeNode(ctx, nodeName, wfv1.NodeTypeLoop, tmplCtx.GetTemplateScope(), orgTmpl, opts.boundaryID, wfv1.NodeRunning, opts.nodeFlag, true)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+
+	iterations := getLoopIterations(node, woc.wf.Status.Nodes)
+	iteration := len(iterations)
+	if iteration > 0 {
+		last := iterations[iteration-1]
+		if last.Phase.Fulfilled(last.TaskResultSynced) {
+			if done, node := woc.assessLoop(ctx, node, loop, last, iteration); done {
+				return node, nil
+			}
+		} else {
+			// the last iteration is still running
+			iteration--
+		}
+	}
+
+	var previous *wfv1.NodeStatus
+	if iteration > 0 {
+		previous = iterations[iteration-1]
+	}
+	iterationArgs, err := loopIterationArguments(ctx, args, iteration, previous)
+	if err != nil {
+		return woc.markNodeError(ctx, nodeName, err), nil
+	}
+	iterationName := fmt.Sprintf("%s(%d)", nodeName, iteration)
+	iterationNode, err := woc.executeTemplate(ctx, iterationName, orgTmpl, tmplCtx, iterationArgs, &executeTemplateOpts{boundaryID: opts.boundaryID, onExitTemplate: opts.onExitTemplate})
+	if iterationNode != nil {
+		woc.addChildNode(ctx, nodeName, iterationName)
+	}
+	if err != nil {
+		return node, err
+	}
+	return woc.wf.GetNodeByName(nodeName)
+}
+
+// assessLoop decides what a loop does once an iteration is fulfilled, and returns whether the loop node is fulfilled,
+// or is waiting for its delay, rather than ready for its next iteration
+func (woc *wfOperationCtx) assessLoop(ctx context.Context, node *wfv1.NodeStatus, loop *wfv1.Loop, last *wfv1.NodeStatus, iterations int) (bool, *wfv1.NodeStatus) {
+	if last.FailedOrError() {
+		return true, woc.markNodePhase(ctx, node.Name, last.Phase, fmt.Sprintf("iteration %d %s: %s", iterations-1, last.Phase, last.Message))
+	}
+	done, err := argoexpr.EvalBool(loop.Until, env.GetFuncMap(loopUntilScope(iterations-1, last)))
+	if err != nil {
+		return true, woc.markNodeError(ctx, node.Name, fmt.Errorf("failed to evaluate loop until expression: %w", err))
+	}
+	if done {
+		node.Outputs = last.Outputs.DeepCopy()
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		return true, woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded)
+	}
+	maxIterations, err := intstr.Int32(loop.MaxIterations)
+	if err != nil {
+		return true, woc.markNodeError(ctx, node.Name, err)
+	}
+	if maxIterations == nil || int32(iterations) >= *maxIterations {
+		return true, woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, fmt.Sprintf("loop did not end after %d iterations", iterations))
+	}
+	if woc.GetShutdownStrategy().Enabled() {
+		return true, woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy()))
+	}
+	if loop.Delay != "" {
+		delay, err := wfv1.ParseStringToDuration(loop.Delay)
+		if err != nil {
+			return true, woc.markNodeError(ctx, node.Name, err)
+		}
+		if remaining := time.Until(last.FinishedAt.Add(delay)); remaining > 0 {
+			woc.requeueAfter(remaining)
+			return true, woc.markNodePhase(ctx, node.Name, wfv1.NodeRunning, fmt.Sprintf("Waiting %s before iteration %d", remaining.Round(time.Second), iterations))
+		}
+	}
+	return false, woc.markNodePhase(ctx, node.Name, wfv1.NodeRunning, "")
+}
+
+// getLoopIterations returns the iteration nodes of a loop node, i.e. its children which are not hooks
+func getLoopIterations(node *wfv1.NodeStatus, nodes wfv1.Nodes) []*wfv1.NodeStatus {
+	var iterations []*wfv1.NodeStatus
+	for i := range node.Children {
+		child := getChildNodeIndex(node, nodes, i)
+		if child == nil || (child.NodeFlag != nil && child.NodeFlag.Hooked) {
+			continue
+		}
+		iterations = append(iterations, child)
+	}
+	return iterations
+}
+
+// loopUntilScope returns the variables a loop's until expression is evaluated with. Outputs the iteration did not
+// produce are nil.
+func loopUntilScope(iteration int, node *wfv1.NodeStatus) map[string]any {
+	parameters := map[string]any{}
+	outputs := map[string]any{"parameters": parameters, "result": nil, "exitCode": nil}
+	if node.Outputs != nil {
+		for _, p := range node.Outputs.Parameters {
+			if p.Value != nil {
+				parameters[p.Name] = p.Value.String()
+			}
+		}
+		if node.Outputs.Result != nil {
+			outputs["result"] = *node.Outputs.Result
+		}
+		if node.Outputs.ExitCode != nil {
+			outputs["exitCode"] = *node.Outputs.ExitCode
+		}
+	}
+	return map[string]any{
+		"iteration": iteration,
+		"status":    string(node.Phase),
+		"outputs":   outputs,
+	}
+}
+
+// loopIterationArguments returns the arguments of an iteration: the step or task's arguments, with the loop.iteration
+// variable substituted, and the outputs of the previous iteration, if any, passed as the arguments of the same names
+func loopIterationArguments(ctx context.Context, args wfv1.Arguments, iteration int, previous *wfv1.NodeStatus) (wfv1.Arguments, error) {
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return args, argoerrors.InternalWrapError(err)
+	}
+	replaced, err := template.Replace(ctx, string(argsBytes), map[string]any{varkeys.LoopIteration.Template(): strconv.Itoa(iteration)}, true)
+	if err != nil {
+		return args, err
+	}
+	var iterationArgs wfv1.Arguments
+	if err := json.Unmarshal([]byte(replaced), &iterationArgs); err != nil {
+		return args, argoerrors.InternalWrapError(err)
+	}
+	if previous == nil || previous.Outputs == nil {
+		return iterationArgs, nil
+	}
+	for _, out := range previous.Outputs.Parameters {
+		if out.Value == nil {
+			continue
+		}
+		param := wfv1.Parameter{Name: out.Name, Value: out.Value}
+		if i := slices.IndexFunc(iterationArgs.Parameters, func(p wfv1.Parameter) bool { return p.Name == out.Name }); i >= 0 {
+			iterationArgs.Parameters[i] = param
+		} else {
+			iterationArgs.Parameters = append(iterationArgs.Parameters, param)
+		}
+	}
+	for _, out := range previous.Outputs.Artifacts {
+		if i := slices.IndexFunc(iterationArgs.Artifacts, func(a wfv1.Artifact) bool { return a.Name == out.Name }); i >= 0 {
+			iterationArgs.Artifacts[i] = out
+		} else {
+			iterationArgs.Artifacts = append(iterationArgs.Artifacts, out)
+		}
+	}
+	return iterationArgs, nil
+}
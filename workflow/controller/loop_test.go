@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+// succeedIteration makes the pod of a loop iteration succeed, with the outputs. It waits for the pod informer to
+// see the pods first, so that a late add of a pod cannot revert its phase, and marks the node succeeded itself, so
+// that its phase does not depend on the timing of the pod assessment.
+func succeedIteration(ctx context.Context, t *testing.T, woc *wfOperationCtx, nodeName string, outputs *wfv1.Outputs) {
+	t.Helper()
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	for _, pod := range pods.Items {
+		require.Eventually(t, func() bool {
+			_, exists, err := woc.controller.PodController.TestingPodInformer().GetStore().Get(&pod)
+			return err == nil && exists
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	node, err := woc.wf.GetNodeByName(nodeName)
+	require.NoError(t, err)
+	node.Phase = wfv1.NodeSucceeded
+	node.Outputs = outputs
+	node.FinishedAt = metav1.Now()
+	woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+}
+
+var stepsLoop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-loop
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: count
+        template: count
+        arguments:
+          parameters:
+          - name: count
+            value: "0"
+          - name: iteration
+            value: "{{loop.iteration}}"
+        loop:
+          until: outputs.parameters.count == "2"
+          maxIterations: 3
+  - name: count
+    inputs:
+      parameters:
+      - name: count
+      - name: iteration
+    outputs:
+      parameters:
+      - name: count
+        valueFrom:
+          path: /tmp/count
+    container:
+      image: my-image
+      command: [sh, -c]
+      args: ["echo $(( {{inputs.parameters.count}} + 1 )) > /tmp/count"]
+`
+
+func TestStepsLoop(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsLoop)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	loopNode, err := woc.wf.GetNodeByName("steps-loop[0].count")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeTypeLoop, loopNode.Type)
+	assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+	iteration, err := woc.wf.GetNodeByName("steps-loop[0].count(0)")
+	require.NoError(t, err)
+	assert.Equal(t, "0", iteration.Inputs.GetParameterByName("count").Value.String())
+	assert.Equal(t, "0", iteration.Inputs.GetParameterByName("iteration").Value.String())
+
+	succeedIteration(ctx, t, woc, "steps-loop[0].count(0)", &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "count", Value: wfv1.AnyStringPtr("1")}}})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	loopNode, err = woc.wf.GetNodeByName("steps-loop[0].count")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+	// the next iteration is passed the outputs of the previous one
+	iteration, err = woc.wf.GetNodeByName("steps-loop[0].count(1)")
+	require.NoError(t, err)
+	assert.Equal(t, "1", iteration.Inputs.GetParameterByName("count").Value.String())
+	assert.Equal(t, "1", iteration.Inputs.GetParameterByName("iteration").Value.String())
+
+	succeedIteration(ctx, t, woc, "steps-loop[0].count(1)", &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "count", Value: wfv1.AnyStringPtr("2")}}})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	loopNode, err = woc.wf.GetNodeByName("steps-loop[0].count")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, loopNode.Phase)
+	assert.Len(t, loopNode.Children, 2)
+	require.NotNil(t, loopNode.Outputs)
+	assert.Equal(t, "2", loopNode.Outputs.Parameters[0].Value.String())
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+var dagLoop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-loop
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: poll
+        template: poll
+        loop:
+          until: outputs.result == "done"
+          maxIterations: 2
+      - name: after
+        template: poll
+        depends: poll
+  - name: poll
+    script:
+      image: my-image
+      command: [sh]
+      source: echo pending
+`
+
+func TestDAGLoop(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagLoop)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	for i := range 2 {
+		woc.operate(ctx)
+		succeedIteration(ctx, t, woc, fmt.Sprintf("dag-loop.poll(%d)", i), &wfv1.Outputs{Result: new("pending")})
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	}
+	woc.operate(ctx)
+
+	loopNode, err := woc.wf.GetNodeByName("dag-loop.poll")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, loopNode.Phase)
+	assert.Equal(t, "loop did not end after 2 iterations", loopNode.Message)
+	assert.Len(t, loopNode.Children, 2)
+	after, err := woc.wf.GetNodeByName("dag-loop.after")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeOmitted, after.Phase)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+var loopDelay = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: loop-delay
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        loop:
+          until: "false"
+          maxIterations: 2
+          delay: 1h
+  - name: poll
+    container:
+      image: my-image
+      command: [sh, -c]
+      args: ["exit 0"]
+`
+
+func TestLoopDelay(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(loopDelay)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	succeedIteration(ctx, t, woc, "loop-delay[0].poll(0)", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	loopNode, err := woc.wf.GetNodeByName("loop-delay[0].poll")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+	assert.Contains(t, loopNode.Message, "before iteration 1")
+	assert.Len(t, loopNode.Children, 1)
+}
+
+func TestLoopIterationArguments(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	args := wfv1.Arguments{
+		Parameters: []wfv1.Parameter{
+			{Name: "count", Value: wfv1.AnyStringPtr("0")},
+			{Name: "name", Value: wfv1.AnyStringPtr("iteration-{{loop.iteration}}")},
+		},
+	}
+	previous := &wfv1.NodeStatus{Outputs: &wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "count", Value: wfv1.AnyStringPtr("3")}, {Name: "other", Value: wfv1.AnyStringPtr("x")}},
+		Artifacts:  []wfv1.Artifact{{Name: "state", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "state.tgz"}}}},
+	}}
+
+	iterationArgs, err := loopIterationArguments(ctx, args, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []wfv1.Parameter{
+		{Name: "count", Value: wfv1.AnyStringPtr("0")},
+		{Name: "name", Value: wfv1.AnyStringPtr("iteration-0")},
+	}, iterationArgs.Parameters)
+
+	iterationArgs, err = loopIterationArguments(ctx, args, 4, previous)
+	require.NoError(t, err)
+	assert.Equal(t, []wfv1.Parameter{
+		{Name: "count", Value: wfv1.AnyStringPtr("3")},
+		{Name: "name", Value: wfv1.AnyStringPtr("iteration-4")},
+		{Name: "other", Value: wfv1.AnyStringPtr("x")},
+	}, iterationArgs.Parameters)
+	assert.Equal(t, previous.Outputs.Artifacts, iterationArgs.Artifacts)
+	// the step's arguments are not changed
+	assert.Equal(t, "0", args.Parameters[0].Value.String())
+}
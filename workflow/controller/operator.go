@@ -1412,8 +1412,9 @@ func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ct
 			}
 			// fail retry wrapper nodes whose children are all fulfilled but the wrapper
 			// itself was left Running because processNodeRetries returned early while a
-			// child pod was still running at the moment of shutdown
-			if node.Type == wfv1.NodeTypeRetry {
+			// child pod was still running at the moment of shutdown, and loop nodes
+			// waiting to start their next iteration
+			if node.Type == wfv1.NodeTypeRetry || node.Type == wfv1.NodeTypeLoop {
 				if woc.childrenFulfilled(&node) {
 					message := fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy())
 					woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, message)
@@ -1567,33 +1568,7 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 
 	// if it's ContainerSetTemplate pod then the inner container names should match to some node names,
 	// in this case need to update nodes according to container status
-	for _, c := range pod.Status.ContainerStatuses {
-		ctrNodeName := fmt.Sprintf("%s.%s", old.Name, c.Name)
-		if _, err := woc.wf.GetNodeByName(ctrNodeName); err != nil {
-			continue
-		}
-		switch {
-		case c.State.Terminated != nil:
-			exitCode := int(c.State.Terminated.ExitCode)
-			message := fmt.Sprintf("%s: %s (exit code %d): %s", c.Name, c.State.Terminated.Reason, exitCode, c.State.Terminated.Message)
-			switch exitCode {
-			case 0:
-				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeSucceeded)
-			case 64:
-				// special emissary exit code indicating the emissary errors, rather than the sub-process failure,
-				// (unless the sub-process coincidentally exits with code 64 of course)
-				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeError, message)
-			default:
-				woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeFailed, message)
-			}
-		case pod.Status.Phase == apiv1.PodFailed:
-			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeFailed, `Pod Failed whilst container running`)
-		case c.State.Waiting != nil:
-			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodePending)
-		case c.State.Running != nil:
-			woc.markNodePhase(ctx, ctrNodeName, wfv1.NodeRunning)
-		}
-	}
+	woc.assessContainerNodes(ctx, pod, tmpl, old)
 
 	// only update Pod IP for daemoned nodes to reduce number of updates
 	if !updated.Completed() && updated.IsDaemoned() {
@@ -2251,6 +2226,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	}
 
 	localParams[varkeys.NodeName.Template()] = nodeName
+	if resolvedTmpl.HTTP != nil && resolvedTmpl.HTTP.Async != nil && resolvedTmpl.HTTP.Async.Callback != nil {
+		callbackURL, err := woc.httpCallbackURL(nodeName)
+		if err != nil {
+			errNode := woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err)
+			return errNode, err
+		}
+		localParams[varkeys.HTTPCallbackURL.Template()] = callbackURL
+	}
 
 	// Inputs has been processed with arguments already, so pass empty arguments.
 	processedTmpl, err := common.ProcessArgs(ctx, resolvedTmpl, &args, woc.globalParams(), localParams, false, woc.wf.Namespace, woc.controller.typedConfigMapInformer.GetIndexer())
@@ -2794,6 +2777,7 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 				woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "WorkflowFailed", message)
 			}
 		}
+		woc.controller.cloudEventSink.Load().EmitWorkflowEvent(ctx, woc.wf, message)
 	}
 	if woc.wf.Status.StartedAt.IsZero() && phase != wfv1.WorkflowPending {
 		woc.updated = true
@@ -3162,6 +3146,10 @@ func (woc *wfOperationCtx) getPodByNode(node *wfv1.NodeStatus) (*apiv1.Pod, erro
 }
 
 func (woc *wfOperationCtx) recordNodePhaseEvent(ctx context.Context, node *wfv1.NodeStatus) {
+	woc.controller.cloudEventSink.Load().EmitNodeEvent(ctx, woc.wf, node)
+	if !woc.controller.Config.NodeEvents.IsEnabled() {
+		return
+	}
 	message := fmt.Sprintf("%v node %s", node.Phase, node.Name)
 	if node.Message != "" {
 		message = message + ": " + node.Message
@@ -3201,10 +3189,10 @@ func (woc *wfOperationCtx) recordNodePhaseEvent(ctx context.Context, node *wfv1.
 	)
 }
 
-// recordNodePhaseChangeEvents creates WorkflowNode Kubernetes events for each node
+// recordNodePhaseChangeEvents creates WorkflowNode Kubernetes events and CloudEvents for each node
 // that has changes logged during this execution of the operator loop.
 func (woc *wfOperationCtx) recordNodePhaseChangeEvents(ctx context.Context, old wfv1.Nodes, newNodes wfv1.Nodes) {
-	if !woc.controller.Config.NodeEvents.IsEnabled() {
+	if !woc.controller.Config.NodeEvents.IsEnabled() && !woc.controller.cloudEventSink.Load().NodesEnabled() {
 		return
 	}
 
@@ -3439,6 +3427,11 @@ func (woc *wfOperationCtx) getOutboundNodes(ctx context.Context, nodeID string)
 		if numChildren > 0 {
 			return []string{node.Children[numChildren-1]}
 		}
+	case wfv1.NodeTypeLoop:
+		if iterations := getLoopIterations(node, woc.wf.Status.Nodes); len(iterations) > 0 {
+			return woc.getOutboundNodes(ctx, iterations[len(iterations)-1].ID)
+		}
+		return []string{node.ID}
 	case wfv1.NodeTypeSteps, wfv1.NodeTypeDAG:
 		if node.MemoizationStatus != nil && node.MemoizationStatus.Hit {
 			return []string{node.ID}
@@ -3658,6 +3651,7 @@ func (woc *wfOperationCtx) buildLocalScope(scope *wfScope, ref varkeys.NodeRefKe
 		ref.HostNodeName.Set(scope.scope, node.HostNodeName, name)
 	}
 	woc.addNodeOutputsToLocalScope(ref, name, node.Outputs, scope)
+	woc.addContainerOutputsToLocalScope(ref, name, node, scope)
 }
 
 func (woc *wfOperationCtx) addNodeOutputsToLocalScope(ref varkeys.NodeRefKeys, name string, outputs *wfv1.Outputs, scope *wfScope) {
@@ -3680,6 +3674,28 @@ func (woc *wfOperationCtx) addNodeOutputsToLocalScope(ref varkeys.NodeRefKeys, n
 	}
 }
 
+// addContainerOutputsToLocalScope adds the outputs of the containers of a container set node to the local scope as
+// <name>.containerSet.<container>.outputs.*
+func (woc *wfOperationCtx) addContainerOutputsToLocalScope(ref varkeys.NodeRefKeys, name string, node *wfv1.NodeStatus, scope *wfScope) {
+	if node.Type != wfv1.NodeTypePod || scope == nil {
+		return
+	}
+	for _, ctrNode := range woc.wf.Status.Nodes {
+		if ctrNode.Type != wfv1.NodeTypeContainer || ctrNode.BoundaryID != node.ID || ctrNode.Outputs == nil {
+			continue
+		}
+		ctrName := strings.TrimPrefix(ctrNode.Name, node.Name+".")
+		for _, param := range ctrNode.Outputs.Parameters {
+			if param.Value != nil {
+				ref.ContainerOutputsParameterByName.Set(scope.scope, param.Value.String(), name, ctrName, param.Name)
+			}
+		}
+		for _, art := range ctrNode.Outputs.Artifacts {
+			ref.ContainerOutputsArtifactByName.Set(scope.scope, art, name, ctrName, art.Name)
+		}
+	}
+}
+
 // addWorkflowOutputsToLocalScope mirrors workflow-level outputs into the
 // per-template scope as workflow.outputs.parameters.<name> /
 // workflow.outputs.artifacts.<name>. Used when a sub-template needs to read
@@ -3739,7 +3755,7 @@ func (n loopNodes) Swap(i, j int) {
 }
 
 // processAggregateNodeOutputs adds the aggregated outputs of a withItems/withParam template as a
-// parameter in the form of a JSON list
+// parameter in the form of a JSON list, and each output artifact as an artifact with a shard per child
 func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, agg varkeys.AggregateKeys, name string, childNodes []wfv1.NodeStatus) error {
 	if len(childNodes) == 0 {
 		return nil
@@ -3758,10 +3774,16 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, agg varke
 	paramList := make([]map[string]string, 0)
 	outputParamValueLists := make(map[string][]string)
 	resultsList := make([]wfv1.Item, 0)
+	outputArtifactShards := make(map[string][]wfv1.Artifact)
 	for _, node := range childNodes {
 		if node.Outputs == nil || node.Phase != wfv1.NodeSucceeded {
 			continue
 		}
+		for _, art := range node.Outputs.Artifacts {
+			if art.HasLocationOrKey() && !art.Deleted {
+				outputArtifactShards[art.Name] = append(outputArtifactShards[art.Name], art)
+			}
+		}
 		if len(node.Outputs.Parameters) > 0 {
 			param := make(map[string]string)
 			for _, p := range node.Outputs.Parameters {
@@ -3802,6 +3824,9 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, agg varke
 		}
 		agg.ParameterByName.Set(scope.scope, valueListJSON, name, outputName)
 	}
+	for artName, shards := range outputArtifactShards {
+		agg.ArtifactByName.Set(scope.scope, wfv1.Artifact{Name: artName, Shards: shards}, name, artName)
+	}
 	return nil
 }
 
@@ -3991,6 +4016,10 @@ func (woc *wfOperationCtx) executeSuspend(ctx context.Context, nodeName string,
 	}
 	woc.log.WithField("nodeName", nodeName).Info(ctx, "node suspended")
 
+	if tmpl.Suspend.Approval != nil {
+		return woc.executeApprovalGate(ctx, nodeName, node, tmpl.Suspend.Approval)
+	}
+
 	// If there is either an active workflow deadline, or if this node is suspended with a duration, then the workflow
 	// will need to be requeued after a certain amount of time
 	var requeueTime *time.Time
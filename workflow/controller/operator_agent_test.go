@@ -90,3 +90,99 @@ func TestHTTPTemplateWithoutServiceAccount(t *testing.T) {
 		assert.Equal(t, `create agent pod failed with reason:"failed to get token volumes: serviceaccounts "default" not found"`, woc.wf.Status.Nodes["hello-world"].Message)
 	})
 }
+
+var httpDeadlineWf = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: hello-world
+  namespace: default
+spec:
+  entrypoint: http
+  templates:
+    - name: http
+      activeDeadlineSeconds: 60
+      timeout: 1h
+      http:
+        url: https://www.google.com/
+`
+
+func TestHTTPTemplateDeadline(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(httpDeadlineWf)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, defaultServiceAccount)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes["hello-world"]
+	assert.Equal(t, wfv1.NodePending, node.Phase)
+	ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets(wf.Namespace).Get(ctx, "hello-world", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, ts.Spec.Tasks, 1)
+
+	// the agent is running the request, but for longer than activeDeadlineSeconds
+	node.Phase = wfv1.NodeRunning
+	node.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	woc.wf.Status.Nodes["hello-world"] = node
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes["hello-world"]
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "Step exceeded its deadline", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+
+	// removing the task from the task set tells the agent to cancel it
+	ts, err = controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets(wf.Namespace).Get(ctx, "hello-world", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, ts.Spec.Tasks)
+}
+
+var httpCallbackWf = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: hello-world
+  namespace: default
+spec:
+  entrypoint: http
+  templates:
+    - name: http
+      http:
+        url: https://example.com/jobs
+        method: POST
+        body: '{"callbackURL": "{{http.callbackURL}}"}'
+        async:
+          callback: {}
+`
+
+func TestHTTPTemplateCallback(t *testing.T) {
+	t.Run("CallbackURL", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(httpCallbackWf)
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, defaultServiceAccount)
+		defer cancel()
+		controller.Config.ArgoServerURL = "https://argo.example.com/"
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes["hello-world"]
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, "Waiting for callback", node.Message)
+		ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets(wf.Namespace).Get(ctx, "hello-world", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Contains(t, ts.Spec.Tasks, "hello-world")
+		assert.JSONEq(t, `{"callbackURL": "https://argo.example.com/api/v1/workflows/default/hello-world/callback/hello-world"}`, ts.Spec.Tasks["hello-world"].HTTP.Body)
+	})
+
+	t.Run("NoArgoServerURL", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(httpCallbackWf)
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, defaultServiceAccount)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes["hello-world"]
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "argoServerURL must be configured to wait for HTTP callbacks", node.Message)
+	})
+}
@@ -14,6 +14,12 @@ func (woc *wfOperationCtx) executePluginTemplate(ctx context.Context, nodeName s
 		}
 		_, node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypePlugin, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
 	}
+	if !node.Fulfilled() {
+		node, err = woc.checkTaskSetNodeDeadline(ctx, node, tmpl)
+		if err != nil {
+			return woc.markNodeError(ctx, nodeName, err)
+		}
+	}
 	if !node.Fulfilled() {
 		woc.taskSet[node.ID] = *tmpl
 	}
@@ -246,7 +246,8 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 
 	// First, resolve any references to outputs from previous steps, and perform substitution
 	var resolveErr error
-	stepGroup, resolveErr = woc.resolveReferences(ctx, stepGroup, stepsCtx.scope)
+	var switchStatuses map[string]*wfv1.SwitchStatus
+	stepGroup, switchStatuses, resolveErr = woc.resolveReferences(ctx, sgNodeName, stepGroup, stepsCtx.scope)
 	if resolveErr != nil {
 		if errors.Is(resolveErr, ErrRequeue) {
 			return node, nil
@@ -289,12 +290,28 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 			}
 			continue
 		}
+		if step.Switch != nil {
+			// the switch matched no case
+			if _, getNodeErr := woc.wf.GetNodeByName(childNodeName); getNodeErr != nil {
+				skipReason := noSwitchCaseMessage(switchStatuses[step.Name])
+				woc.log.WithFields(logging.Fields{"childNodeName": childNodeName, "skipReason": skipReason}).Info(ctx, "Skipping")
+				_, _ = woc.initializeNode(ctx, childNodeName, wfv1.NodeTypeSkipped, stepTemplateScope, &step, stepsCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, skipReason)
+				woc.setNodeSwitchStatus(ctx, childNodeName, switchStatuses[step.Name])
+				woc.addChildNode(ctx, sgNodeName, childNodeName)
+			}
+			continue
+		}
 
 		if stepsCtx.boundaryID == "" {
 			woc.log.Warn(ctx, "boundaryID was nil")
 		}
 		var childNode *wfv1.NodeStatus
-		childNode, err = woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate})
+		opts := &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate}
+		if step.Loop != nil {
+			childNode, err = woc.executeLoop(ctx, childNodeName, &step, step.Loop, stepsCtx.tmplCtx, step.Arguments, opts)
+		} else {
+			childNode, err = woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, opts)
+		}
 		if err != nil {
 			switch {
 			case errors.Is(err, ErrDeadlineExceeded):
@@ -313,6 +330,9 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		if childNode != nil {
 			nodeSteps[childNodeName] = step
 			woc.addChildNode(ctx, sgNodeName, childNodeName)
+			if status, ok := switchStatuses[step.Name]; ok {
+				woc.setNodeSwitchStatus(ctx, childNodeName, status)
+			}
 		}
 	}
 
@@ -436,13 +456,16 @@ func errorFromChannel(errCh <-chan error) error {
 // 3) dereferencing output.exitCode from previous steps
 // 4) dereferencing artifacts from previous steps
 // 5) dereferencing artifacts from inputs
-func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wfv1.WorkflowStep, scope *wfScope) ([]wfv1.WorkflowStep, error) {
+// Steps with a switch are resolved to the case it chooses, which is returned by step name. A step whose switch
+// matched no case keeps its switch.
+func (woc *wfOperationCtx) resolveReferences(ctx context.Context, sgNodeName string, stepGroup []wfv1.WorkflowStep, scope *wfScope) ([]wfv1.WorkflowStep, map[string]*wfv1.SwitchStatus, error) {
 	newStepGroup := make([]wfv1.WorkflowStep, len(stepGroup))
+	newSwitchStatuses := make([]*wfv1.SwitchStatus, len(stepGroup))
 
 	// Step 0: replace all parameter scope references for volumes
 	substErr := woc.substituteParamsInVolumes(ctx, scope.getParametersAny(nil))
 	if substErr != nil {
-		return nil, substErr
+		return nil, nil, substErr
 	}
 
 	// Resolve a Step's References and add it to newStepGroup
@@ -492,6 +515,26 @@ func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wf
 			}
 		}
 
+		// Choose the case of the switch before resolving the step, so that only the chosen case is resolved
+		if step.Switch != nil {
+			node, _ := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", sgNodeName, step.Name))
+			switchCase, status, err := chooseSwitchCase(ctx, step.Switch, node, mergedParams)
+			if err != nil {
+				if template.IsMissingVariableErr(err) {
+					woc.requeue()
+					return ErrRequeue
+				}
+				return err
+			}
+			newSwitchStatuses[i] = status
+			if switchCase == nil {
+				step.Hooks = originalHooks
+				newStepGroup[i] = step
+				return nil
+			}
+			step = step.WithSwitchCase(*switchCase)
+		}
+
 		// Replace arguments that are pure references to a skipped/omitted step's output with no
 		// producer default with a sentinel BEFORE substitution; common.ProcessArgs interprets it as
 		// "unsupplied" at consumption time so the consumed template's input default applies (or
@@ -561,11 +604,17 @@ func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wf
 
 	if err := errorFromChannel(errCh); err != nil { // fetch the first error during resolveStepReferences
 		if errors.Is(err, ErrRequeue) {
-			return nil, err
+			return nil, nil, err
 		}
-		return nil, fmt.Errorf("failed to resolve references: %w", err)
+		return nil, nil, fmt.Errorf("failed to resolve references: %w", err)
 	}
-	return newStepGroup, nil
+	switchStatuses := make(map[string]*wfv1.SwitchStatus)
+	for i, status := range newSwitchStatuses {
+		if status != nil {
+			switchStatuses[stepGroup[i].Name] = status
+		}
+	}
+	return newStepGroup, switchStatuses, nil
 }
 
 // expandStepGroup looks at each step in a collection of parallel steps, and expands all steps using withItems/withParam
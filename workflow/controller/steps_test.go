@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
@@ -1038,3 +1039,69 @@ func TestStepsWhenFalseSkipsDropPass(t *testing.T) {
 	assert.NotEqual(t, wfv1.WorkflowError, woc.wf.Status.Phase)
 	assert.NotEqual(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
 }
+
+var stepsAggregatedArtifacts = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-aggregated-artifacts
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: gen
+        template: gen
+        withItems: [a, b]
+    - - name: merge
+        template: merge
+        arguments:
+          artifacts:
+          - name: shards
+            from: "{{steps.gen.outputs.artifacts.out}}"
+  - name: gen
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+    container:
+      image: my-image
+  - name: merge
+    inputs:
+      artifacts:
+      - name: shards
+        path: /tmp/shards
+    container:
+      image: my-image
+`
+
+func TestStepsAggregatedArtifacts(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stepsAggregatedArtifacts)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	for _, item := range []string{"0:a", "1:b"} {
+		node, err := woc.wf.GetNodeByName("steps-aggregated-artifacts[0].gen(" + item + ")")
+		require.NoError(t, err)
+		node.Outputs = &wfv1.Outputs{Artifacts: []wfv1.Artifact{{
+			Name:             "out",
+			ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: item + ".tgz"}},
+		}}}
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+	}
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	merge, err := woc.wf.GetNodeByName("steps-aggregated-artifacts[1].merge")
+	require.NoError(t, err)
+	require.NotNil(t, merge.Inputs)
+	require.Len(t, merge.Inputs.Artifacts, 1)
+	shards := merge.Inputs.Artifacts[0].Shards
+	require.Len(t, shards, 2)
+	assert.Equal(t, "0:a.tgz", shards[0].S3.Key)
+	assert.Equal(t, "1:b.tgz", shards[1].S3.Key)
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	argoerrors "github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v4/util/expr/env"
+	"github.com/argoproj/argo-workflows/v4/util/template"
+)
+
+// chooseSwitchCase chooses the case of a step or task's switch, by evaluating its expression with params. Once the
+// node of the step or task has chosen a case, the same case is chosen again, so the choice does not change as the
+// scope does. It returns a nil case if no case matches and the switch has no default.
+func chooseSwitchCase(ctx context.Context, sw *wfv1.Switch, node *wfv1.NodeStatus, params map[string]any) (*wfv1.SwitchCase, *wfv1.SwitchStatus, error) {
+	if node != nil && node.Switch != nil {
+		c, _ := sw.Choose(node.Switch.Value)
+		return c, node.Switch, nil
+	}
+	value, err := evalSwitchExpression(ctx, sw.Expression, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, isDefault := sw.Choose(value)
+	return c, &wfv1.SwitchStatus{Value: value, Default: isDefault}, nil
+}
+
+// evalSwitchExpression substitutes any variables in the expression of a switch, and evaluates it to a string
+func evalSwitchExpression(ctx context.Context, expression string, params map[string]any) (string, error) {
+	expressionBytes, err := json.Marshal(expression)
+	if err != nil {
+		return "", argoerrors.InternalWrapError(err)
+	}
+	resolvedStr, err := template.ReplaceStrictAny(ctx, string(expressionBytes), params, []string{"steps", "tasks"})
+	if err != nil {
+		return "", err
+	}
+	var resolved string
+	if err := json.Unmarshal([]byte(resolvedStr), &resolved); err != nil {
+		return "", argoerrors.InternalWrapError(err)
+	}
+	result, err := argoexpr.Eval(resolved, env.GetFuncMap(params))
+	if err != nil {
+		return "", argoerrors.Errorf(argoerrors.CodeBadRequest, "invalid switch expression '%s': %v", expression, err)
+	}
+	if s, ok := result.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(result), nil
+}
+
+// setNodeSwitchStatus records the case chosen by the switch of the node's step or task
+func (woc *wfOperationCtx) setNodeSwitchStatus(ctx context.Context, nodeName string, status *wfv1.SwitchStatus) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil || node.Switch != nil {
+		return
+	}
+	node.Switch = status
+	woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+	woc.updated = true
+}
+
+// noSwitchCaseMessage is the message of the skipped node of a step or task whose switch matched no case
+func noSwitchCaseMessage(status *wfv1.SwitchStatus) string {
+	return fmt.Sprintf("switch '%s' matched no case", status.Value)
+}
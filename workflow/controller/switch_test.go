@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+var stepsSwitch = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-switch
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: size
+      value: large
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: size
+        value: "{{workflow.parameters.size}}"
+    steps:
+    - - name: process
+        switch:
+          expression: inputs.parameters.size
+          cases:
+          - value: small
+            template: echo
+            arguments:
+              parameters:
+              - name: message
+                value: small
+          - value: large
+            template: echo
+            arguments:
+              parameters:
+              - name: message
+                value: large
+          default:
+            template: echo
+            arguments:
+              parameters:
+              - name: message
+                value: default
+  - name: echo
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: my-image
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestStepsSwitch(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsSwitch)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node, err := woc.wf.GetNodeByName("steps-switch[0].process")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeTypePod, node.Type)
+	assert.Equal(t, "echo", node.TemplateName)
+	assert.Equal(t, "large", node.Inputs.GetParameterByName("message").Value.String())
+	assert.Equal(t, &wfv1.SwitchStatus{Value: "large"}, node.Switch)
+	sgNode, err := woc.wf.GetNodeByName("steps-switch[0]")
+	require.NoError(t, err)
+	assert.Len(t, sgNode.Children, 1)
+
+	succeedIteration(ctx, t, woc, "steps-switch[0].process", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+func TestStepsSwitchDefault(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsSwitch)
+	wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("medium")
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node, err := woc.wf.GetNodeByName("steps-switch[0].process")
+	require.NoError(t, err)
+	assert.Equal(t, "default", node.Inputs.GetParameterByName("message").Value.String())
+	assert.Equal(t, &wfv1.SwitchStatus{Value: "medium", Default: true}, node.Switch)
+
+	// without a default, the step is skipped
+	wf = wfv1.MustUnmarshalWorkflow(stepsSwitch)
+	wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("medium")
+	wf.Spec.Templates[0].Steps[0].Steps[0].Switch.Default = nil
+	woc = newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node, err = woc.wf.GetNodeByName("steps-switch[0].process")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeSkipped, node.Phase)
+	assert.Equal(t, "switch 'medium' matched no case", node.Message)
+	assert.Equal(t, &wfv1.SwitchStatus{Value: "medium"}, node.Switch)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+var dagSwitch = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-switch
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: flip
+        template: flip
+      - name: process
+        depends: flip
+        switch:
+          expression: tasks.flip.outputs.result
+          cases:
+          - value: heads
+            template: echo
+            arguments:
+              parameters:
+              - name: message
+                value: "{{tasks.flip.outputs.result}}"
+          - value: tails
+            template: echo
+            arguments:
+              parameters:
+              - name: message
+                value: tails
+  - name: flip
+    script:
+      image: my-image
+      command: [sh]
+      source: echo heads
+  - name: echo
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: my-image
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestDAGSwitch(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagSwitch)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	succeedIteration(ctx, t, woc, "dag-switch.flip", &wfv1.Outputs{Result: new("heads")})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node, err := woc.wf.GetNodeByName("dag-switch.process")
+	require.NoError(t, err)
+	assert.Equal(t, "echo", node.TemplateName)
+	assert.Equal(t, "heads", node.Inputs.GetParameterByName("message").Value.String())
+	assert.Equal(t, &wfv1.SwitchStatus{Value: "heads"}, node.Switch)
+
+	succeedIteration(ctx, t, woc, "dag-switch.process", nil)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"time"
 
@@ -144,6 +145,28 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 			woc.wf.Status.Nodes.Set(ctx, nodeID, *newNode)
 			woc.updated = true
 		}
+		woc.reconcileContainerOutputs(ctx, newNode, result.ContainerOutputs)
 		span.End()
 	}
 }
+
+// reconcileContainerOutputs sets the outputs of the container nodes of a container set's pod node from its task result
+func (woc *wfOperationCtx) reconcileContainerOutputs(ctx context.Context, node *wfv1.NodeStatus, containerOutputs map[string]wfv1.Outputs) {
+	for name, outputs := range containerOutputs {
+		ctrNode, err := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", node.Name, name))
+		if err != nil || ctrNode.Type != wfv1.NodeTypeContainer {
+			continue
+		}
+		newOutputs := outputs.DeepCopy()
+		if ctrNode.Outputs != nil && newOutputs.ExitCode == nil { // prevent overwriting of ExitCode
+			newOutputs.ExitCode = ctrNode.Outputs.ExitCode
+		}
+		if reflect.DeepEqual(ctrNode.Outputs, newOutputs) {
+			continue
+		}
+		woc.log.WithField("nodeID", ctrNode.ID).Debug(ctx, "container outputs changed")
+		ctrNode.Outputs = newOutputs
+		woc.wf.Status.Nodes.Set(ctx, ctrNode.ID, *ctrNode)
+		woc.updated = true
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +14,7 @@ import (
 	argoerrors "github.com/argoproj/argo-workflows/v4/errors"
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/intstr"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v4/workflow/controller/cache"
@@ -60,6 +62,39 @@ func (woc *wfOperationCtx) markTaskSetNodesError(ctx context.Context, err error)
 	}
 }
 
+// checkTaskSetNodeDeadline fails a task set node which has run for longer than its template's timeout or
+// activeDeadlineSeconds, which the pod enforces for other nodes, and otherwise requeues the workflow for the deadline.
+// Once the failed node's task is removed from the task set, the agent cancels it.
+func (woc *wfOperationCtx) checkTaskSetNodeDeadline(ctx context.Context, node *wfv1.NodeStatus, tmpl *wfv1.Template) (*wfv1.NodeStatus, error) {
+	var deadline *time.Time
+	if tmpl.Timeout != "" {
+		timeoutDeadline, err := getTimeoutAsDeadline(&node.StartedAt.Time, tmpl.Timeout)
+		if err != nil {
+			return node, err
+		}
+		deadline = timeoutDeadline
+	}
+	activeDeadlineSeconds, err := intstr.Int64(tmpl.ActiveDeadlineSeconds)
+	if err != nil {
+		return node, err
+	}
+	if activeDeadlineSeconds != nil {
+		activeDeadline := node.StartedAt.Add(time.Duration(*activeDeadlineSeconds) * time.Second)
+		if deadline == nil || activeDeadline.Before(*deadline) {
+			deadline = &activeDeadline
+		}
+	}
+	if deadline == nil {
+		return node, nil
+	}
+	if time.Now().UTC().After(*deadline) {
+		woc.log.WithField("nodeName", node.Name).Info(ctx, "Task set node exceeded its deadline")
+		return woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, "Step exceeded its deadline"), nil
+	}
+	woc.requeueAfter(time.Until(*deadline))
+	return node, nil
+}
+
 func (woc *wfOperationCtx) hasTaskSetNodes() bool {
 	return woc.wf.Status.Nodes.Any(func(node wfv1.NodeStatus) bool {
 		return node.IsTaskSetNode()
@@ -149,6 +184,10 @@ func (woc *wfOperationCtx) reconcileTaskSet(ctx context.Context) error {
 				woc.log.WithField("nodeID", nodeID).Error(ctx, "was unable to obtain node for nodeID")
 				return err
 			}
+			if taskResult.Phase == "" {
+				// the agent reports the job of an HTTP node waiting for a callback without a phase
+				continue
+			}
 
 			node.Outputs = taskResult.Outputs.DeepCopy()
 			node.Phase = taskResult.Phase
@@ -408,3 +408,64 @@ status:
 		assert.NotEmpty(t, memo.Data["cache-demo-1"])
 	})
 }
+
+func TestReconcileTaskSetWithCallbackJob(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http-template-1
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      http:
+        url: "http://localhost"
+        async:
+          callback: {}
+status:
+  nodes:
+    http-template-1:
+      displayName: http-template-1
+      id: http-template-1
+      name: http-template-1
+      phase: Running
+      templateName: main
+      type: HTTP
+  phase: Running
+`)
+	ctx := logging.TestContext(t.Context())
+	var ts wfv1.WorkflowTaskSet
+	wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTaskSet
+metadata:
+  name: http-template-1
+  namespace: default
+spec:
+  tasks:
+    http-template-1:
+      http:
+        url: http://localhost
+        async:
+          callback: {}
+      name: main
+status:
+  nodes:
+    http-template-1:
+      httpJob:
+        pollURL: ""
+`, &ts)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	_, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Create(ctx, &ts, v1.CreateOptions{})
+	require.NoError(t, err)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	time.Sleep(1 * time.Second)
+	err = woc.reconcileTaskSet(ctx)
+	require.NoError(t, err)
+	// the job of a node waiting for a callback is reported without a phase, which must not change the node
+	node, err := woc.wf.Status.Nodes.Get("http-template-1")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	assert.True(t, node.FinishedAt.IsZero())
+}
@@ -1746,7 +1746,7 @@ func (pb *podBuilder) addArchiveLocation(ctx context.Context, tmpl *wfv1.Templat
 	}
 	archiveLogs := pb.deps.IsArchiveLogs(tmpl)
 	needLocation := archiveLogs
-	artifacts := slices.Concat(tmpl.Inputs.Artifacts, tmpl.Outputs.Artifacts)
+	artifacts := slices.Concat(tmpl.Inputs.Artifacts, tmpl.Outputs.Artifacts, tmpl.ContainerSet.GetOutputArtifacts())
 	if tmpl.Data != nil {
 		if art, exist := tmpl.Data.Source.GetArtifactIfNeeded(); exist {
 			artifacts = append(artifacts, *art)
@@ -2122,7 +2122,7 @@ func createSecretVolumesAndMounts(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.
 
 	createArchiveLocationSecret(tmpl, allVolumesMap, uniqueKeyMap)
 
-	for _, art := range tmpl.Outputs.Artifacts {
+	for _, art := range slices.Concat(tmpl.Outputs.Artifacts, tmpl.ContainerSet.GetOutputArtifacts()) {
 		createSecretVolume(allVolumesMap, art, uniqueKeyMap)
 	}
 	for _, art := range tmpl.Inputs.Artifacts {
@@ -2147,6 +2147,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 
 func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMap map[string]bool) {
 	createSecretVolumesFromArtifactLocations(volMap, []*wfv1.ArtifactLocation{&art.ArtifactLocation}, keyMap)
+	for _, shard := range art.Shards {
+		createSecretVolume(volMap, shard, keyMap)
+	}
 }
 
 func createSecretVolumesAndMountsFromArtifactLocations(artifactLocations []*wfv1.ArtifactLocation) ([]apiv1.Volume, []apiv1.VolumeMount) {
@@ -2202,6 +2205,12 @@ func createSecretVolumesFromArtifactLocations(volMap map[string]apiv1.Volume, ar
 			createSecretVal(volMap, artifactLocation.HTTP.Auth.OAuth2.TokenURLSecret, keyMap)
 		case artifactLocation.Azure != nil:
 			createSecretVal(volMap, artifactLocation.Azure.AccountKeySecret, keyMap)
+		case artifactLocation.OCI != nil:
+			createSecretVal(volMap, artifactLocation.OCI.UsernameSecret, keyMap)
+			createSecretVal(volMap, artifactLocation.OCI.PasswordSecret, keyMap)
+		}
+		if artifactLocation.Encryption != nil {
+			createSecretVal(volMap, artifactLocation.Encryption.KeySecret, keyMap)
 		}
 	}
 }
@@ -2814,3 +2814,16 @@ func TestPodResourceClaimsParameterSubstitution(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateSecretVolumesFromArtifactLocationsEncryption(t *testing.T) {
+	volumes, mounts := createSecretVolumesAndMountsFromArtifactLocations([]*wfv1.ArtifactLocation{{
+		S3: &wfv1.S3Artifact{Key: "my-key"},
+		Encryption: &wfv1.ArtifactEncryption{
+			KeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-encryption-key"}, Key: "key"},
+		},
+	}})
+	require.Len(t, volumes, 1)
+	assert.Equal(t, "my-encryption-key", volumes[0].Secret.SecretName)
+	assert.Equal(t, []apiv1.KeyToPath{{Key: "key", Path: "key"}}, volumes[0].Secret.Items)
+	require.Len(t, mounts, 1)
+}
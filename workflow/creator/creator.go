@@ -21,6 +21,8 @@ const (
 	ActionTerminate ActionType = "Terminate"
 	ActionResume    ActionType = "Resume"
 	ActionRetry     ActionType = "Retry"
+	ActionApprove   ActionType = "Approve"
+	ActionReject    ActionType = "Reject"
 	ActionNone      ActionType = ""
 )
 
@@ -0,0 +1,161 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+// CloudEventTypePrefix is the prefix of the type of the CloudEvents emitted for lifecycle transitions, e.g.
+// io.argoproj.workflow.v1alpha1.workflow.succeeded or io.argoproj.workflow.v1alpha1.node.running
+const CloudEventTypePrefix = "io.argoproj.workflow.v1alpha1."
+
+// CloudEvent is a CloudEvent v1.0 in its structured mode JSON encoding
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            any       `json:"data,omitempty"`
+}
+
+// CloudEventSink posts CloudEvents to an HTTP sink. Events are queued and posted in the background, so that workflows
+// are not slowed down by the sink, and are dropped if the queue is full or the sink fails.
+type CloudEventSink struct {
+	config config.CloudEvents
+	client *http.Client
+	queue  chan CloudEvent
+	done   chan struct{}
+}
+
+// NewCloudEventSink returns a new sink that posts events until it is closed, or nil if CloudEvents are not enabled
+func NewCloudEventSink(ctx context.Context, cloudEvents *config.CloudEvents) *CloudEventSink {
+	if !cloudEvents.IsEnabled() {
+		return nil
+	}
+	s := &CloudEventSink{
+		config: *cloudEvents,
+		client: &http.Client{Timeout: cloudEvents.GetTimeout()},
+		queue:  make(chan CloudEvent, cloudEvents.GetQueueSize()),
+		done:   make(chan struct{}),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// NodesEnabled returns true if events are emitted for node lifecycle transitions
+func (s *CloudEventSink) NodesEnabled() bool {
+	return s != nil && s.config.Nodes
+}
+
+// EmitWorkflowEvent queues an event for the phase of the workflow
+func (s *CloudEventSink) EmitWorkflowEvent(ctx context.Context, wf *wfv1.Workflow, message string) {
+	if s == nil {
+		return
+	}
+	s.emit(ctx, wf, "workflow."+strings.ToLower(string(wf.Status.Phase)), map[string]any{
+		"name":      wf.Name,
+		"namespace": wf.Namespace,
+		"uid":       wf.UID,
+		"phase":     wf.Status.Phase,
+		"message":   message,
+	})
+}
+
+// EmitNodeEvent queues an event for the phase of the node of the workflow
+func (s *CloudEventSink) EmitNodeEvent(ctx context.Context, wf *wfv1.Workflow, node *wfv1.NodeStatus) {
+	if !s.NodesEnabled() {
+		return
+	}
+	s.emit(ctx, wf, "node."+strings.ToLower(string(node.Phase)), map[string]any{
+		"workflowName": wf.Name,
+		"namespace":    wf.Namespace,
+		"workflowUID":  wf.UID,
+		"id":           node.ID,
+		"name":         node.Name,
+		"displayName":  node.DisplayName,
+		"type":         node.Type,
+		"templateName": node.TemplateName,
+		"phase":        node.Phase,
+		"message":      node.Message,
+	})
+}
+
+func (s *CloudEventSink) emit(ctx context.Context, wf *wfv1.Workflow, eventType string, data map[string]any) {
+	source := s.config.Source
+	if source == "" {
+		source = fmt.Sprintf("/apis/argoproj.io/v1alpha1/namespaces/%s/workflows", wf.Namespace)
+	}
+	event := CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            CloudEventTypePrefix + eventType,
+		Subject:         wf.Name,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+	select {
+	case s.queue <- event:
+	default:
+		logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"type": event.Type, "subject": event.Subject}).Warn(ctx, "CloudEvent queue is full, dropping event")
+	}
+}
+
+// Close stops posting events, events that are still queued are dropped
+func (s *CloudEventSink) Close() {
+	if s == nil {
+		return
+	}
+	close(s.done)
+}
+
+func (s *CloudEventSink) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case event := <-s.queue:
+			if err := s.post(ctx, event); err != nil {
+				logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"type": event.Type, "subject": event.Subject}).Warn(ctx, "Failed to post CloudEvent")
+			}
+		}
+	}
+}
+
+func (s *CloudEventSink) post(ctx context.Context, event CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.SinkURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sink responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/config"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestCloudEventSink(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		s := NewCloudEventSink(logging.TestContext(t.Context()), &config.CloudEvents{})
+		assert.Nil(t, s)
+		assert.False(t, s.NodesEnabled())
+		// a nil sink does nothing
+		s.EmitWorkflowEvent(logging.TestContext(t.Context()), &wfv1.Workflow{}, "")
+		s.Close()
+	})
+	t.Run("Emit", func(t *testing.T) {
+		received := make(chan map[string]any, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/cloudevents+json; charset=utf-8", r.Header.Get("Content-Type"))
+			event := map[string]any{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received <- event
+		}))
+		defer server.Close()
+		ctx := logging.TestContext(t.Context())
+		s := NewCloudEventSink(ctx, &config.CloudEvents{SinkURL: server.URL, Nodes: true})
+		defer s.Close()
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed},
+		}
+		s.EmitWorkflowEvent(ctx, wf, "my-message")
+		s.EmitNodeEvent(ctx, wf, &wfv1.NodeStatus{ID: "my-node", Name: "my-wf.a", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded})
+		for _, want := range []struct{ eventType, dataKey, dataValue string }{
+			{"io.argoproj.workflow.v1alpha1.workflow.failed", "message", "my-message"},
+			{"io.argoproj.workflow.v1alpha1.node.succeeded", "id", "my-node"},
+		} {
+			select {
+			case event := <-received:
+				assert.Equal(t, "1.0", event["specversion"])
+				assert.NotEmpty(t, event["id"])
+				assert.Equal(t, "/apis/argoproj.io/v1alpha1/namespaces/my-ns/workflows", event["source"])
+				assert.Equal(t, want.eventType, event["type"])
+				assert.Equal(t, "my-wf", event["subject"])
+				require.IsType(t, map[string]any{}, event["data"])
+				assert.Equal(t, want.dataValue, event["data"].(map[string]any)[want.dataKey])
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for event")
+			}
+		}
+	})
+	t.Run("NodesDisabled", func(t *testing.T) {
+		s := NewCloudEventSink(logging.TestContext(t.Context()), &config.CloudEvents{SinkURL: "http://localhost", QueueSize: 1})
+		s.Close()
+		s.EmitNodeEvent(logging.TestContext(t.Context()), &wfv1.Workflow{}, &wfv1.NodeStatus{Phase: wfv1.NodeRunning})
+		assert.Empty(t, s.queue)
+	})
+}
@@ -5,13 +5,19 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
 
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -29,8 +35,10 @@ import (
 	"github.com/argoproj/argo-workflows/v4/util"
 	"github.com/argoproj/argo-workflows/v4/util/errors"
 	"github.com/argoproj/argo-workflows/v4/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v4/util/intstr"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	wfutil "github.com/argoproj/argo-workflows/v4/workflow/util"
 )
 
 type AgentExecutor struct {
@@ -41,17 +49,31 @@ type AgentExecutor struct {
 	RESTClient        rest.Interface
 	Namespace         string
 	consideredTasks   *sync.Map
-	plugins           []executorplugins.TemplateExecutor
+	startedTasks      *sync.Map
+	httpJobs          *sync.Map
+	authHTTPClients   sync.Map
+	cancellingTasks   sync.WaitGroup
+	plugins           []*Plugin
 	taskWorkers       int
 	requeueTime       time.Duration
 }
 
-type templateExecutor = func(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error)
+type templateExecutor = func(ctx context.Context, nodeID string, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error)
+
+// defaultCleanupTimeout is the timeout of HTTP cleanup requests which do not set one
+const defaultCleanupTimeout = 30 * time.Second
+
+// cancelWaitTimeout bounds how long the agent waits for the tasks it is cancelling before it stops
+const cancelWaitTimeout = time.Minute
+
+// cancelReason is given to plugins when a task is cancelled. The controller removes a task from the task set once its
+// node is fulfilled, so a task which is removed before the agent has a result was stopped, terminated or timed out.
+const cancelReason = "node was stopped, terminated or timed out"
 
 // NewAgentExecutor instantiates a new agent executor. taskWorkers and
 // requeueTime are parsed from the environment (ARGO_AGENT_TASK_WORKERS,
 // ARGO_AGENT_PATCH_RATE) at the composition root in cmd/argoexec.
-func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface, config *rest.Config, namespace, workflowName, workflowUID string, plugins []executorplugins.TemplateExecutor, taskWorkers int, requeueTime time.Duration) *AgentExecutor {
+func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface, config *rest.Config, namespace, workflowName, workflowUID string, plugins []*Plugin, taskWorkers int, requeueTime time.Duration) *AgentExecutor {
 	return &AgentExecutor{
 		ClientSet:         clientSet,
 		RESTClient:        restClient,
@@ -60,6 +82,8 @@ func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface,
 		workflowUID:       workflowUID,
 		WorkflowInterface: workflow.NewForConfigOrDie(config),
 		consideredTasks:   &sync.Map{},
+		startedTasks:      &sync.Map{},
+		httpJobs:          &sync.Map{},
 		plugins:           plugins,
 		taskWorkers:       taskWorkers,
 		requeueTime:       requeueTime,
@@ -76,6 +100,51 @@ type response struct {
 	Result *wfv1.NodeResult
 }
 
+// startedTask is a task the agent has started executing but does not have a result for yet, which is cancelled if
+// its node is no longer running
+type startedTask struct {
+	mu        sync.Mutex
+	template  wfv1.Template
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// start returns the context to execute the task with, or false if the task was cancelled
+func (t *startedTask) start(ctx context.Context) (context.Context, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancelled {
+		return nil, false
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	return ctx, true
+}
+
+// stop releases the context the task was executed with, and returns whether the task was cancelled meanwhile
+func (t *startedTask) stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+	return t.cancelled
+}
+
+// markCancelled cancels the task's context, and returns false if the task was already cancelled
+func (t *startedTask) markCancelled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancelled {
+		return false
+	}
+	t.cancelled = true
+	if t.cancel != nil {
+		t.cancel()
+	}
+	return true
+}
+
 func (ae *AgentExecutor) Agent(ctx context.Context) error {
 	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
 
@@ -104,6 +173,7 @@ func (ae *AgentExecutor) Agent(ctx context.Context) error {
 
 			if event.Type == watch.Deleted {
 				// We're done if the task set is deleted
+				ae.waitForCancellingTasks(ctx)
 				return nil
 			}
 
@@ -111,8 +181,13 @@ func (ae *AgentExecutor) Agent(ctx context.Context) error {
 			if !ok {
 				return apierr.FromObject(event.Object)
 			}
+			ae.forgetCompletedTasks(taskSet.Status.Nodes)
+			ae.resumeHTTPJobs(taskSet.Status.Nodes)
+			ae.cancelRemovedTasks(ctx, taskSet.Spec.Tasks)
 			if IsWorkflowCompleted(taskSet) {
 				logger.Info(ctx, "Workflow completed... stopping agent")
+				// the controller removes the last tasks in the same update which completes the workflow
+				ae.waitForCancellingTasks(ctx)
 				return nil
 			}
 
@@ -140,8 +215,21 @@ func (ae *AgentExecutor) taskWorker(ctx context.Context, taskQueue chan task, re
 			continue
 		}
 
+		value, _ := ae.startedTasks.LoadOrStore(nodeID, &startedTask{template: tmpl})
+		started := value.(*startedTask)
+		taskCtx, ok := started.start(ctx)
+		if !ok {
+			logger.Info(ctx, "Task was cancelled")
+			continue
+		}
+
 		logger.Info(ctx, "Processing task")
-		result, requeue, err := ae.processTask(ctx, tmpl)
+		result, requeue, err := ae.processTask(taskCtx, nodeID, tmpl)
+		if started.stop() {
+			// the node is no longer running, so its result is not wanted
+			logger.Info(ctx, "Task was cancelled while processing")
+			continue
+		}
 		if err != nil {
 			logger.WithError(err).Error(ctx, "Error in agent task")
 			result = &wfv1.NodeResult{
@@ -157,7 +245,11 @@ func (ae *AgentExecutor) taskWorker(ctx context.Context, taskQueue chan task, re
 			WithField("requeue", requeue).
 			Info(ctx, "Sending result")
 
-		if result.Phase != "" {
+		if result.Phase.Completed() {
+			ae.startedTasks.Delete(nodeID)
+			ae.authHTTPClients.Delete(nodeID)
+		}
+		if result.Phase != "" || result.HTTPJob != nil {
 			responseQueue <- response{NodeID: nodeID, Result: result}
 		}
 		if requeue > 0 {
@@ -230,7 +322,94 @@ func (ae *AgentExecutor) patchWorker(ctx context.Context, taskSetInterface v1alp
 	}
 }
 
-func (ae *AgentExecutor) processTask(ctx context.Context, tmpl wfv1.Template) (*wfv1.NodeResult, time.Duration, error) {
+// forgetCompletedTasks stops the started tasks which someone else completed, i.e. the Argo Server completed an HTTP
+// node from its callback, so they are neither executed again nor cleaned up once they are removed from the task set
+func (ae *AgentExecutor) forgetCompletedTasks(results map[string]wfv1.NodeResult) {
+	for nodeID, result := range results {
+		if !result.Phase.Completed() {
+			continue
+		}
+		if value, ok := ae.startedTasks.Load(nodeID); ok {
+			value.(*startedTask).markCancelled()
+		}
+		ae.httpJobs.Delete(nodeID)
+		ae.authHTTPClients.Delete(nodeID)
+	}
+}
+
+// resumeHTTPJobs restores the jobs of async HTTP templates which the agent was waiting for before it was restarted, so
+// that it resumes polling them or waiting for their callbacks rather than sending their requests again
+func (ae *AgentExecutor) resumeHTTPJobs(results map[string]wfv1.NodeResult) {
+	for nodeID, result := range results {
+		if result.HTTPJob == nil || result.Phase.Completed() {
+			continue
+		}
+		// a task this agent considered has the job already, or has completed it since the result was reported
+		if _, ok := ae.consideredTasks.Load(nodeID); ok {
+			continue
+		}
+		job := &httpJob{pollURL: result.HTTPJob.PollURL}
+		if deadline := result.HTTPJob.Deadline; deadline != nil {
+			job.deadline = deadline.Time
+		}
+		ae.httpJobs.LoadOrStore(nodeID, job)
+	}
+}
+
+// cancelRemovedTasks cancels the started tasks which are no longer in the task set
+func (ae *AgentExecutor) cancelRemovedTasks(ctx context.Context, tasks map[string]wfv1.Template) {
+	ae.startedTasks.Range(func(key, value any) bool {
+		nodeID := key.(string)
+		if _, ok := tasks[nodeID]; !ok {
+			started := value.(*startedTask)
+			if started.markCancelled() {
+				// the task is cancelled in the background, so that a slow plugin or server does not hold up the task set
+				ae.cancellingTasks.Add(1)
+				go func() {
+					defer ae.cancellingTasks.Done()
+					ae.cancelTask(ctx, nodeID, started)
+				}()
+			}
+		}
+		return true
+	})
+}
+
+// waitForCancellingTasks waits for the tasks being cancelled, so that the agent does not stop before their
+// cancellations are sent
+func (ae *AgentExecutor) waitForCancellingTasks(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		ae.cancellingTasks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	case <-time.After(cancelWaitTimeout):
+		logging.RequireLoggerFromContext(ctx).Warn(ctx, "Timed out waiting for tasks to be cancelled")
+	}
+}
+
+// cancelTask asks whoever is executing the cancelled task to stop any work it started
+func (ae *AgentExecutor) cancelTask(ctx context.Context, nodeID string, started *startedTask) {
+	ae.httpJobs.Delete(nodeID)
+	defer ae.authHTTPClients.Delete(nodeID)
+	ctx, logger := logging.RequireLoggerFromContext(ctx).WithField("nodeID", nodeID).InContext(ctx)
+	logger.Info(ctx, "Cancelling task")
+	var err error
+	switch tmpl := started.template; {
+	case tmpl.HTTP != nil:
+		err = ae.cleanupHTTPTemplate(ctx, nodeID, tmpl)
+	case tmpl.Plugin != nil:
+		err = ae.cancelPluginTemplate(ctx, nodeID, tmpl)
+	}
+	if err != nil {
+		logger.WithError(err).Error(ctx, "Failed to cancel task")
+	}
+}
+
+func (ae *AgentExecutor) processTask(ctx context.Context, nodeID string, tmpl wfv1.Template) (*wfv1.NodeResult, time.Duration, error) {
 	var executeTemplate templateExecutor
 	switch {
 	case tmpl.HTTP != nil:
@@ -241,7 +420,7 @@ func (ae *AgentExecutor) processTask(ctx context.Context, tmpl wfv1.Template) (*
 		return nil, 0, fmt.Errorf("agent cannot execute: unknown task type: %v", tmpl.GetType())
 	}
 	result := &wfv1.NodeResult{}
-	requeue, err := executeTemplate(ctx, tmpl, result)
+	requeue, err := executeTemplate(ctx, nodeID, tmpl, result)
 	if err != nil {
 		result.Phase = wfv1.NodeFailed
 		result.Message = err.Error()
@@ -249,10 +428,15 @@ func (ae *AgentExecutor) processTask(ctx context.Context, tmpl wfv1.Template) (*
 	return result, requeue, nil
 }
 
-func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, nodeID string, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
 	if tmpl.HTTP == nil {
 		return 0, nil
 	}
+	if tmpl.HTTP.Async != nil {
+		if job, ok := ae.httpJobs.Load(nodeID); ok {
+			return ae.waitHTTPJob(ctx, nodeID, tmpl, job.(*httpJob), result)
+		}
+	}
 	// Read response.Body after cancel(), sometimes it return a context canceled error
 	// For more detail  https://groups.google.com/g/golang-nuts/c/2FKwG6oEvos
 	var cancel context.CancelFunc
@@ -260,7 +444,7 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(*tmpl.HTTP.TimeoutSeconds)*time.Second)
 		defer cancel()
 	}
-	response, err := ae.executeHTTPTemplateRequest(ctx, tmpl.HTTP)
+	response, err := ae.executeHTTPTemplateRequest(ctx, nodeID, tmpl.HTTP)
 	if err != nil {
 		return 0, err
 	}
@@ -271,7 +455,7 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 		return 0, err
 	}
 
-	outputs := wfv1.Outputs{Result: new(string(bodyBytes))}
+	evalScope := httpEvalScope(tmpl.HTTP, response, bodyBytes)
 	phase := wfv1.NodeSucceeded
 	message := ""
 	if tmpl.HTTP.SuccessCondition == "" {
@@ -282,20 +466,6 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 			message = fmt.Sprintf("received non-2xx response code: %d", response.StatusCode)
 		}
 	} else {
-		evalScope := map[string]any{
-			"request": map[string]any{
-				"method":    tmpl.HTTP.Method,
-				"url":       tmpl.HTTP.URL,
-				"body":      tmpl.HTTP.Body,
-				"bodyBytes": tmpl.HTTP.GetBodyBytes(),
-				"headers":   tmpl.HTTP.Headers.ToHeader(),
-			},
-			"response": map[string]any{
-				"statusCode": response.StatusCode,
-				"body":       string(bodyBytes),
-				"headers":    response.Header,
-			},
-		}
 		success, err := argoexpr.EvalBool(tmpl.HTTP.SuccessCondition, evalScope)
 		if err != nil {
 			return 0, err
@@ -305,13 +475,211 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 			message = fmt.Sprintf("successCondition '%s' evaluated false", tmpl.HTTP.SuccessCondition)
 		}
 	}
+	if phase == wfv1.NodeSucceeded && tmpl.HTTP.Async != nil {
+		return ae.startHTTPJob(nodeID, tmpl.HTTP.Async, response, result)
+	}
+
+	outputs := &wfv1.Outputs{Result: new(string(bodyBytes))}
+	if phase == wfv1.NodeSucceeded {
+		if outputs, err = wfutil.HTTPTemplateOutputs(tmpl.Outputs.Parameters, bodyBytes, evalScope); err != nil {
+			phase = wfv1.NodeFailed
+			message = err.Error()
+			outputs = &wfv1.Outputs{Result: new(string(bodyBytes))}
+		}
+	}
 
 	result.Phase = phase
 	result.Message = message
-	result.Outputs = &outputs
+	result.Outputs = outputs
+	return 0, nil
+}
+
+// httpEvalScope is the scope the conditions and output parameter expressions of HTTP templates are evaluated in
+func httpEvalScope(httpTemplate *wfv1.HTTP, response *http.Response, bodyBytes []byte) map[string]any {
+	return map[string]any{
+		"request": map[string]any{
+			"method":    httpTemplate.Method,
+			"url":       httpTemplate.URL,
+			"body":      httpTemplate.Body,
+			"bodyBytes": httpTemplate.GetBodyBytes(),
+			"headers":   httpTemplate.Headers.ToHeader(),
+		},
+		"response": map[string]any{
+			"statusCode": response.StatusCode,
+			"body":       string(bodyBytes),
+			"headers":    response.Header,
+		},
+	}
+}
+
+// httpJob is a job started by an async HTTP template, which the agent waits for by polling its status, or until its
+// callback times out
+type httpJob struct {
+	pollURL  string
+	deadline time.Time
+}
+
+// startHTTPJob starts waiting for the job the HTTP Request started, and reports the job so that a restarted agent resumes
+// waiting for it. Polled jobs are reported as running, whereas jobs which call back have no phase, so the agent does not
+// overwrite a result the Argo Server set from the callback.
+func (ae *AgentExecutor) startHTTPJob(nodeID string, async *wfv1.HTTPAsync, response *http.Response, result *wfv1.NodeResult) (time.Duration, error) {
+	job := &httpJob{}
+	if async.TimeoutSeconds != nil {
+		job.deadline = time.Now().Add(time.Duration(*async.TimeoutSeconds) * time.Second)
+	}
+	if async.Callback != nil {
+		ae.httpJobs.Store(nodeID, job)
+		result.HTTPJob = job.status()
+		return job.untilDeadline(), nil
+	}
+	job.pollURL = async.Poll.URL
+	if job.pollURL == "" {
+		location, err := response.Location()
+		if err != nil {
+			return 0, fmt.Errorf("poll.url is not specified and the response has no Location header: %w", err)
+		}
+		job.pollURL = location.String()
+	}
+	ae.httpJobs.Store(nodeID, job)
+	result.Phase = wfv1.NodeRunning
+	result.Message = "Polling " + job.pollURL
+	result.HTTPJob = job.status()
+	return job.nextPoll(async.Poll), nil
+}
+
+// status returns the status the job is reported with
+func (j *httpJob) status() *wfv1.HTTPJobStatus {
+	status := &wfv1.HTTPJobStatus{PollURL: j.pollURL}
+	if !j.deadline.IsZero() {
+		status.Deadline = &metav1.Time{Time: j.deadline}
+	}
+	return status
+}
+
+// untilDeadline returns the time until the job times out, or zero if it does not time out
+func (j *httpJob) untilDeadline() time.Duration {
+	if j.deadline.IsZero() {
+		return 0
+	}
+	return max(time.Until(j.deadline), time.Millisecond)
+}
+
+// nextPoll returns the time until the job's status is next polled, or it times out
+func (j *httpJob) nextPoll(poll *wfv1.HTTPPoll) time.Duration {
+	if j.deadline.IsZero() {
+		return poll.GetInterval()
+	}
+	return min(poll.GetInterval(), j.untilDeadline())
+}
+
+// waitHTTPJob polls the status of the job, and fails the node if the job did not complete in time. Results are only
+// reported once the job completes, as the node is already running.
+func (ae *AgentExecutor) waitHTTPJob(ctx context.Context, nodeID string, tmpl wfv1.Template, job *httpJob, result *wfv1.NodeResult) (time.Duration, error) {
+	async := tmpl.HTTP.Async
+	if !job.deadline.IsZero() && time.Now().After(job.deadline) {
+		ae.httpJobs.Delete(nodeID)
+		if err := ae.cleanupHTTPTemplate(ctx, nodeID, tmpl); err != nil {
+			logging.RequireLoggerFromContext(ctx).WithError(err).Error(ctx, "Failed to clean up timed out HTTP job")
+		}
+		result.Phase = wfv1.NodeFailed
+		result.Message = fmt.Sprintf("job did not complete within %ds", *async.TimeoutSeconds)
+		return 0, nil
+	}
+	if async.Callback != nil {
+		return job.untilDeadline(), nil
+	}
+
+	if tmpl.HTTP.TimeoutSeconds != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(*tmpl.HTTP.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	poll := &wfv1.HTTP{
+		Method:             async.Poll.Method,
+		URL:                job.pollURL,
+		Headers:            async.Poll.Headers,
+		InsecureSkipVerify: tmpl.HTTP.InsecureSkipVerify,
+		Auth:               tmpl.HTTP.Auth,
+		RetryStrategy:      tmpl.HTTP.RetryStrategy,
+	}
+	response, err := ae.executeHTTPTemplateRequest(ctx, nodeID, poll)
+	if err != nil {
+		ae.httpJobs.Delete(nodeID)
+		return 0, err
+	}
+	defer response.Body.Close()
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		ae.httpJobs.Delete(nodeID)
+		return 0, err
+	}
+
+	evalScope := httpEvalScope(poll, response, bodyBytes)
+	if async.Poll.FailureCondition != "" {
+		failed, err := argoexpr.EvalBool(async.Poll.FailureCondition, evalScope)
+		if err != nil {
+			ae.httpJobs.Delete(nodeID)
+			return 0, err
+		}
+		if failed {
+			ae.httpJobs.Delete(nodeID)
+			result.Phase = wfv1.NodeFailed
+			result.Message = fmt.Sprintf("failureCondition '%s' evaluated true", async.Poll.FailureCondition)
+			result.Outputs = &wfv1.Outputs{Result: new(string(bodyBytes))}
+			return 0, nil
+		}
+	}
+	succeeded, err := argoexpr.EvalBool(async.Poll.SuccessCondition, evalScope)
+	if err != nil {
+		ae.httpJobs.Delete(nodeID)
+		return 0, err
+	}
+	if !succeeded {
+		return job.nextPoll(async.Poll), nil
+	}
+	ae.httpJobs.Delete(nodeID)
+	outputs, err := wfutil.HTTPTemplateOutputs(tmpl.Outputs.Parameters, bodyBytes, evalScope)
+	if err != nil {
+		result.Phase = wfv1.NodeFailed
+		result.Message = err.Error()
+		result.Outputs = &wfv1.Outputs{Result: new(string(bodyBytes))}
+		return 0, nil
+	}
+	result.Phase = wfv1.NodeSucceeded
+	result.Outputs = outputs
 	return 0, nil
 }
 
+// cleanupHTTPTemplate sends the cleanup request of an HTTP template, if it has one
+func (ae *AgentExecutor) cleanupHTTPTemplate(ctx context.Context, nodeID string, tmpl wfv1.Template) error {
+	cleanup := tmpl.HTTP.Cleanup
+	if cleanup == nil {
+		return nil
+	}
+	timeout := defaultCleanupTimeout
+	if cleanup.TimeoutSeconds != nil {
+		timeout = time.Duration(*cleanup.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	response, err := ae.executeHTTPTemplateRequest(ctx, nodeID, &wfv1.HTTP{
+		Method:             cleanup.Method,
+		URL:                cleanup.URL,
+		Headers:            cleanup.Headers,
+		Body:               cleanup.Body,
+		InsecureSkipVerify: tmpl.HTTP.InsecureSkipVerify,
+		Auth:               tmpl.HTTP.Auth,
+	})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("cleanup request received non-2xx response code: %d", response.StatusCode)
+	}
+	return nil
+}
+
 var httpClientSkip = &http.Client{
 	Transport: &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -323,7 +691,94 @@ var httpClients = map[bool]*http.Client{
 	true:  httpClientSkip,
 }
 
-func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Response, error) {
+// defaultHTTPRetryBackoff is the backoff between HTTP request retries when the retry strategy does not set one
+const defaultHTTPRetryBackoff = time.Second
+
+func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, nodeID string, httpTemplate *wfv1.HTTP) (*http.Response, error) {
+	client, err := ae.httpClient(ctx, nodeID, httpTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		limit   *int
+		backoff *wfv1.Backoff
+	)
+	if httpTemplate.RetryStrategy != nil {
+		if limit, err = intstr.Int(httpTemplate.RetryStrategy.Limit); err != nil {
+			return nil, err
+		}
+		backoff = httpTemplate.RetryStrategy.Backoff
+	}
+	var maxDurationDeadline time.Time
+	if backoff != nil && backoff.MaxDuration != "" {
+		maxDuration, err := wfv1.ParseStringToDuration(backoff.MaxDuration)
+		if err != nil {
+			return nil, err
+		}
+		maxDurationDeadline = time.Now().Add(maxDuration)
+	}
+	for retries := 0; ; retries++ {
+		request, err := ae.newHTTPTemplateRequest(ctx, httpTemplate)
+		if err != nil {
+			return nil, err
+		}
+		response, err := client.Do(request)
+		retryable := (err != nil && ctx.Err() == nil) || (err == nil && response.StatusCode >= 500)
+		if !retryable || limit == nil || retries >= *limit {
+			return response, err
+		}
+		timeToWait, backoffErr := httpRetryBackoff(backoff, retries)
+		if backoffErr == nil && !maxDurationDeadline.IsZero() && time.Now().Add(timeToWait).After(maxDurationDeadline) {
+			return response, err
+		}
+		if err == nil {
+			response.Body.Close()
+		}
+		if backoffErr != nil {
+			return nil, backoffErr
+		}
+		logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"url": httpTemplate.URL, "retries": retries, "timeToWait": timeToWait}).WithError(err).Info(ctx, "Retrying HTTP request")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(timeToWait):
+		}
+	}
+}
+
+// httpRetryBackoff returns the time to wait before retrying an HTTP request, which is duration * factor^retries
+func httpRetryBackoff(backoff *wfv1.Backoff, retries int) (time.Duration, error) {
+	if backoff == nil || backoff.Duration == "" {
+		return defaultHTTPRetryBackoff, nil
+	}
+	timeToWait, err := wfv1.ParseStringToDuration(backoff.Duration)
+	if err != nil {
+		return 0, err
+	}
+	factor, err := intstr.Int32(backoff.Factor)
+	if err != nil {
+		return 0, err
+	}
+	if factor != nil && *factor > 0 {
+		multiplier := math.Pow(float64(*factor), float64(retries))
+		if multiplier > float64(math.MaxInt64)/float64(timeToWait) {
+			timeToWait = time.Duration(math.MaxInt64)
+		} else {
+			timeToWait *= time.Duration(multiplier)
+		}
+	}
+	if backoff.Cap != "" {
+		capDuration, err := wfv1.ParseStringToDuration(backoff.Cap)
+		if err != nil {
+			return 0, err
+		}
+		timeToWait = min(timeToWait, capDuration)
+	}
+	return timeToWait, nil
+}
+
+// newHTTPTemplateRequest builds the request of an HTTP template, resolving secret headers and basic auth
+func (ae *AgentExecutor) newHTTPTemplateRequest(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Request, error) {
 	var (
 		request *http.Request
 		err     error
@@ -356,28 +811,131 @@ func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTem
 		}
 	}
 
-	response, err := httpClients[httpTemplate.InsecureSkipVerify].Do(request)
+	if httpTemplate.Auth != nil && httpTemplate.Auth.BasicAuth.UsernameSecret != nil {
+		username, err := ae.getSecret(ctx, httpTemplate.Auth.BasicAuth.UsernameSecret)
+		if err != nil {
+			return nil, err
+		}
+		var password string
+		if httpTemplate.Auth.BasicAuth.PasswordSecret != nil {
+			if password, err = ae.getSecret(ctx, httpTemplate.Auth.BasicAuth.PasswordSecret); err != nil {
+				return nil, err
+			}
+		}
+		request.SetBasicAuth(username, password)
+	}
+	return request, nil
+}
+
+// httpClient returns the client for the HTTP template, which presents the client certificate and fetches OAuth2
+// tokens using the client credentials grant if the template's auth has them
+func (ae *AgentExecutor) httpClient(ctx context.Context, nodeID string, httpTemplate *wfv1.HTTP) (*http.Client, error) {
+	auth := httpTemplate.Auth
+	if auth == nil {
+		return httpClients[httpTemplate.InsecureSkipVerify], nil
+	}
+	if (auth.ClientCert.ClientCertSecret == nil) != (auth.ClientCert.ClientKeySecret == nil) {
+		return nil, stderrors.New("clientCert must set both clientCertSecret and clientKeySecret")
+	}
+	if auth.ClientCert.ClientCertSecret == nil && auth.OAuth2.ClientIDSecret == nil {
+		return httpClients[httpTemplate.InsecureSkipVerify], nil
+	}
+	// all the requests of a task use the same authentication, so its client, and the token of its OAuth2 client, are
+	// reused until the task completes
+	if client, ok := ae.authHTTPClients.Load(nodeID); ok {
+		return client.(*http.Client), nil
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: httpTemplate.InsecureSkipVerify}
+	if auth.ClientCert.ClientCertSecret != nil {
+		clientCert, err := ae.getSecret(ctx, auth.ClientCert.ClientCertSecret)
+		if err != nil {
+			return nil, err
+		}
+		clientKey, err := ae.getSecret(ctx, auth.ClientCert.ClientKeySecret)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: transport}
+	if auth.OAuth2.ClientIDSecret != nil && auth.OAuth2.ClientSecretSecret != nil && auth.OAuth2.TokenURLSecret != nil {
+		clientID, err := ae.getSecret(ctx, auth.OAuth2.ClientIDSecret)
+		if err != nil {
+			return nil, err
+		}
+		clientSecret, err := ae.getSecret(ctx, auth.OAuth2.ClientSecretSecret)
+		if err != nil {
+			return nil, err
+		}
+		tokenURL, err := ae.getSecret(ctx, auth.OAuth2.TokenURLSecret)
+		if err != nil {
+			return nil, err
+		}
+		endpointParams := url.Values{}
+		for _, param := range auth.OAuth2.EndpointParams {
+			endpointParams.Add(param.Key, param.Value)
+		}
+		conf := clientcredentials.Config{
+			ClientID:       clientID,
+			ClientSecret:   clientSecret,
+			TokenURL:       tokenURL,
+			Scopes:         auth.OAuth2.Scopes,
+			EndpointParams: endpointParams,
+		}
+		// the token request uses the same TLS configuration as the template's request. The token source outlives the
+		// request, so it does not use the request's context.
+		client = conf.Client(context.WithValue(context.WithoutCancel(ctx), oauth2.HTTPClient, client))
+	}
+	value, _ := ae.authHTTPClients.LoadOrStore(nodeID, client)
+	return value.(*http.Client), nil
+}
+
+func (ae *AgentExecutor) getSecret(ctx context.Context, selector *apiv1.SecretKeySelector) (string, error) {
+	secret, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, selector.Name, selector.Key)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	return response, nil
+	return string(secret), nil
 }
 
-func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+// executePluginTemplate routes the template to the healthy plugins which execute it, until one does. Templates wait
+// for unhealthy plugins which may execute them, in case they are starting or restarting, and error once the plugins
+// have been unhealthy for too long. Plugins' errors are node errors naming the plugin.
+func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, nodeID string, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+	name, err := pluginTemplateName(tmpl.Plugin)
+	if err != nil {
+		return 0, err
+	}
 	args := executorplugins.ExecuteTemplateArgs{
-		Workflow: &executorplugins.Workflow{
-			ObjectMeta: executorplugins.ObjectMeta{
-				Name:      ae.WorkflowName,
-				Namespace: ae.Namespace,
-				UID:       ae.workflowUID,
-			},
-		},
+		Workflow: ae.pluginWorkflow(),
 		Template: &tmpl,
+		NodeID:   nodeID,
 	}
 	reply := &executorplugins.ExecuteTemplateReply{}
+	waiting := false
+	var unhealthy []error
 	for _, plug := range ae.plugins {
+		if !plug.executes(name) {
+			continue
+		}
+		if unhealthyFor, err := plug.health(ctx); err != nil {
+			if unhealthyFor < pluginUnhealthyTimeout {
+				waiting = true
+			} else {
+				unhealthy = append(unhealthy, fmt.Errorf("plugin %s is unhealthy: %w", plug.Name, err))
+			}
+			continue
+		}
 		if err := plug.ExecuteTemplate(ctx, args, reply); err != nil {
-			return 0, err
+			result.Phase = wfv1.NodeError
+			result.Message = fmt.Sprintf("plugin %s failed to execute the template: %v", plug.Name, err)
+			return 0, nil
 		} else if reply.Node != nil {
 			*result = *reply.Node
 			if reply.Node.Phase == wfv1.NodeSucceeded {
@@ -386,9 +944,55 @@ func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Te
 			return reply.GetRequeue(), nil
 		}
 	}
+	switch {
+	case waiting:
+		logging.RequireLoggerFromContext(ctx).WithField("nodeID", nodeID).Info(ctx, "Waiting for plugins to become healthy")
+		return pluginProbePeriod, nil
+	case len(unhealthy) > 0:
+		result.Phase = wfv1.NodeError
+		result.Message = stderrors.Join(unhealthy...).Error()
+		return 0, nil
+	}
 	return 0, fmt.Errorf("no plugin executed the template")
 }
 
+// cancelPluginTemplate asks the plugins to stop executing the template. As with execution, each plugin decides whether
+// the template is its own. Plugins which do not implement TemplateCanceller are not asked, and plugins which return a 404
+// error are not asked again.
+func (ae *AgentExecutor) cancelPluginTemplate(ctx context.Context, nodeID string, tmpl wfv1.Template) error {
+	name, err := pluginTemplateName(tmpl.Plugin)
+	if err != nil {
+		return err
+	}
+	args := executorplugins.CancelTemplateArgs{
+		Workflow: ae.pluginWorkflow(),
+		Template: &tmpl,
+		NodeID:   nodeID,
+		Reason:   cancelReason,
+	}
+	var errs []error
+	for _, plug := range ae.plugins {
+		canceller, ok := plug.TemplateExecutor.(executorplugins.TemplateCanceller)
+		if !ok || !plug.executes(name) {
+			continue
+		}
+		if err := canceller.CancelTemplate(ctx, args, &executorplugins.CancelTemplateReply{}); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", plug.Name, err))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+func (ae *AgentExecutor) pluginWorkflow() *executorplugins.Workflow {
+	return &executorplugins.Workflow{
+		ObjectMeta: executorplugins.ObjectMeta{
+			Name:      ae.WorkflowName,
+			Namespace: ae.Namespace,
+			UID:       ae.workflowUID,
+		},
+	}
+}
+
 func IsWorkflowCompleted(wts *wfv1.WorkflowTaskSet) bool {
 	return wts.Labels[common.LabelKeyCompleted] == "true"
 }
@@ -0,0 +1,115 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	executorplugins "github.com/argoproj/argo-workflows/v4/pkg/plugins/executor"
+)
+
+const (
+	// pluginProbePeriod is how long the result of a plugin's health check is used for before it is checked again
+	pluginProbePeriod = 10 * time.Second
+	// pluginProbeTimeout is the timeout of a plugin's health check
+	pluginProbeTimeout = 5 * time.Second
+	// pluginUnhealthyTimeout is how long a plugin can be unhealthy before the templates routed to it error rather than
+	// wait, which gives plugins time to start, or to be restarted
+	pluginUnhealthyTimeout = time.Minute
+)
+
+// errPluginNotProbed is the error of a plugin whose first health check has not completed
+var errPluginNotProbed = errors.New("plugin has not been checked yet")
+
+// Plugin is an executor plugin running as a sidecar of the agent pod
+type Plugin struct {
+	executorplugins.TemplateExecutor
+	// Name is the name of the plugin's container
+	Name string
+	// Version is the version the plugin was installed with, if any
+	Version string
+	// Capabilities are the names of the plugin templates the plugin executes. A plugin without capabilities is asked
+	// to execute every plugin template.
+	Capabilities []string
+
+	mu             sync.Mutex
+	probing        bool
+	probed         time.Time
+	err            error
+	unhealthySince time.Time
+}
+
+// executes returns whether the plugin executes plugin templates with the name
+func (p *Plugin) executes(name string) bool {
+	return len(p.Capabilities) == 0 || slices.Contains(p.Capabilities, name)
+}
+
+// health returns the error of the plugin's latest health check, checking it again if the result is stale, and how long
+// the plugin has been unhealthy for. The lock is not held while the plugin is checked, so that a slow plugin does not
+// block the other tasks, which use the latest result until the check completes.
+func (p *Plugin) health(ctx context.Context) (time.Duration, error) {
+	p.mu.Lock()
+	now := time.Now()
+	probe := !p.probing && now.Sub(p.probed) >= pluginProbePeriod
+	if probe {
+		p.probing = true
+	}
+	p.mu.Unlock()
+	if probe {
+		err := p.probe(ctx)
+		p.mu.Lock()
+		p.probing = false
+		p.probed = now
+		p.err = err
+		switch {
+		case err == nil:
+			p.unhealthySince = time.Time{}
+		case p.unhealthySince.IsZero():
+			p.unhealthySince = now
+		}
+		p.mu.Unlock()
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case p.probed.IsZero():
+		return 0, errPluginNotProbed
+	case p.err == nil:
+		return 0, nil
+	}
+	return time.Since(p.unhealthySince), p.err
+}
+
+func (p *Plugin) probe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pluginProbeTimeout)
+	defer cancel()
+	checker, ok := p.TemplateExecutor.(executorplugins.HealthChecker)
+	if !ok {
+		return nil
+	}
+	reply := &executorplugins.HealthReply{}
+	if err := checker.Health(ctx, executorplugins.HealthArgs{}, reply); err != nil {
+		return err
+	}
+	if p.Version != "" && reply.Version != "" && reply.Version != p.Version {
+		return fmt.Errorf("plugin is version %s, but version %s is installed", reply.Version, p.Version)
+	}
+	return nil
+}
+
+// pluginTemplateName returns the name of the plugin template, i.e. the only key of its `plugin` field
+func pluginTemplateName(tmpl *wfv1.Plugin) (string, error) {
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(tmpl.Value, &m); err != nil {
+		return "", err
+	}
+	for name := range m {
+		return name, nil
+	}
+	return "", fmt.Errorf("plugin template is empty")
+}
@@ -3,23 +3,37 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/argoproj/argo-workflows/v4/util/logging"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
 	executorplugins "github.com/argoproj/argo-workflows/v4/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
 )
 
 func TestUnsupportedTemplateTaskWorker(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	ae := &AgentExecutor{
 		consideredTasks: &sync.Map{},
+		startedTasks:    &sync.Map{},
+		httpJobs:        &sync.Map{},
 	}
 	taskQueue := make(chan task)
 	defer close(taskQueue)
@@ -74,9 +88,11 @@ func TestAgentPluginExecuteTaskSet(t *testing.T) {
 			ctx := logging.TestContext(t.Context())
 			ae := &AgentExecutor{
 				consideredTasks: &sync.Map{},
-				plugins:         []executorplugins.TemplateExecutor{tc.plugin},
+				startedTasks:    &sync.Map{},
+				httpJobs:        &sync.Map{},
+				plugins:         []*Plugin{{Name: "my-plugin", TemplateExecutor: tc.plugin}},
 			}
-			_, requeue, err := ae.processTask(ctx, *tc.template)
+			_, requeue, err := ae.processTask(ctx, "a", *tc.template)
 			if err != nil {
 				t.Errorf("expect nil, but got %v", err)
 			}
@@ -98,3 +114,710 @@ func (a alwaysSucceededPlugin) ExecuteTemplate(_ context.Context, _ executorplug
 	reply.Requeue = &metav1.Duration{Duration: a.requeue}
 	return nil
 }
+
+func (a alwaysSucceededPlugin) CancelTemplate(_ context.Context, _ executorplugins.CancelTemplateArgs, _ *executorplugins.CancelTemplateReply) error {
+	return nil
+}
+
+func (a alwaysSucceededPlugin) Health(_ context.Context, _ executorplugins.HealthArgs, _ *executorplugins.HealthReply) error {
+	return nil
+}
+
+type longRunningPlugin struct {
+	cancelled chan executorplugins.CancelTemplateArgs
+}
+
+func (p longRunningPlugin) ExecuteTemplate(_ context.Context, _ executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
+	reply.Node = &v1alpha1.NodeResult{Phase: v1alpha1.NodeRunning}
+	reply.Requeue = &metav1.Duration{Duration: time.Hour}
+	return nil
+}
+
+func (p longRunningPlugin) CancelTemplate(_ context.Context, args executorplugins.CancelTemplateArgs, _ *executorplugins.CancelTemplateReply) error {
+	p.cancelled <- args
+	return nil
+}
+
+func (p longRunningPlugin) Health(_ context.Context, _ executorplugins.HealthArgs, _ *executorplugins.HealthReply) error {
+	return nil
+}
+
+func TestAgentCancelPluginTask(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// the channel is unbuffered, so the plugin blocks until the cancellation is received, which must not block the agent
+	plugin := longRunningPlugin{cancelled: make(chan executorplugins.CancelTemplateArgs)}
+	ae := &AgentExecutor{
+		WorkflowName:    "my-wf",
+		consideredTasks: &sync.Map{},
+		startedTasks:    &sync.Map{},
+		httpJobs:        &sync.Map{},
+		plugins:         []*Plugin{{Name: "my-plugin", TemplateExecutor: plugin}},
+	}
+	taskQueue := make(chan task)
+	defer close(taskQueue)
+	responseQueue := make(chan response)
+	go ae.taskWorker(ctx, taskQueue, responseQueue)
+
+	tmpl := v1alpha1.Template{Plugin: &v1alpha1.Plugin{Object: v1alpha1.Object{Value: json.RawMessage(`{"key": "value"}`)}}}
+	taskQueue <- task{NodeID: "a", Template: tmpl}
+	assert.Equal(t, v1alpha1.NodeRunning, (<-responseQueue).Result.Phase)
+
+	// tasks which are still in the task set are not cancelled
+	ae.cancelRemovedTasks(ctx, map[string]v1alpha1.Template{"a": tmpl})
+	assert.Empty(t, plugin.cancelled)
+
+	ae.cancelRemovedTasks(ctx, map[string]v1alpha1.Template{})
+	args := <-plugin.cancelled
+	assert.Equal(t, "a", args.NodeID)
+	assert.Equal(t, "my-wf", args.Workflow.ObjectMeta.Name)
+	assert.Equal(t, cancelReason, args.Reason)
+
+	// tasks are only cancelled once
+	ae.cancelRemovedTasks(ctx, map[string]v1alpha1.Template{})
+	assert.Empty(t, plugin.cancelled)
+}
+
+// executeOnlyPlugin is a plugin which implements neither TemplateCanceller nor HealthChecker
+type executeOnlyPlugin struct{}
+
+func (p executeOnlyPlugin) ExecuteTemplate(_ context.Context, _ executorplugins.ExecuteTemplateArgs, _ *executorplugins.ExecuteTemplateReply) error {
+	return nil
+}
+
+func TestAgentCancelPluginTaskWithoutCanceller(t *testing.T) {
+	ae := &AgentExecutor{plugins: []*Plugin{{Name: "my-plugin", TemplateExecutor: executeOnlyPlugin{}}}}
+	tmpl := v1alpha1.Template{Plugin: &v1alpha1.Plugin{Object: v1alpha1.Object{Value: json.RawMessage(`{"key": "value"}`)}}}
+	require.NoError(t, ae.cancelPluginTemplate(logging.TestContext(t.Context()), "a", tmpl))
+}
+
+func TestPluginHealthWithoutChecker(t *testing.T) {
+	plug := &Plugin{Name: "my-plugin", TemplateExecutor: executeOnlyPlugin{}}
+	_, err := plug.health(logging.TestContext(t.Context()))
+	require.NoError(t, err)
+}
+
+type fakePlugin struct {
+	executed   bool
+	executeErr error
+	healthErr  error
+	version    string
+	// healthBlock blocks health checks until it is closed, if it is set
+	healthBlock chan struct{}
+}
+
+func (p *fakePlugin) ExecuteTemplate(_ context.Context, _ executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
+	p.executed = true
+	if p.executeErr != nil {
+		return p.executeErr
+	}
+	reply.Node = &v1alpha1.NodeResult{Phase: v1alpha1.NodeSucceeded}
+	return nil
+}
+
+func (p *fakePlugin) CancelTemplate(_ context.Context, _ executorplugins.CancelTemplateArgs, _ *executorplugins.CancelTemplateReply) error {
+	return nil
+}
+
+func (p *fakePlugin) Health(_ context.Context, _ executorplugins.HealthArgs, reply *executorplugins.HealthReply) error {
+	if p.healthBlock != nil {
+		<-p.healthBlock
+	}
+	reply.Version = p.version
+	return p.healthErr
+}
+
+func TestAgentPluginRouting(t *testing.T) {
+	tmpl := v1alpha1.Template{Plugin: &v1alpha1.Plugin{Object: v1alpha1.Object{Value: json.RawMessage(`{"hello": {}}`)}}}
+	execute := func(t *testing.T, plugins ...*Plugin) (*v1alpha1.NodeResult, time.Duration) {
+		ctx := logging.TestContext(t.Context())
+		ae := &AgentExecutor{plugins: plugins}
+		result, requeue, err := ae.processTask(ctx, "a", tmpl)
+		require.NoError(t, err)
+		return result, requeue
+	}
+	t.Run("Capabilities", func(t *testing.T) {
+		other := &fakePlugin{}
+		hello := &fakePlugin{}
+		result, _ := execute(t,
+			&Plugin{Name: "other", Capabilities: []string{"other"}, TemplateExecutor: other},
+			&Plugin{Name: "hello", Capabilities: []string{"hello"}, TemplateExecutor: hello},
+		)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		assert.False(t, other.executed)
+		assert.True(t, hello.executed)
+	})
+	t.Run("SkipsUnhealthy", func(t *testing.T) {
+		unhealthy := &fakePlugin{healthErr: errors.New("connection refused")}
+		healthy := &fakePlugin{}
+		result, _ := execute(t,
+			&Plugin{Name: "unhealthy", TemplateExecutor: unhealthy},
+			&Plugin{Name: "healthy", TemplateExecutor: healthy},
+		)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		assert.False(t, unhealthy.executed)
+		assert.True(t, healthy.executed)
+	})
+	t.Run("WaitsForUnhealthy", func(t *testing.T) {
+		plug := &fakePlugin{healthErr: errors.New("connection refused")}
+		result, requeue := execute(t, &Plugin{Name: "my-plugin", TemplateExecutor: plug})
+		assert.Empty(t, result.Phase)
+		assert.Equal(t, pluginProbePeriod, requeue)
+		assert.False(t, plug.executed)
+	})
+	t.Run("Unhealthy", func(t *testing.T) {
+		plug := &Plugin{
+			Name:             "my-plugin",
+			TemplateExecutor: &fakePlugin{},
+			probed:           time.Now(),
+			err:              errors.New("connection refused"),
+			unhealthySince:   time.Now().Add(-pluginUnhealthyTimeout),
+		}
+		result, _ := execute(t, plug)
+		assert.Equal(t, v1alpha1.NodeError, result.Phase)
+		assert.Equal(t, "plugin my-plugin is unhealthy: connection refused", result.Message)
+	})
+	t.Run("VersionMismatch", func(t *testing.T) {
+		plug := &Plugin{Name: "my-plugin", Version: "v1", TemplateExecutor: &fakePlugin{version: "v2"}}
+		_, err := plug.health(logging.TestContext(t.Context()))
+		require.EqualError(t, err, "plugin is version v2, but version v1 is installed")
+	})
+	t.Run("ProbeDoesNotBlock", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		fake := &fakePlugin{healthBlock: make(chan struct{})}
+		plug := &Plugin{Name: "my-plugin", TemplateExecutor: fake}
+		done := make(chan error)
+		go func() {
+			_, err := plug.health(ctx)
+			done <- err
+		}()
+		require.Eventually(t, func() bool {
+			plug.mu.Lock()
+			defer plug.mu.Unlock()
+			return plug.probing
+		}, 5*time.Second, time.Millisecond)
+		// the check in progress does not block other tasks
+		_, err := plug.health(ctx)
+		require.ErrorIs(t, err, errPluginNotProbed)
+		close(fake.healthBlock)
+		require.NoError(t, <-done)
+		_, err = plug.health(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("ExecuteError", func(t *testing.T) {
+		result, _ := execute(t, &Plugin{Name: "my-plugin", TemplateExecutor: &fakePlugin{executeErr: errors.New("boom")}})
+		assert.Equal(t, v1alpha1.NodeError, result.Phase)
+		assert.Equal(t, "plugin my-plugin failed to execute the template: boom", result.Message)
+	})
+	t.Run("NoPlugin", func(t *testing.T) {
+		result, _ := execute(t, &Plugin{Name: "other", Capabilities: []string{"other"}, TemplateExecutor: &fakePlugin{}})
+		assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+		assert.Equal(t, "no plugin executed the template", result.Message)
+	})
+}
+
+func TestAgentCancelHTTPTask(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	requested := make(chan struct{})
+	cleanedUp := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			cleanedUp <- r.URL.Path
+			return
+		}
+		close(requested)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ae := &AgentExecutor{
+		consideredTasks: &sync.Map{},
+		startedTasks:    &sync.Map{},
+		httpJobs:        &sync.Map{},
+	}
+	taskQueue := make(chan task)
+	defer close(taskQueue)
+	responseQueue := make(chan response, 1)
+	go ae.taskWorker(ctx, taskQueue, responseQueue)
+
+	taskQueue <- task{NodeID: "a", Template: v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+		URL:     server.URL + "/jobs",
+		Cleanup: &v1alpha1.HTTPCleanup{Method: http.MethodDelete, URL: server.URL + "/jobs/a"},
+	}}}
+	<-requested
+
+	ae.cancelRemovedTasks(ctx, map[string]v1alpha1.Template{})
+	assert.Equal(t, "/jobs/a", <-cleanedUp)
+
+	// the result of a cancelled task is not reported
+	require.Never(t, func() bool { return len(responseQueue) > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestAgentCancelsRemovedTasksBeforeStopping(t *testing.T) {
+	tmpl := v1alpha1.Template{Plugin: &v1alpha1.Plugin{Object: v1alpha1.Object{Value: json.RawMessage(`{"key": "value"}`)}}}
+	taskSet := func(tasks map[string]v1alpha1.Template, labels map[string]string) *v1alpha1.WorkflowTaskSet {
+		return &v1alpha1.WorkflowTaskSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: labels},
+			Spec:       v1alpha1.WorkflowTaskSetSpec{Tasks: tasks},
+		}
+	}
+	tests := []struct {
+		name   string
+		remove func(w *watch.FakeWatcher)
+	}{
+		{
+			// the controller removes the last tasks in the same update which completes the workflow
+			name: "Completed",
+			remove: func(w *watch.FakeWatcher) {
+				w.Modify(taskSet(nil, map[string]string{common.LabelKeyCompleted: "true"}))
+			},
+		},
+		{
+			name: "Deleted",
+			remove: func(w *watch.FakeWatcher) {
+				w.Modify(taskSet(nil, nil))
+				w.Delete(taskSet(nil, nil))
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			plugin := longRunningPlugin{cancelled: make(chan executorplugins.CancelTemplateArgs)}
+			w := watch.NewFake()
+			client := argofake.NewClientset()
+			client.PrependWatchReactor("workflowtasksets", k8stesting.DefaultWatchReactor(w, nil))
+			ae := &AgentExecutor{
+				WorkflowName:      "my-wf",
+				Namespace:         "my-ns",
+				WorkflowInterface: client,
+				consideredTasks:   &sync.Map{},
+				startedTasks:      &sync.Map{},
+				httpJobs:          &sync.Map{},
+				plugins:           []*Plugin{{Name: "my-plugin", TemplateExecutor: plugin}},
+				taskWorkers:       1,
+				requeueTime:       time.Hour,
+			}
+			stopped := make(chan error, 1)
+			go func() { stopped <- ae.Agent(ctx) }()
+
+			w.Add(taskSet(map[string]v1alpha1.Template{"a": tmpl}, nil))
+			require.Eventually(t, func() bool {
+				_, ok := ae.startedTasks.Load("a")
+				return ok
+			}, time.Second, 10*time.Millisecond)
+			tc.remove(w)
+
+			// the plugin blocks until the cancellation is received, and the agent must wait for it before stopping
+			require.Never(t, func() bool { return len(stopped) > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+			assert.Equal(t, "a", (<-plugin.cancelled).NodeID)
+			require.NoError(t, <-stopped)
+		})
+	}
+}
+
+func TestAgentHTTPTemplate(t *testing.T) {
+	t.Run("Retries", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL:           server.URL,
+			RetryStrategy: &v1alpha1.HTTPRetryStrategy{Limit: new(intstr.FromInt32(2)), Backoff: &v1alpha1.Backoff{Duration: "1ms"}},
+		}}, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		assert.Equal(t, "ok", *result.Outputs.Result)
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL:           server.URL,
+			RetryStrategy: &v1alpha1.HTTPRetryStrategy{Limit: new(intstr.FromInt32(1)), Backoff: &v1alpha1.Backoff{Duration: "1ms"}},
+		}}, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+		assert.Equal(t, "received non-2xx response code: 502", result.Message)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("OutputParameters", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/jobs/1")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "1", "tags": ["a", "b"]}`))
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{
+			HTTP: &v1alpha1.HTTP{URL: server.URL},
+			Outputs: v1alpha1.Outputs{Parameters: []v1alpha1.Parameter{
+				{Name: "id", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "$.id"}},
+				{Name: "tags", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "$.tags"}},
+				{Name: "location", ValueFrom: &v1alpha1.ValueFrom{Expression: `response.headers["Location"][0]`}},
+				{Name: "status", ValueFrom: &v1alpha1.ValueFrom{Expression: "response.statusCode"}},
+				{Name: "missing", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "$.missing", Default: v1alpha1.AnyStringPtr("none")}},
+			}},
+		}, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		values := map[string]string{}
+		for _, param := range result.Outputs.Parameters {
+			values[param.Name] = param.Value.String()
+		}
+		assert.Equal(t, map[string]string{"id": "1", "tags": `["a","b"]`, "location": "/jobs/1", "status": "201", "missing": "none"}, values)
+	})
+
+	t.Run("OutputParameterError", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`not json`))
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{
+			HTTP:    &v1alpha1.HTTP{URL: server.URL},
+			Outputs: v1alpha1.Outputs{Parameters: []v1alpha1.Parameter{{Name: "id", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "$.id"}}}},
+		}, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+		assert.Contains(t, result.Message, "unable to get output parameter 'id'")
+	})
+
+	t.Run("BasicAuth", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "admin" || password != "password" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{
+			Namespace: "argo",
+			ClientSet: fake.NewClientset(&apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "argo"},
+				Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("password")},
+			}),
+		}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL: server.URL,
+			Auth: &v1alpha1.HTTPAuth{BasicAuth: v1alpha1.BasicAuth{
+				UsernameSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "creds"}, Key: "username"},
+				PasswordSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "creds"}, Key: "password"},
+			}},
+		}}, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+	})
+
+	t.Run("OAuth2", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var tokens atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/token" {
+				tokens.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token": "my-token", "token_type": "Bearer"}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer my-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		defer server.Close()
+
+		ae := &AgentExecutor{
+			Namespace: "argo",
+			ClientSet: fake.NewClientset(&apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "oauth", Namespace: "argo"},
+				Data:       map[string][]byte{"id": []byte("client"), "secret": []byte("secret"), "url": []byte(server.URL + "/token")},
+			}),
+		}
+		secret := func(key string) *apiv1.SecretKeySelector {
+			return &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "oauth"}, Key: key}
+		}
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL: server.URL,
+			Auth: &v1alpha1.HTTPAuth{OAuth2: v1alpha1.OAuth2Auth{
+				ClientIDSecret:     secret("id"),
+				ClientSecretSecret: secret("secret"),
+				TokenURLSecret:     secret("url"),
+			}},
+		}}
+		for range 2 {
+			result := &v1alpha1.NodeResult{}
+			_, err := ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+			require.NoError(t, err)
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		}
+		// the task's client is reused, so the token is only fetched once
+		assert.Equal(t, int32(1), tokens.Load())
+	})
+
+	t.Run("ClientCertWithoutKey", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		ae := &AgentExecutor{}
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL: "http://localhost",
+			Auth: &v1alpha1.HTTPAuth{ClientCert: v1alpha1.ClientCertAuth{
+				ClientCertSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "creds"}, Key: "cert"},
+			}},
+		}}, result)
+		require.EqualError(t, err, "clientCert must set both clientCertSecret and clientKeySecret")
+	})
+}
+
+func TestAgentAsyncHTTPTemplate(t *testing.T) {
+	newAgent := func() *AgentExecutor {
+		return &AgentExecutor{consideredTasks: &sync.Map{}, startedTasks: &sync.Map{}, httpJobs: &sync.Map{}}
+	}
+
+	t.Run("Poll", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var polls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				w.Header().Set("Location", "/jobs/1")
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			assert.Equal(t, "/jobs/1", r.URL.Path)
+			if polls.Add(1) < 2 {
+				_, _ = w.Write([]byte(`{"status": "running"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "done", "id": "1"}`))
+		}))
+		defer server.Close()
+
+		ae := newAgent()
+		tmpl := v1alpha1.Template{
+			HTTP: &v1alpha1.HTTP{URL: server.URL + "/jobs", Method: http.MethodPost, Async: &v1alpha1.HTTPAsync{Poll: &v1alpha1.HTTPPoll{
+				IntervalSeconds:  new(int64(1)),
+				SuccessCondition: `fromJSON(response.body).status == "done"`,
+				FailureCondition: `fromJSON(response.body).status == "failed"`,
+			}}},
+			Outputs: v1alpha1.Outputs{Parameters: []v1alpha1.Parameter{{Name: "id", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "$.id"}}}},
+		}
+
+		result := &v1alpha1.NodeResult{}
+		requeue, err := ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeRunning, result.Phase)
+		assert.Equal(t, "Polling "+server.URL+"/jobs/1", result.Message)
+		assert.Equal(t, time.Second, requeue)
+
+		result = &v1alpha1.NodeResult{}
+		requeue, err = ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Empty(t, result.Phase)
+		assert.Equal(t, time.Second, requeue)
+
+		result = &v1alpha1.NodeResult{}
+		requeue, err = ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		assert.Zero(t, requeue)
+		assert.Equal(t, "1", result.Outputs.Parameters[0].Value.String())
+		_, ok := ae.httpJobs.Load("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("PollResumes", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var posts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				posts.Add(1)
+				w.Header().Set("Location", "/jobs/1")
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "done"}`))
+		}))
+		defer server.Close()
+
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: server.URL + "/jobs", Method: http.MethodPost, Async: &v1alpha1.HTTPAsync{
+			TimeoutSeconds: new(int64(3600)),
+			Poll:           &v1alpha1.HTTPPoll{SuccessCondition: `fromJSON(response.body).status == "done"`},
+		}}}
+		result := &v1alpha1.NodeResult{}
+		_, err := newAgent().executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		require.NotNil(t, result.HTTPJob)
+		assert.Equal(t, server.URL+"/jobs/1", result.HTTPJob.PollURL)
+		assert.NotNil(t, result.HTTPJob.Deadline)
+
+		// a restarted agent resumes polling the job from the reported result
+		restarted := newAgent()
+		restarted.resumeHTTPJobs(map[string]v1alpha1.NodeResult{"a": *result})
+		result = &v1alpha1.NodeResult{}
+		_, err = restarted.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		assert.Equal(t, int32(1), posts.Load(), "the request is not sent again")
+	})
+
+	t.Run("PollFailure", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status": "failed"}`))
+		}))
+		defer server.Close()
+
+		ae := newAgent()
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: server.URL, Async: &v1alpha1.HTTPAsync{Poll: &v1alpha1.HTTPPoll{
+			URL:              server.URL + "/status",
+			SuccessCondition: `fromJSON(response.body).status == "done"`,
+			FailureCondition: `fromJSON(response.body).status == "failed"`,
+		}}}}
+		_, err := ae.executeHTTPTemplate(ctx, "a", tmpl, &v1alpha1.NodeResult{})
+		require.NoError(t, err)
+
+		result := &v1alpha1.NodeResult{}
+		_, err = ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+		assert.Equal(t, `failureCondition 'fromJSON(response.body).status == "failed"' evaluated true`, result.Message)
+	})
+
+	t.Run("NoLocation", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		ae := newAgent()
+		_, err := ae.executeHTTPTemplate(ctx, "a", v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: server.URL, Async: &v1alpha1.HTTPAsync{Poll: &v1alpha1.HTTPPoll{SuccessCondition: "true"}}}}, &v1alpha1.NodeResult{})
+		require.ErrorContains(t, err, "poll.url is not specified and the response has no Location header")
+	})
+
+	t.Run("CallbackTimeout", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cleanedUp := make(chan struct{}, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				cleanedUp <- struct{}{}
+			}
+		}))
+		defer server.Close()
+
+		ae := newAgent()
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL:     server.URL,
+			Cleanup: &v1alpha1.HTTPCleanup{Method: http.MethodDelete, URL: server.URL},
+			Async:   &v1alpha1.HTTPAsync{Callback: &v1alpha1.HTTPCallback{}, TimeoutSeconds: new(int64(1))},
+		}}
+		result := &v1alpha1.NodeResult{}
+		requeue, err := ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		// the node is not reported as running, so the agent cannot overwrite the callback's result
+		assert.Empty(t, result.Phase)
+		assert.Positive(t, requeue)
+
+		job, ok := ae.httpJobs.Load("a")
+		require.True(t, ok)
+		job.(*httpJob).deadline = time.Now().Add(-time.Second)
+		_, err = ae.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+		assert.Equal(t, "job did not complete within 1s", result.Message)
+		<-cleanedUp
+	})
+
+	t.Run("CallbackResumes", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		var posts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			posts.Add(1)
+		}))
+		defer server.Close()
+
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: server.URL, Method: http.MethodPost, Async: &v1alpha1.HTTPAsync{
+			Callback:       &v1alpha1.HTTPCallback{},
+			TimeoutSeconds: new(int64(3600)),
+		}}}
+		result := &v1alpha1.NodeResult{}
+		_, err := newAgent().executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Empty(t, result.Phase)
+		require.NotNil(t, result.HTTPJob)
+		assert.Empty(t, result.HTTPJob.PollURL)
+		assert.NotNil(t, result.HTTPJob.Deadline)
+
+		// a restarted agent resumes waiting for the callback from the reported result
+		restarted := newAgent()
+		restarted.resumeHTTPJobs(map[string]v1alpha1.NodeResult{"a": *result})
+		result = &v1alpha1.NodeResult{}
+		requeue, err := restarted.executeHTTPTemplate(ctx, "a", tmpl, result)
+		require.NoError(t, err)
+		assert.Empty(t, result.Phase)
+		assert.Positive(t, requeue)
+		assert.Equal(t, int32(1), posts.Load(), "the request is not sent again")
+	})
+
+	t.Run("CallbackCompleted", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEqual(t, http.MethodDelete, r.Method, "a completed job must not be cleaned up")
+		}))
+		defer server.Close()
+
+		ae := newAgent()
+		tmpl := v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+			URL:     server.URL,
+			Cleanup: &v1alpha1.HTTPCleanup{Method: http.MethodDelete, URL: server.URL},
+			Async:   &v1alpha1.HTTPAsync{Callback: &v1alpha1.HTTPCallback{}},
+		}}
+		ae.startedTasks.Store("a", &startedTask{template: tmpl})
+		_, err := ae.executeHTTPTemplate(ctx, "a", tmpl, &v1alpha1.NodeResult{})
+		require.NoError(t, err)
+
+		// the Argo Server sets the result from the callback, then the controller removes the task
+		ae.forgetCompletedTasks(map[string]v1alpha1.NodeResult{"a": {Phase: v1alpha1.NodeSucceeded}})
+		ae.cancelRemovedTasks(ctx, map[string]v1alpha1.Template{})
+		_, ok := ae.httpJobs.Load("a")
+		assert.False(t, ok)
+	})
+}
+
+func TestHTTPRetryBackoff(t *testing.T) {
+	timeToWait, err := httpRetryBackoff(nil, 3)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, timeToWait)
+
+	backoff := &v1alpha1.Backoff{Duration: "1s", Factor: new(intstr.FromInt32(2)), Cap: "5s"}
+	for retries, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		timeToWait, err = httpRetryBackoff(backoff, retries)
+		require.NoError(t, err)
+		assert.Equal(t, expected, timeToWait)
+	}
+}
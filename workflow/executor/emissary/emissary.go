@@ -72,8 +72,8 @@ func (e emissary) WriteTemplate(t wfv1.Template) error {
 	return os.WriteFile(common.VarRunArgoPath+"/template", data, 0o444) // chmod -r--r--r--
 }
 
-func (e emissary) GetFileContents(_ string, sourcePath string) (string, error) {
-	data, err := os.ReadFile(filepath.Clean(filepath.Join(common.VarRunArgoPath, "outputs", "parameters", sourcePath)))
+func (e emissary) GetFileContents(containerName string, sourcePath string) (string, error) {
+	data, err := os.ReadFile(filepath.Clean(filepath.Join(common.VarRunArgoPath, common.ContainerOutputsDir(containerName), "parameters", sourcePath)))
 	return string(data), err
 }
 
@@ -81,7 +81,7 @@ func (e emissary) CopyFile(ctx context.Context, containerName string, sourcePath
 	// this implementation is very different, because we expect the emissary binary has already compressed the file
 	// so no compression can or needs to be implemented here
 	// TODO - warn the user we ignored compression?
-	sourceFile := filepath.Join(common.VarRunArgoPath, "outputs", "artifacts", strings.TrimSuffix(sourcePath, "/")+".tgz")
+	sourceFile := filepath.Join(common.VarRunArgoPath, common.ContainerOutputsDir(containerName), "artifacts", strings.TrimSuffix(sourcePath, "/")+".tgz")
 	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"source": sourceFile, "dest": destPath}).Info(ctx, "Copying file")
 	src, err := os.Open(filepath.Clean(sourceFile))
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +19,7 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -97,6 +100,9 @@ type WorkflowExecutor struct {
 
 	// flag to indicate if the task result was created
 	taskResultCreated bool
+
+	// the saved outputs of the containers of a container set, keyed by container name
+	containerOutputs map[string]wfv1.Outputs
 }
 
 // Config carries the data values a WorkflowExecutor is constructed from.
@@ -280,6 +286,47 @@ func (we *WorkflowExecutor) loadArtifact(ctx context.Context, pluginName wfv1.Ar
 	if err != nil {
 		return err
 	}
+	// Determine the file path of where to load the artifact
+	var artPath string
+	mnt := common.FindOverlappingVolume(&we.Template, art.Path)
+	if mnt == nil {
+		artPath = path.Join(common.ExecutorArtifactBaseDir, art.Name)
+	} else {
+		// If we get here, it means the input artifact path overlaps with a user-specified
+		// volumeMount in the container. Because we also implement input artifacts as volume
+		// mounts, we need to load the artifact into the user specified volume mount,
+		// as opposed to the `input-artifacts` volume that is an implementation detail
+		// unbeknownst to the user.
+		logger.WithFields(logging.Fields{"path": art.Path, "mountPath": mnt.MountPath}).Info(ctx, "Specified artifact path overlaps with volume mount, extracting to volume mount")
+		artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
+	}
+	if len(art.Shards) > 0 {
+		return we.loadArtifactShards(ctx, pluginName, art, artPath)
+	}
+	return we.loadArtifactTo(ctx, pluginName, art, artPath)
+}
+
+// loadArtifactShards loads each shard of an aggregated artifact, with its own driver, into a sub-directory of
+// artPath named by its index
+func (we *WorkflowExecutor) loadArtifactShards(ctx context.Context, pluginName wfv1.ArtifactPluginName, art wfv1.Artifact, artPath string) error {
+	if err := os.MkdirAll(artPath, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for artifact %s: %w", art.Name, err)
+	}
+	for i, shard := range art.Shards {
+		shard.Optional = art.Optional
+		shard.Mode = art.Mode
+		shard.RecurseMode = art.RecurseMode
+		shard.Stream = false
+		if err := we.loadArtifactTo(ctx, pluginName, shard, path.Join(artPath, strconv.Itoa(i))); err != nil {
+			return fmt.Errorf("failed to load shard %d of artifact %s: %w", i, art.Name, err)
+		}
+	}
+	return nil
+}
+
+// loadArtifactTo loads an artifact to artPath, if it is from the plugin, or not from a plugin if pluginName is empty
+func (we *WorkflowExecutor) loadArtifactTo(ctx context.Context, pluginName wfv1.ArtifactPluginName, art wfv1.Artifact, artPath string) error {
+	logger := logging.RequireLoggerFromContext(ctx)
 	driverArt, err := we.newDriverArt(&art)
 	if err != nil {
 		return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
@@ -303,19 +350,9 @@ func (we *WorkflowExecutor) loadArtifact(ctx context.Context, pluginName wfv1.Ar
 	if err != nil {
 		return err
 	}
-	// Determine the file path of where to load the artifact
-	var artPath string
-	mnt := common.FindOverlappingVolume(&we.Template, art.Path)
-	if mnt == nil {
-		artPath = path.Join(common.ExecutorArtifactBaseDir, art.Name)
-	} else {
-		// If we get here, it means the input artifact path overlaps with a user-specified
-		// volumeMount in the container. Because we also implement input artifacts as volume
-		// mounts, we need to load the artifact into the user specified volume mount,
-		// as opposed to the `input-artifacts` volume that is an implementation detail
-		// unbeknownst to the user.
-		logger.WithFields(logging.Fields{"path": art.Path, "mountPath": mnt.MountPath}).Info(ctx, "Specified artifact path overlaps with volume mount, extracting to volume mount")
-		artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
+
+	if art.Stream {
+		return we.loadStreamArtifact(ctx, &art, driverArt, artPath)
 	}
 
 	// The artifact is downloaded to a temporary location, after which we determine if
@@ -464,9 +501,13 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 
 	var aggregateError strings.Builder
 	for _, art := range we.Template.Outputs.Artifacts {
+		if art.Stream {
+			// streams are uploaded as they are written
+			continue
+		}
 		span.AddEvent("upload artifact",
 			trace.WithAttributes(attribute.KeyValue{Key: "file", Value: attribute.StringValue(art.Name)}))
-		saved, err := we.saveArtifact(ctx, common.MainContainerName, &art)
+		saved, err := we.saveArtifact(ctx, common.MainContainerName, "", &art)
 		if err != nil {
 			aggregateError.WriteString(err.Error())
 			aggregateError.WriteString("; ")
@@ -481,9 +522,9 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 	return artifacts, errors.New(aggregateError.String())
 }
 
-// save artifact
+// save artifact, keyed under keyDir within the archive location if the artifact has no key of its own
 // return whether artifact was in fact saved, and if there was an error
-func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName string, art *wfv1.Artifact) (bool, error) {
+func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName, keyDir string, art *wfv1.Artifact) (bool, error) {
 	logger := logging.RequireLoggerFromContext(ctx)
 	// Determine the file path of where to find the artifact
 	err := art.CleanPath()
@@ -508,12 +549,14 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if size == 0 {
 		logger.WithField("path", localArtPath).Warn(ctx, "The file is empty. It may not be uploaded successfully depending on the artifact driver")
 	}
-	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
+	err = we.saveArtifactFromFile(ctx, art, path.Join(keyDir, fileName), localArtPath)
 	return err == nil, err
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
+	// only artifacts keyed by the artifact repository can be content-addressed, user-specified keys are always honoured
+	contentAddressed := false
 	if !art.HasKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
@@ -529,6 +572,7 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 		if err := art.SetKey(path.Join(key, fileName)); err != nil {
 			return err
 		}
+		contentAddressed = we.Template.ArchiveLocation.IsContentAddressed()
 	}
 	driverArt, err := we.newDriverArt(art)
 	if err != nil {
@@ -538,15 +582,210 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	if contentAddressed && driverArt.IsEncrypted() {
+		// identical artifacts of namespaces with different keys cannot share an object
+		logging.RequireLoggerFromContext(ctx).WithField("name", art.Name).Info(ctx, "Encrypted artifacts are not content-addressed")
+		contentAddressed = false
+	}
+	if contentAddressed {
+		exists, err := we.contentAddressArtifact(ctx, artDriver, art, driverArt, fileName, localArtPath)
+		if err != nil {
+			return err
+		}
+		if exists {
+			we.maybeDeleteLocalArtPath(ctx, localArtPath)
+			logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"path": localArtPath, "digest": art.Digest}).Info(ctx, "Identical artifact already exists, skipping upload")
+			return nil
+		}
+	}
 	err = artDriver.Save(ctx, localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	if driverArt.OCI != nil && art.OCI != nil {
+		// pins the output artifact to the digest of the manifest that was pushed
+		art.OCI.Digest = driverArt.OCI.Digest
+	}
 	we.maybeDeleteLocalArtPath(ctx, localArtPath)
 	logging.RequireLoggerFromContext(ctx).WithField("path", localArtPath).Info(ctx, "Successfully saved file")
 	return nil
 }
 
+// contentAddressArtifact re-keys the artifact (and its driver copy) by the digest of the staged file,
+// returning true if an identical object is already stored in the repository.
+// Directories are left as they are, as they have no single digest; archive them to deduplicate them.
+// The workflow's reference to the object is recorded before checking for it, so that artifact GC of another
+// workflow, which only deletes the object once no workflow references it, does not delete an object this workflow
+// is about to reuse.
+func (we *WorkflowExecutor) contentAddressArtifact(ctx context.Context, artDriver artifactcommon.ArtifactDriver, art, driverArt *wfv1.Artifact, fileName, localArtPath string) (bool, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	isDir, err := file.IsDirectory(localArtPath)
+	if err != nil {
+		return false, argoerrs.InternalWrapError(err)
+	}
+	if isDir {
+		logger.WithField("name", art.Name).Info(ctx, "Directory artifacts are not content-addressed")
+		return false, nil
+	}
+	digest, err := artifactDigest(art, path.Base(fileName), localArtPath)
+	if err != nil {
+		return false, err
+	}
+	dir := we.Template.ArchiveLocation.ContentAddressed.DigestKey(digest)
+	key := path.Join(dir, fileName)
+	for _, a := range []*wfv1.Artifact{art, driverArt} {
+		if err := a.SetKey(key); err != nil {
+			return false, err
+		}
+		a.Digest = digest
+	}
+	if err := we.saveContentAddressedRef(ctx, artDriver, driverArt); err != nil {
+		return false, err
+	}
+	listArt := driverArt.DeepCopy()
+	if err := listArt.SetKey(dir); err != nil {
+		return false, err
+	}
+	keys, err := artDriver.ListObjects(ctx, listArt)
+	if err != nil {
+		// not every driver can list objects, so we fall back to uploading, which is always correct
+		logger.WithField("key", key).WithError(err).Warn(ctx, "Unable to check for an existing content-addressed artifact")
+		return false, nil
+	}
+	return slices.Contains(keys, key), nil
+}
+
+// saveContentAddressedRef records that this workflow references the content-addressed artifact
+func (we *WorkflowExecutor) saveContentAddressedRef(ctx context.Context, artDriver artifactcommon.ArtifactDriver, driverArt *wfv1.Artifact) error {
+	key, err := driverArt.GetKey()
+	if err != nil {
+		return err
+	}
+	refsKey, err := wfv1.ContentAddressedRefsKey(key, driverArt.Digest)
+	if err != nil {
+		return err
+	}
+	refArt := driverArt.DeepCopy()
+	if err := refArt.SetKey(path.Join(refsKey, string(we.workflowUID))); err != nil {
+		return err
+	}
+	// the reference holds the key of the object, as some drivers do not save empty files
+	f, err := os.CreateTemp("", "ref-")
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	_, err = f.WriteString(key)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	return artDriver.Save(ctx, f.Name(), refArt)
+}
+
+// artifactDigest returns the digest of the content of the staged artifact. Archives staged by the executor are
+// digested by the files they contain rather than their bytes, which include the modification times of the files, so
+// that archives of identical files have the same digest.
+func artifactDigest(art *wfv1.Artifact, fileName, localArtPath string) (string, error) {
+	switch {
+	case fileName == art.Name+".tgz" && (art.Archive == nil || art.Archive.Tar != nil):
+		return tarGzDigest(localArtPath)
+	case fileName == art.Name+".zip" && art.Archive != nil && art.Archive.Zip != nil:
+		return zipDigest(localArtPath)
+	default:
+		return fileDigest(localArtPath)
+	}
+}
+
+// treeEntry is a file of an archive, as digested by treeDigest
+type treeEntry struct {
+	name     string
+	mode     fs.FileMode
+	linkname string
+	sum      []byte
+}
+
+// treeDigest returns the sha256 digest of the files sorted by path, in the form `sha256:<hex>`
+func treeDigest(entries []treeEntry) string {
+	slices.SortFunc(entries, func(a, b treeEntry) int { return strings.Compare(a.name, b.name) })
+	h := sha256.New()
+	for _, e := range entries {
+		_, _ = fmt.Fprintf(h, "%s\x00%o\x00%s\x00%x\n", e.name, e.mode, e.linkname, e.sum)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// tarGzDigest returns the tree digest of the files in the tgz
+func tarGzDigest(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", argoerrs.InternalWrapError(err)
+	}
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return "", argoerrs.InternalWrapError(err)
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	var entries []treeEntry
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", argoerrs.InternalWrapError(err)
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, tr); err != nil {
+			return "", argoerrs.InternalWrapError(err)
+		}
+		entries = append(entries, treeEntry{name: header.Name, mode: header.FileInfo().Mode(), linkname: header.Linkname, sum: h.Sum(nil)})
+	}
+	return treeDigest(entries), nil
+}
+
+// zipDigest returns the tree digest of the files in the zip
+func zipDigest(filePath string) (string, error) {
+	zr, err := zip.OpenReader(filePath)
+	if err != nil {
+		return "", argoerrs.InternalWrapError(err)
+	}
+	defer zr.Close()
+	entries := make([]treeEntry, len(zr.File))
+	for i, zf := range zr.File {
+		rc, err := zf.Open()
+		if err != nil {
+			return "", argoerrs.InternalWrapError(err)
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, rc)
+		_ = rc.Close()
+		if err != nil {
+			return "", argoerrs.InternalWrapError(err)
+		}
+		entries[i] = treeEntry{name: zf.Name, mode: zf.Mode(), sum: h.Sum(nil)}
+	}
+	return treeDigest(entries), nil
+}
+
+// fileDigest returns the sha256 digest of the file, in the form `sha256:<hex>`
+func fileDigest(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", argoerrs.InternalWrapError(err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", argoerrs.InternalWrapError(err)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func (we *WorkflowExecutor) maybeDeleteLocalArtPath(ctx context.Context, localArtPath string) {
 	if we.removeLocalArtPath {
 		logger := logging.RequireLoggerFromContext(ctx)
@@ -742,7 +981,13 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		return nil
 	}
 	logger.Info(ctx, "Saving output parameters")
-	for i, param := range we.Template.Outputs.Parameters {
+	return we.saveParameters(ctx, common.MainContainerName, we.Template.Outputs.Parameters)
+}
+
+// saveParameters sets the value of each of the output parameters of the container from the file at its path
+func (we *WorkflowExecutor) saveParameters(ctx context.Context, containerName string, params []wfv1.Parameter) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	for i, param := range params {
 		logger.WithField("name", param.Name).Info(ctx, "Saving path output parameter")
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -752,7 +997,7 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		var output *wfv1.AnyString
 		if we.isBaseImagePath(param.ValueFrom.Path) {
 			logger.WithField("path", param.ValueFrom.Path).Info(ctx, "Copying from base image layer")
-			fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, param.ValueFrom.Path)
+			fileContents, err := we.RuntimeExecutor.GetFileContents(containerName, param.ValueFrom.Path)
 			if err != nil {
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default == nil {
@@ -779,12 +1024,51 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 
 		// Trims off a single newline for user convenience
 		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
-		we.Template.Outputs.Parameters[i].Value = output
+		params[i].Value = output
 		logger.WithField("name", param.Name).Info(ctx, "Successfully saved output parameter")
 	}
 	return nil
 }
 
+// SaveContainerOutputs saves the output parameters and artifacts of each container of a container set that has
+// outputs of its own. The artifacts of a container are keyed under its name, so they do not collide with those of the
+// template or of other containers.
+func (we *WorkflowExecutor) SaveContainerOutputs(ctx context.Context) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	var aggregateError strings.Builder
+	for _, ctr := range we.Template.ContainerSet.GetGraph() {
+		if ctr.Outputs == nil {
+			continue
+		}
+		logger.WithField("containerName", ctr.Name).Info(ctx, "Saving container outputs")
+		outputs := ctr.Outputs.DeepCopy()
+		if err := we.saveParameters(ctx, ctr.Name, outputs.Parameters); err != nil {
+			aggregateError.WriteString(err.Error())
+			aggregateError.WriteString("; ")
+		}
+		artifacts := wfv1.Artifacts{}
+		for _, art := range outputs.Artifacts {
+			saved, err := we.saveArtifact(ctx, ctr.Name, ctr.Name, &art)
+			if err != nil {
+				aggregateError.WriteString(err.Error())
+				aggregateError.WriteString("; ")
+			}
+			if saved {
+				artifacts = append(artifacts, art)
+			}
+		}
+		outputs.Artifacts = artifacts
+		if we.containerOutputs == nil {
+			we.containerOutputs = make(map[string]wfv1.Outputs)
+		}
+		we.containerOutputs[ctr.Name] = *outputs
+	}
+	if aggregateError.Len() == 0 {
+		return nil
+	}
+	return errors.New(aggregateError.String())
+}
+
 func (we *WorkflowExecutor) SaveLogs(ctx context.Context) []wfv1.Artifact {
 	ctx, span := we.Tracing.StartSaveLogs(ctx)
 	defer span.End()
@@ -1026,7 +1310,7 @@ func (we *WorkflowExecutor) InitializeOutput(ctx context.Context) {
 func (we *WorkflowExecutor) ReportOutputs(ctx context.Context, artifacts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
 	outputs.Artifacts = artifacts
-	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs})
+	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs, ContainerOutputs: we.containerOutputs})
 }
 
 // ReportOutputsLogs updates the WorkflowTaskResult log fields
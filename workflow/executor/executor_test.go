@@ -4,12 +4,14 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -23,7 +25,9 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v4/util/archive"
 	"github.com/argoproj/argo-workflows/v4/util/logging"
+	artifactcommon "github.com/argoproj/argo-workflows/v4/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v4/workflow/common"
 	"github.com/argoproj/argo-workflows/v4/workflow/executor/mocks"
 	"github.com/argoproj/argo-workflows/v4/workflow/executor/tracing"
@@ -94,6 +98,30 @@ func TestWorkflowExecutor_LoadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutor_LoadArtifactShards(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tracing, err := tracing.New(ctx, `argoexec`)
+	require.NoError(t, err)
+	we := WorkflowExecutor{Tracing: tracing}
+	art := wfv1.Artifact{
+		Name: "shards",
+		Path: "/tmp/shards",
+		Shards: []wfv1.Artifact{
+			{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "a"}}},
+			{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "b"}}},
+		},
+	}
+	dir := filepath.Join(t.TempDir(), "shards")
+
+	err = we.loadArtifactShards(ctx, "", art, dir)
+	require.NoError(t, err)
+	for i, data := range []string{"a", "b"} {
+		content, err := os.ReadFile(filepath.Join(dir, fmt.Sprint(i)))
+		require.NoError(t, err)
+		assert.Equal(t, data, string(content))
+	}
+}
+
 func TestSaveParameters(t *testing.T) {
 	fakeClientset := fake.NewClientset()
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -124,6 +152,45 @@ func TestSaveParameters(t *testing.T) {
 	assert.Equal(t, "has a newline", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestSaveContainerOutputs(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockTaskResultClient := argofake.NewClientset().ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace)
+	ctx := logging.TestContext(t.Context())
+	tracing, err := tracing.New(ctx, `argoexec`)
+	require.NoError(t, err)
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			ContainerSet: &wfv1.ContainerSetTemplate{
+				Containers: []wfv1.ContainerNode{
+					{
+						Container: corev1.Container{Name: "a"},
+						Outputs: &wfv1.Outputs{
+							Parameters: []wfv1.Parameter{{Name: "my-out", ValueFrom: &wfv1.ValueFrom{Path: "/path"}}},
+						},
+					},
+					{Container: corev1.Container{Name: "b"}},
+				},
+			},
+		},
+		RuntimeExecutor:  &mockRuntimeExecutor,
+		taskResultClient: mockTaskResultClient,
+		Tracing:          tracing,
+		nodeID:           fakeNodeID,
+	}
+	mockRuntimeExecutor.On("GetFileContents", "a", "/path").Return("hello\n", nil)
+
+	err = we.SaveContainerOutputs(ctx)
+	require.NoError(t, err)
+	err = we.ReportOutputs(ctx, nil)
+	require.NoError(t, err)
+
+	taskResult, err := mockTaskResultClient.Get(ctx, fakeNodeID, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, taskResult.ContainerOutputs, 1)
+	assert.Equal(t, "hello", taskResult.ContainerOutputs["a"].Parameters[0].Value.String())
+	assert.Nil(t, we.Template.ContainerSet.Containers[0].Outputs.Parameters[0].Value, "the template is unchanged")
+}
+
 // TestIsBaseImagePath tests logic of isBaseImagePath which determines if a path is coming from a
 // base image layer versus a shared volumeMount.
 func TestIsBaseImagePath(t *testing.T) {
@@ -884,3 +951,148 @@ func TestWaitMainContainers(t *testing.T) {
 		rt.AssertExpectations(t)
 	})
 }
+
+func TestFileDigest(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "my-file")
+	require.NoError(t, os.WriteFile(f, []byte("hello world"), 0o600))
+	digest, err := fileDigest(f)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", digest)
+
+	_, err = fileDigest(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestArtifactDigest(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b"), 0o600))
+	tarGz := func(name string) string {
+		f, err := os.Create(filepath.Join(t.TempDir(), name))
+		require.NoError(t, err)
+		defer f.Close()
+		require.NoError(t, archive.TarGzToWriter(ctx, dir, gzip.DefaultCompression, f))
+		return f.Name()
+	}
+	zipped := func(name string) string {
+		f, err := os.Create(filepath.Join(t.TempDir(), name))
+		require.NoError(t, err)
+		defer f.Close()
+		zw := zip.NewWriter(f)
+		require.NoError(t, archive.ZipToWriter(ctx, dir, zw))
+		require.NoError(t, zw.Close())
+		return f.Name()
+	}
+	art := &wfv1.Artifact{Name: "my-art"}
+
+	t.Run("TarGz", func(t *testing.T) {
+		first := tarGz("my-art.tgz")
+		// archives of the same files record their modification times, so their bytes differ
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "a.txt"), later, later))
+		second := tarGz("my-art.tgz")
+		firstDigest, err := artifactDigest(art, "my-art.tgz", first)
+		require.NoError(t, err)
+		secondDigest, err := artifactDigest(art, "my-art.tgz", second)
+		require.NoError(t, err)
+		assert.Equal(t, firstDigest, secondDigest)
+		fileDigest, err := fileDigest(first)
+		require.NoError(t, err)
+		assert.NotEqual(t, fileDigest, firstDigest)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("c"), 0o600))
+		changedDigest, err := artifactDigest(art, "my-art.tgz", tarGz("my-art.tgz"))
+		require.NoError(t, err)
+		assert.NotEqual(t, firstDigest, changedDigest)
+	})
+	t.Run("Zip", func(t *testing.T) {
+		zipArt := &wfv1.Artifact{Name: "my-art", Archive: &wfv1.ArchiveStrategy{Zip: &wfv1.ZipStrategy{}}}
+		first, err := artifactDigest(zipArt, "my-art.zip", zipped("my-art.zip"))
+		require.NoError(t, err)
+		later := time.Now().Add(2 * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "a.txt"), later, later))
+		second, err := artifactDigest(zipArt, "my-art.zip", zipped("my-art.zip"))
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+	})
+	t.Run("File", func(t *testing.T) {
+		digest, err := artifactDigest(art, "a.txt", filepath.Join(dir, "a.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb", digest)
+	})
+}
+
+// fakeArtifactDriver stores artifacts in memory, keyed by their S3 key, recording the calls made to it
+type fakeArtifactDriver struct {
+	artifactcommon.ArtifactDriver
+	objects map[string]string
+	calls   []string
+}
+
+func (d *fakeArtifactDriver) Save(_ context.Context, path string, art *wfv1.Artifact) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d.calls = append(d.calls, "Save "+art.S3.Key)
+	d.objects[art.S3.Key] = string(data)
+	return nil
+}
+
+func (d *fakeArtifactDriver) ListObjects(_ context.Context, art *wfv1.Artifact) ([]string, error) {
+	d.calls = append(d.calls, "ListObjects "+art.S3.Key)
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, art.S3.Key+"/") {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func TestContentAddressArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	localArtPath := filepath.Join(t.TempDir(), "my-art.txt")
+	require.NoError(t, os.WriteFile(localArtPath, []byte("a"), 0o600))
+	const digest = "sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	const key = "cas/sha256/ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb/my-art.txt"
+	const refKey = "cas/sha256/ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb/.refs/my-art.txt/" + fakeWorkflowUID
+	we := &WorkflowExecutor{
+		workflowUID: fakeWorkflowUID,
+		Template: wfv1.Template{ArchiveLocation: &wfv1.ArtifactLocation{
+			S3:               &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod"},
+			ContentAddressed: &wfv1.ContentAddressedStorage{},
+		}},
+	}
+	newArtifacts := func() (*wfv1.Artifact, *wfv1.Artifact) {
+		art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-pod/my-art.txt"}}}
+		driverArt, err := we.newDriverArt(art)
+		require.NoError(t, err)
+		return art, driverArt
+	}
+
+	t.Run("Upload", func(t *testing.T) {
+		driver := &fakeArtifactDriver{objects: map[string]string{}}
+		art, driverArt := newArtifacts()
+		exists, err := we.contentAddressArtifact(ctx, driver, art, driverArt, "my-art.txt", localArtPath)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.Equal(t, key, art.S3.Key)
+		assert.Equal(t, digest, art.Digest)
+		assert.Equal(t, key, driverArt.S3.Key)
+		assert.Equal(t, map[string]string{refKey: key}, driver.objects)
+	})
+	t.Run("SkipUpload", func(t *testing.T) {
+		driver := &fakeArtifactDriver{objects: map[string]string{key: "a"}}
+		art, driverArt := newArtifacts()
+		exists, err := we.contentAddressArtifact(ctx, driver, art, driverArt, "my-art.txt", localArtPath)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, key, art.S3.Key)
+		assert.Equal(t, digest, art.Digest)
+		// the reference is recorded before checking for the object, so artifact GC does not delete it
+		assert.Equal(t, []string{"Save " + refKey, "ListObjects " + filepath.Dir(key)}, driver.calls)
+	})
+}
@@ -0,0 +1,23 @@
+//go:build linux || darwin
+
+package osspecific
+
+import (
+	"os"
+	"syscall"
+)
+
+// Mkfifo creates a named pipe
+func Mkfifo(path string, mode uint32) error {
+	return syscall.Mkfifo(path, mode)
+}
+
+// UnblockFifoWriter opens the named pipe for reading without waiting for a writer, so that a writer blocked opening it
+// is released. The writer's writes then fail, as the pipe is closed again immediately.
+func UnblockFifoWriter(path string) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
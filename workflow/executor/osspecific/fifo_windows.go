@@ -0,0 +1,14 @@
+package osspecific
+
+import "errors"
+
+// Mkfifo creates a named pipe
+func Mkfifo(path string, mode uint32) error {
+	return errors.New("named pipes are not supported on Windows")
+}
+
+// UnblockFifoWriter opens the named pipe for reading without waiting for a writer, so that a writer blocked opening it
+// is released.
+func UnblockFifoWriter(path string) error {
+	return errors.New("named pipes are not supported on Windows")
+}
@@ -24,3 +24,11 @@ func New(address, token string) executorplugins.TemplateExecutor {
 func (p *plugin) ExecuteTemplate(ctx context.Context, args executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
 	return p.Call(ctx, "template.execute", args, reply)
 }
+
+func (p *plugin) CancelTemplate(ctx context.Context, args executorplugins.CancelTemplateArgs, reply *executorplugins.CancelTemplateReply) error {
+	return p.Call(ctx, "template.cancel", args, reply)
+}
+
+func (p *plugin) Health(ctx context.Context, args executorplugins.HealthArgs, reply *executorplugins.HealthReply) error {
+	return p.Probe(ctx, "plugin.health", args, reply)
+}
@@ -8,9 +8,10 @@ import (
 
 // PostMain drives the phase after the user's main container has started:
 // wait for main to exit, then capture script result, output parameters,
-// output artifacts and logs, and report outputs. It is shared between
-// the `argoexec wait` command (legacy pod layout) and the new
-// `argoexec supervisor` command (init-less pod layout).
+// output artifacts, the outputs of the containers of a container set and
+// logs, and report outputs. It is shared between the `argoexec wait`
+// command (legacy pod layout) and the new `argoexec supervisor` command
+// (init-less pod layout).
 //
 // If preMainFailed is true, the supervisor's pre-main phase failed (or the
 // ready marker could not be written). In that case the user's main never
@@ -27,10 +28,14 @@ import (
 func (we *WorkflowExecutor) PostMain(ctx, bgCtx context.Context, preMainFailed bool) error {
 	we.InitializeOutput(bgCtx)
 
+	streams := we.startStreams(bgCtx)
+
 	if err := we.Wait(ctx); err != nil {
 		we.AddError(ctx, err)
 	}
 
+	streamArtifacts := streams.finish(bgCtx)
+
 	if we.Template.Resource != nil {
 		if err := we.ReportOutputsLogs(bgCtx); err != nil {
 			we.AddError(ctx, err)
@@ -53,8 +58,14 @@ func (we *WorkflowExecutor) PostMain(ctx, bgCtx context.Context, preMainFailed b
 		if err != nil {
 			we.AddError(ctx, err)
 		}
+
+		if err := we.SaveContainerOutputs(bgCtx); err != nil {
+			we.AddError(ctx, err)
+		}
 	}
 
+	artifacts = append(artifacts, streamArtifacts...)
+
 	// Save log artifacts (still useful even when pre-main failed — main's
 	// stdout/stderr contains the emissary's "supervisor pre-main failed"
 	// message).
@@ -0,0 +1,251 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	argoerrs "github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	"github.com/argoproj/argo-workflows/v4/workflow/artifacts/stream"
+	"github.com/argoproj/argo-workflows/v4/workflow/common"
+	"github.com/argoproj/argo-workflows/v4/workflow/executor/osspecific"
+)
+
+// streams uploads the stream output artifacts of the main container as they are written,
+// and feeds its stream input artifacts into their named pipes, while it runs
+type streams struct {
+	done      chan struct{}
+	cancel    context.CancelFunc
+	pipes     []string
+	outputs   wfv1.Artifacts
+	wg        sync.WaitGroup
+	mu        sync.Mutex
+	finishing bool
+}
+
+// startStreams starts streaming the template's stream artifacts, and reports the stream output artifacts
+// so that dependent tasks can read them while the main container is still writing them
+func (we *WorkflowExecutor) startStreams(ctx context.Context) *streams {
+	logger := logging.RequireLoggerFromContext(ctx)
+	// pipes are fed until the main container completes, whereas uploads continue until the remainder is uploaded
+	feedCtx, cancel := context.WithCancel(ctx)
+	s := &streams{done: make(chan struct{}), cancel: cancel}
+	for _, art := range we.Template.Inputs.Artifacts {
+		if !art.Stream || !isStreamPipe(&we.Template, &art) {
+			continue
+		}
+		pipe := filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
+		s.pipes = append(s.pipes, pipe)
+		s.wg.Go(func() {
+			// the main container may stop reading, closing the pipe, before the stream ends
+			if err := we.feedStream(feedCtx, &art, pipe); err != nil && !errors.Is(err, syscall.EPIPE) && !s.isFinishing() {
+				we.AddError(ctx, err)
+			}
+		})
+	}
+	for _, art := range we.Template.Outputs.Artifacts {
+		if !art.Stream {
+			continue
+		}
+		w, err := we.newStreamWriter(ctx, &art)
+		if err != nil {
+			we.AddError(ctx, err)
+			continue
+		}
+		s.outputs = append(s.outputs, art)
+		s.wg.Go(func() {
+			if err := we.uploadStream(ctx, w, &art, s.done); err != nil {
+				we.AddError(ctx, err)
+			}
+		})
+	}
+	if len(s.outputs) > 0 {
+		logger.WithField("artifacts", len(s.outputs)).Info(ctx, "Reporting stream output artifacts")
+		if err := we.reportResult(ctx, wfv1.NodeResult{Outputs: &wfv1.Outputs{Artifacts: s.outputs}}); err != nil {
+			we.AddError(ctx, err)
+		}
+	}
+	return s
+}
+
+func (s *streams) isFinishing() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.finishing
+}
+
+// finish uploads the remainder of the stream output artifacts, now the main container has completed, and returns them
+func (s *streams) finish(ctx context.Context) wfv1.Artifacts {
+	s.mu.Lock()
+	s.finishing = true
+	s.mu.Unlock()
+	close(s.done)
+	// the main container no longer reads its pipes
+	s.cancel()
+	for _, pipe := range s.pipes {
+		if err := osspecific.UnblockFifoWriter(pipe); err != nil {
+			logging.RequireLoggerFromContext(ctx).WithError(err).WithField("pipe", pipe).Debug(ctx, "Failed to unblock stream pipe")
+		}
+	}
+	s.wg.Wait()
+	return s.outputs
+}
+
+// isStreamPipe returns whether a stream input artifact is read through a named pipe, which is only possible on a volume
+// mount shared with the wait container. Otherwise, the whole stream is loaded before the main container starts.
+func isStreamPipe(tmpl *wfv1.Template, art *wfv1.Artifact) bool {
+	return common.FindOverlappingVolume(tmpl, art.Path) != nil
+}
+
+// loadStreamArtifact creates the named pipe through which the stream will be read, or loads the whole stream
+func (we *WorkflowExecutor) loadStreamArtifact(ctx context.Context, art, driverArt *wfv1.Artifact, artPath string) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if err := os.MkdirAll(filepath.Dir(artPath), 0o755); err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	if isStreamPipe(&we.Template, art) {
+		logger.WithField("path", artPath).Info(ctx, "Creating named pipe for stream artifact")
+		mode := uint32(0o644)
+		if art.Mode != nil {
+			mode = uint32(*art.Mode)
+		}
+		return osspecific.Mkfifo(artPath, mode)
+	}
+	logger.WithField("path", artPath).Info(ctx, "Stream artifact is not on a volume mount, waiting for the whole stream")
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(artPath)
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	defer f.Close()
+	r := stream.NewReader(ctx, artDriver, driverArt)
+	defer r.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	if art.Mode != nil {
+		return chmod(artPath, *art.Mode, false)
+	}
+	return nil
+}
+
+// feedStream copies the stream into the named pipe, once the main container opens it
+func (we *WorkflowExecutor) feedStream(ctx context.Context, art *wfv1.Artifact, pipe string) error {
+	driverArt, err := we.newDriverArt(art)
+	if err != nil {
+		return err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	// blocks until the main container opens the pipe for reading
+	f, err := os.OpenFile(pipe, os.O_WRONLY, 0)
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	defer f.Close()
+	r := stream.NewReader(ctx, artDriver, driverArt)
+	defer r.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// newStreamWriter keys the stream output artifact in the archive location, unless it already has a key,
+// and returns a writer of the stream
+func (we *WorkflowExecutor) newStreamWriter(ctx context.Context, art *wfv1.Artifact) (*stream.Writer, error) {
+	if !art.HasKey() {
+		key, err := we.Template.ArchiveLocation.GetKey()
+		if err != nil {
+			return nil, err
+		}
+		artLocation, err := we.Template.ArchiveLocation.Get()
+		if err != nil {
+			return nil, err
+		}
+		if err := art.SetType(artLocation); err != nil {
+			return nil, err
+		}
+		if err := art.SetKey(path.Join(key, art.Name+".stream")); err != nil {
+			return nil, err
+		}
+	}
+	driverArt, err := we.newDriverArt(art)
+	if err != nil {
+		return nil, err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return nil, err
+	}
+	return stream.NewWriter(ctx, artDriver, driverArt), nil
+}
+
+// uploadStream uploads what has been written to the artifact's path every stream.PollInterval, until done is closed,
+// then uploads the remainder and marks the stream complete
+func (we *WorkflowExecutor) uploadStream(ctx context.Context, w *stream.Writer, art *wfv1.Artifact, done <-chan struct{}) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	localPath := filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
+	ticker := time.NewTicker(stream.PollInterval)
+	defer ticker.Stop()
+	var f *os.File
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+		}
+	}()
+	abort := func(err error) error {
+		if abortErr := w.Abort(); abortErr != nil {
+			logger.WithError(abortErr).WithField("name", art.Name).Warn(ctx, "Failed to abort stream")
+		}
+		return err
+	}
+	for {
+		finished := false
+		select {
+		case <-done:
+			finished = true
+		case <-ticker.C:
+		}
+		if f == nil {
+			var err error
+			f, err = os.Open(localPath)
+			if errors.Is(err, fs.ErrNotExist) {
+				if !finished {
+					continue
+				}
+				if art.Optional {
+					logger.WithField("name", art.Name).Warn(ctx, "Ignoring optional stream artifact which does not exist in path")
+					return w.Close()
+				}
+				return abort(argoerrs.Errorf(argoerrs.CodeNotFound, "%s no such file or directory", art.Path))
+			}
+			if err != nil {
+				return abort(argoerrs.InternalWrapError(err))
+			}
+		}
+		// reads up to what has been written so far, and continues from there next time
+		if _, err := io.Copy(w, f); err != nil {
+			return abort(argoerrs.InternalWrapError(err))
+		}
+		if err := w.Flush(); err != nil {
+			return abort(err)
+		}
+		if finished {
+			logger.WithField("name", art.Name).Info(ctx, "Successfully streamed artifact")
+			return w.Close()
+		}
+	}
+}
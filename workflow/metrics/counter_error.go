@@ -12,6 +12,7 @@ const (
 	ErrorCauseOperationPanic              ErrorCause = "OperationPanic"
 	ErrorCauseCronWorkflowSubmissionError ErrorCause = "CronWorkflowSubmissionError"
 	ErrorCauseCronWorkflowSpecError       ErrorCause = "CronWorkflowSpecError"
+	ErrorCauseArtifactLineageError        ErrorCause = "ArtifactLineageError"
 )
 
 func addErrorCounter(ctx context.Context, m *Metrics) error {
@@ -20,7 +21,7 @@ func addErrorCounter(ctx context.Context, m *Metrics) error {
 		return err
 	}
 	// Initialise all values to zero
-	for _, cause := range []ErrorCause{ErrorCauseOperationPanic, ErrorCauseCronWorkflowSubmissionError, ErrorCauseCronWorkflowSpecError} {
+	for _, cause := range []ErrorCause{ErrorCauseOperationPanic, ErrorCauseCronWorkflowSubmissionError, ErrorCauseCronWorkflowSpecError, ErrorCauseArtifactLineageError} {
 		m.AddErrorCount(ctx, 0, string(cause))
 	}
 	return nil
@@ -37,3 +38,7 @@ func (m *Metrics) CronWorkflowSubmissionError(ctx context.Context) {
 func (m *Metrics) CronWorkflowSpecError(ctx context.Context) {
 	m.AddErrorCount(ctx, 1, string(ErrorCauseCronWorkflowSpecError))
 }
+
+func (m *Metrics) ArtifactLineageError(ctx context.Context) {
+	m.AddErrorCount(ctx, 1, string(ErrorCauseArtifactLineageError))
+}
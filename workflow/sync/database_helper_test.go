@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -36,6 +37,8 @@ func createTestDBSession(ctx context.Context, t *testing.T, dbType sqldb.DBType)
 		cfg, termContainerFn, err = setupPostgresContainer(ctx, t)
 	case sqldb.MySQL:
 		cfg, termContainerFn, err = setupMySQLContainer(ctx, t)
+	case sqldb.SQLite:
+		cfg, termContainerFn = setupSQLiteDB(t)
 	}
 	if err != nil {
 		t.Fatalf("failed to start container: %s", err)
@@ -167,3 +170,16 @@ func setupMySQLContainer(ctx context.Context, t *testing.T) (config.SyncConfig,
 
 	return cfg, termContainerFn, nil
 }
+
+// setupSQLiteDB sets up a SQLite database in a temporary file and returns the config and cleanup function
+func setupSQLiteDB(t *testing.T) (config.SyncConfig, func()) {
+	cfg := config.SyncConfig{
+		ControllerName: "test1",
+		DBConfig: config.DBConfig{
+			SQLite: &config.SQLiteConfig{
+				Path: filepath.Join(t.TempDir(), "sync.db"),
+			},
+		},
+	}
+	return cfg, func() {}
+}
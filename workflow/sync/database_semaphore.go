@@ -114,7 +114,17 @@ func (s *databaseSemaphore) currentHoldersSession(ctx context.Context, sessionPr
 	return s.currentState(ctx, sessionProxy, true)
 }
 
+// serializedByTransaction is whether the database serializes the transactions which acquire locks by itself. SQLite
+// allows a single writer, which the transaction takes when it begins, so the lock table is not needed to coordinate
+// controllers. It must not be used either, as writing it outside the transaction would wait for the transaction.
+func (s *databaseSemaphore) serializedByTransaction() bool {
+	return s.info.SessionProxy.DBType() == sqldb.SQLite
+}
+
 func (s *databaseSemaphore) lock(ctx context.Context) bool {
+	if s.serializedByTransaction() {
+		return true
+	}
 	logger := s.logger(ctx)
 	// Check if lock already exists, in case we crashed and restarted
 	existingLocks, err := s.queries.GetExistingLocks(ctx, s.longDBKey(), s.info.Config.ControllerName)
@@ -135,6 +145,9 @@ func (s *databaseSemaphore) lock(ctx context.Context) bool {
 }
 
 func (s *databaseSemaphore) unlock(ctx context.Context) {
+	if s.serializedByTransaction() {
+		return
+	}
 	for {
 		err := s.queries.DeleteLock(ctx, s.longDBKey())
 		if err == nil {
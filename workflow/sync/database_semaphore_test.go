@@ -20,10 +20,10 @@ var testDBTypes []sqldb.DBType
 func init() {
 	switch runtime.GOOS {
 	case "windows":
-		// Can't test these on windows
-		testDBTypes = []sqldb.DBType{}
+		// Can't test these on windows, apart from SQLite which needs no container
+		testDBTypes = []sqldb.DBType{sqldb.SQLite}
 	default:
-		testDBTypes = []sqldb.DBType{sqldb.Postgres, sqldb.MySQL}
+		testDBTypes = []sqldb.DBType{sqldb.Postgres, sqldb.MySQL, sqldb.SQLite}
 	}
 }
 
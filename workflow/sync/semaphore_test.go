@@ -57,6 +57,13 @@ func createTestDatabaseSemaphoreMySQL(ctx context.Context, t *testing.T, name, n
 	return s, info.SessionProxy, deferfunc
 }
 
+// createTestDatabaseSemaphoreSQLite creates a database-backed semaphore that conforms to the factory
+func createTestDatabaseSemaphoreSQLite(ctx context.Context, t *testing.T, name, namespace string, limit int, nextWorkflow NextWorkflow) (semaphore, *sqldb.SessionProxy, func()) {
+	t.Helper()
+	s, info, deferfunc := createTestDatabaseSemaphore(ctx, t, name, namespace, limit, 0, nextWorkflow, sqldb.SQLite)
+	return s, info.SessionProxy, deferfunc
+}
+
 // semaphoreFactories defines the available semaphore implementations for testing
 var semaphoreFactories map[string]semaphoreFactory
 
@@ -66,12 +73,14 @@ func init() {
 	case "windows":
 		semaphoreFactories = map[string]semaphoreFactory{
 			"InternalSemaphore": createTestInternalSemaphore,
+			"SQLiteSemaphore":   createTestDatabaseSemaphoreSQLite,
 		}
 	default:
 		semaphoreFactories = map[string]semaphoreFactory{
 			"InternalSemaphore": createTestInternalSemaphore,
 			"PostgresSemaphore": createTestDatabaseSemaphorePostgres,
 			"MySQLSemaphore":    createTestDatabaseSemaphoreMySQL,
+			"SQLiteSemaphore":   createTestDatabaseSemaphoreSQLite,
 		}
 	}
 }
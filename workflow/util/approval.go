@@ -0,0 +1,140 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	errorsutil "github.com/argoproj/argo-workflows/v4/util/errors"
+	"github.com/argoproj/argo-workflows/v4/util/retry"
+	waitutil "github.com/argoproj/argo-workflows/v4/util/wait"
+	"github.com/argoproj/argo-workflows/v4/workflow/creator"
+	"github.com/argoproj/argo-workflows/v4/workflow/hydrator"
+)
+
+// DecideApproval records the decision on the active approval gates matching the node field selector, or all of them
+// if it is empty. A rejection fails the gates, and an approval resumes them once they reach their quorum, setting the
+// output parameters supplied by the approvers. isApprover returns whether the user is one of the gate's approvers.
+func DecideApproval(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string, decision wfv1.ApprovalDecision, isApprover func(wfv1.Approvers) bool) error {
+	if decision.User == "" {
+		return errors.Errorf(errors.CodeForbidden, "approving or rejecting requires a known user, e.g. one who logged in with SSO")
+	}
+	selector, err := fields.ParseSelector(nodeFieldSelector)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid node field selector: %v", err)
+	}
+	action := creator.ActionApprove
+	if !decision.Approved {
+		action = creator.ActionReject
+	}
+	return waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		wf, err := wfIf.Get(ctx, workflowName, metav1.GetOptions{})
+		if err != nil {
+			return !errorsutil.IsTransientErr(ctx, err), err
+		}
+		if err := hydrator.Hydrate(ctx, wf); err != nil {
+			return true, err
+		}
+		decided := false
+		for nodeID, node := range wf.Status.Nodes {
+			if !node.IsActiveSuspendNode() || node.Approval == nil || !SelectorMatchesNode(selector, node) {
+				continue
+			}
+			if !isApprover(node.Approval.Approvers) {
+				return true, errors.Errorf(errors.CodeForbidden, "%s is not an approver of node %s", decision.User, node.Name)
+			}
+			if node.Approval.GetDecision(decision.User) != nil {
+				return true, errors.Errorf(errors.CodeBadRequest, "%s has already approved or rejected node %s", decision.User, node.Name)
+			}
+			if err := decideNode(ctx, wf, &node, decision); err != nil {
+				return true, err
+			}
+			wf.Status.Nodes.Set(ctx, nodeID, node)
+			decided = true
+		}
+		if !decided {
+			return true, errors.Errorf(errors.CodeNotFound, "no active approval gates matching nodeFieldSelector: %s", nodeFieldSelector)
+		}
+		if err := hydrator.Dehydrate(ctx, wf); err != nil {
+			return true, fmt.Errorf("unable to compress or offload workflow nodes: %w", err)
+		}
+		creator.LabelActor(ctx, wf, action)
+		_, err = wfIf.Update(ctx, wf, metav1.UpdateOptions{})
+		if apierr.IsConflict(err) {
+			return false, nil
+		}
+		return true, err
+	})
+}
+
+// decideNode records the decision on the node, then fails or resumes the node if the gate is decided
+func decideNode(ctx context.Context, wf *wfv1.Workflow, node *wfv1.NodeStatus, decision wfv1.ApprovalDecision) error {
+	for name := range decision.Parameters {
+		if !expectsSuppliedParameter(node, name) {
+			return errors.Errorf(errors.CodeBadRequest, "node %s is not expecting output parameter '%s'", node.Name, name)
+		}
+	}
+	decision.Time = metav1.Time{Time: time.Now().UTC()}
+	approval := node.Approval.DeepCopy()
+	approval.Decisions = append(approval.Decisions, decision)
+	node.Approval = approval
+	if !decision.Approved {
+		node.Phase = wfv1.NodeFailed
+		node.Message = fmt.Sprintf("Rejected by %s", decision.User)
+		if decision.Message != "" {
+			node.Message += ": " + decision.Message
+		}
+		node.FinishedAt = decision.Time
+		return nil
+	}
+	if approval.GetApprovals() < approval.Quorum {
+		node.Message = fmt.Sprintf("Approved by %d of %d approvers", approval.GetApprovals(), approval.Quorum)
+		return nil
+	}
+	// later approvers' parameters take precedence
+	var approvers []string
+	for _, d := range approval.Decisions {
+		approvers = append(approvers, d.User)
+		for name, value := range d.Parameters {
+			for i, param := range node.Outputs.Parameters {
+				if param.Name == name {
+					node.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(value)
+					node.Outputs.Parameters[i].ValueFrom = nil
+				}
+			}
+		}
+	}
+	if err := OverrideOutputParametersWithDefault(node.Outputs); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%v", err)
+	}
+	if node.Outputs != nil {
+		for _, param := range node.Outputs.Parameters {
+			AddParamToGlobalScope(ctx, wf, param)
+		}
+	}
+	node.Phase = wfv1.NodeSucceeded
+	node.Message = "Approved by " + strings.Join(approvers, ", ")
+	node.FinishedAt = decision.Time
+	return nil
+}
+
+// expectsSuppliedParameter returns whether the node has an output parameter of the name which is supplied by a user
+func expectsSuppliedParameter(node *wfv1.NodeStatus, name string) bool {
+	if node.Outputs == nil {
+		return false
+	}
+	for _, param := range node.Outputs.Parameters {
+		if param.Name == name {
+			return param.ValueFrom != nil && param.ValueFrom.Supplied != nil
+		}
+	}
+	return false
+}
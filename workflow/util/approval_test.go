@@ -0,0 +1,176 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+	hydratorfake "github.com/argoproj/argo-workflows/v4/workflow/hydrator/fake"
+)
+
+var approvalWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: approval
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: approve
+        template: approve
+  - name: approve
+    suspend:
+      approval:
+        approvers:
+          groups: [release-managers]
+        quorum: 2
+    outputs:
+      parameters:
+      - name: version
+        valueFrom:
+          supplied: {}
+status:
+  phase: Running
+  nodes:
+    approval:
+      id: approval
+      name: approval
+      displayName: approval
+      phase: Running
+      type: Steps
+      children: [approval-1]
+    approval-1:
+      id: approval-1
+      name: approval[0].approve
+      displayName: approve
+      boundaryID: approval
+      phase: Running
+      type: Suspend
+      templateName: approve
+      approval:
+        approvers:
+          groups: [release-managers]
+        quorum: 2
+      outputs:
+        parameters:
+        - name: version
+          valueFrom:
+            supplied: {}
+`
+
+func newApprovalWorkflow(t *testing.T) v1alpha1.WorkflowInterface {
+	t.Helper()
+	wfIf := argofake.NewClientset().ArgoprojV1alpha1().Workflows("")
+	_, err := wfIf.Create(logging.TestContext(t.Context()), wfv1.MustUnmarshalWorkflow(approvalWf), metav1.CreateOptions{})
+	require.NoError(t, err)
+	return wfIf
+}
+
+func anyApprover(wfv1.Approvers) bool { return true }
+
+func TestDecideApproval(t *testing.T) {
+	t.Run("Quorum", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{User: "alice", Approved: true, Parameters: map[string]string{"version": "1"}}, anyApprover)
+		require.NoError(t, err)
+		wf, err := wfIf.Get(ctx, "approval", metav1.GetOptions{})
+		require.NoError(t, err)
+		node := wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, "Approved by 1 of 2 approvers", node.Message)
+
+		err = DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{User: "alice", Approved: true}, anyApprover)
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeBadRequest, err))
+
+		err = DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "displayName=approve", wfv1.ApprovalDecision{User: "bob", Approved: true, Parameters: map[string]string{"version": "2"}, Message: "LGTM"}, anyApprover)
+		require.NoError(t, err)
+		wf, err = wfIf.Get(ctx, "approval", metav1.GetOptions{})
+		require.NoError(t, err)
+		node = wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "Approved by alice, bob", node.Message)
+		assert.Equal(t, "2", node.Outputs.Parameters[0].Value.String())
+		if assert.Len(t, node.Approval.Decisions, 2) {
+			assert.Equal(t, "bob", node.Approval.Decisions[1].User)
+			assert.Equal(t, "LGTM", node.Approval.Decisions[1].Message)
+			assert.Equal(t, map[string]string{"version": "2"}, node.Approval.Decisions[1].Parameters)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{User: "alice", Message: "not today"}, anyApprover)
+		require.NoError(t, err)
+		wf, err := wfIf.Get(ctx, "approval", metav1.GetOptions{})
+		require.NoError(t, err)
+		node := wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "Rejected by alice: not today", node.Message)
+	})
+
+	t.Run("NotApprover", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{User: "mallory", Approved: true}, func(approvers wfv1.Approvers) bool {
+			assert.Equal(t, []string{"release-managers"}, approvers.Groups)
+			return false
+		})
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{Approved: true}, anyApprover)
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+
+	t.Run("UnexpectedParameter", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "", wfv1.ApprovalDecision{User: "alice", Approved: true, Parameters: map[string]string{"foo": "bar"}}, anyApprover)
+		require.EqualError(t, err, "node approval[0].approve is not expecting output parameter 'foo'")
+	})
+
+	t.Run("NoMatchingNode", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := DecideApproval(ctx, wfIf, hydratorfake.Noop, "approval", "displayName=nonexistent", wfv1.ApprovalDecision{User: "alice", Approved: true}, anyApprover)
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+
+	t.Run("ResumeSkipsApprovalGates", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := newApprovalWorkflow(t)
+
+		err := ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "approval", "")
+		require.NoError(t, err)
+		wf, err := wfIf.Get(ctx, "approval", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes.FindByDisplayName("approve").Phase)
+
+		err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "approval", "displayName=approve")
+		require.Error(t, err)
+	})
+}
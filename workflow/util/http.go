@@ -0,0 +1,127 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/evilmonkeyinc/jsonpath"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/expr/argoexpr"
+	exprenv "github.com/argoproj/argo-workflows/v4/util/expr/env"
+)
+
+// HTTPTemplateOutputs returns the outputs of an HTTP template from the body which completed it, i.e. the body of the
+// response or of the callback. Output parameters are the JSONPath of the body, or expressions evaluated in evalScope.
+func HTTPTemplateOutputs(params []wfv1.Parameter, body []byte, evalScope map[string]any) (*wfv1.Outputs, error) {
+	outputs := &wfv1.Outputs{Result: new(string(body))}
+	for _, param := range params {
+		if param.ValueFrom == nil {
+			outputs.Parameters = append(outputs.Parameters, param)
+			continue
+		}
+		value, err := httpOutputParameterValue(param, body, evalScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get output parameter '%s': %w", param.Name, err)
+		}
+		outputs.Parameters = append(outputs.Parameters, wfv1.Parameter{Name: param.Name, Value: wfv1.AnyStringPtr(value)})
+	}
+	return outputs, nil
+}
+
+// httpOutputParameterValue returns the value of an output parameter of an HTTP template. Values which are not strings
+// are JSON encoded.
+func httpOutputParameterValue(param wfv1.Parameter, body []byte, evalScope map[string]any) (string, error) {
+	var (
+		value any
+		err   error
+	)
+	switch {
+	case param.ValueFrom.JSONPath != "":
+		var data any
+		if err = json.Unmarshal(body, &data); err == nil {
+			value, err = jsonpath.Query(param.ValueFrom.JSONPath, data)
+		}
+	case param.ValueFrom.Expression != "":
+		var program *vm.Program
+		env := exprenv.GetFuncMap(evalScope)
+		if program, err = expr.Compile(param.ValueFrom.Expression, expr.Env(env)); err == nil {
+			value, err = expr.Run(program, env)
+		}
+	default:
+		return "", fmt.Errorf("either jsonPath or expression must be specified")
+	}
+	if err != nil || value == nil {
+		if param.ValueFrom.Default != nil {
+			return param.ValueFrom.Default.String(), nil
+		}
+		if err == nil {
+			err = fmt.Errorf("no value")
+		}
+		return "", err
+	}
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// CallbackHTTPNode completes the HTTP node waiting for a callback with the body of the callback, by setting its result
+// in the workflow's task set as the agent does. The node fails if the callback's success condition is false.
+func CallbackHTTPNode(ctx context.Context, taskSetIf v1alpha1.WorkflowTaskSetInterface, workflowName, nodeID string, body []byte) error {
+	taskSet, err := taskSetIf.Get(ctx, workflowName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	tmpl, ok := taskSet.Spec.Tasks[nodeID]
+	if !ok || tmpl.HTTP == nil || tmpl.HTTP.Async == nil || tmpl.HTTP.Async.Callback == nil {
+		return errors.Errorf(errors.CodeNotFound, "no HTTP node %s is waiting for a callback", nodeID)
+	}
+	if result, ok := taskSet.Status.Nodes[nodeID]; ok && result.Phase.Completed() {
+		return errors.Errorf(errors.CodeBadRequest, "HTTP node %s has already completed", nodeID)
+	}
+
+	evalScope := map[string]any{
+		"callback": map[string]any{
+			"body": string(body),
+		},
+	}
+	result := wfv1.NodeResult{Phase: wfv1.NodeSucceeded}
+	if condition := tmpl.HTTP.Async.Callback.SuccessCondition; condition != "" {
+		success, err := argoexpr.EvalBool(condition, evalScope)
+		if err != nil {
+			result.Phase = wfv1.NodeFailed
+			result.Message = err.Error()
+		} else if !success {
+			result.Phase = wfv1.NodeFailed
+			result.Message = fmt.Sprintf("successCondition '%s' evaluated false", condition)
+		}
+	}
+	if result.Phase == wfv1.NodeSucceeded {
+		outputs, err := HTTPTemplateOutputs(tmpl.Outputs.Parameters, body, evalScope)
+		if err != nil {
+			result.Phase = wfv1.NodeFailed
+			result.Message = err.Error()
+		} else {
+			result.Outputs = outputs
+		}
+	}
+
+	patch, err := json.Marshal(map[string]any{"status": wfv1.WorkflowTaskSetStatus{Nodes: map[string]wfv1.NodeResult{nodeID: result}}})
+	if err != nil {
+		return err
+	}
+	_, err = taskSetIf.Patch(ctx, workflowName, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	return err
+}
@@ -0,0 +1,94 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v4/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+var callbackTaskSet = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTaskSet
+metadata:
+  name: callback
+spec:
+  tasks:
+    callback-1:
+      name: http
+      http:
+        url: https://example.com/jobs
+        async:
+          callback:
+            successCondition: fromJSON(callback.body).status == "done"
+      outputs:
+        parameters:
+        - name: id
+          valueFrom:
+            jsonPath: $.id
+    callback-2:
+      name: http
+      http:
+        url: https://example.com/jobs
+`
+
+func newCallbackTaskSet(t *testing.T) v1alpha1.WorkflowTaskSetInterface {
+	t.Helper()
+	taskSetIf := argofake.NewClientset().ArgoprojV1alpha1().WorkflowTaskSets("")
+	var taskSet wfv1.WorkflowTaskSet
+	wfv1.MustUnmarshal(callbackTaskSet, &taskSet)
+	_, err := taskSetIf.Create(logging.TestContext(t.Context()), &taskSet, metav1.CreateOptions{})
+	require.NoError(t, err)
+	return taskSetIf
+}
+
+func TestCallbackHTTPNode(t *testing.T) {
+	t.Run("Succeeded", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		taskSetIf := newCallbackTaskSet(t)
+
+		err := CallbackHTTPNode(ctx, taskSetIf, "callback", "callback-1", []byte(`{"status": "done", "id": "1"}`))
+		require.NoError(t, err)
+		taskSet, err := taskSetIf.Get(ctx, "callback", metav1.GetOptions{})
+		require.NoError(t, err)
+		result := taskSet.Status.Nodes["callback-1"]
+		assert.Equal(t, wfv1.NodeSucceeded, result.Phase)
+		assert.JSONEq(t, `{"status": "done", "id": "1"}`, *result.Outputs.Result)
+		assert.Equal(t, "1", result.Outputs.Parameters[0].Value.String())
+
+		err = CallbackHTTPNode(ctx, taskSetIf, "callback", "callback-1", []byte(`{"status": "done"}`))
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeBadRequest, err))
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		taskSetIf := newCallbackTaskSet(t)
+
+		err := CallbackHTTPNode(ctx, taskSetIf, "callback", "callback-1", []byte(`{"status": "error"}`))
+		require.NoError(t, err)
+		taskSet, err := taskSetIf.Get(ctx, "callback", metav1.GetOptions{})
+		require.NoError(t, err)
+		result := taskSet.Status.Nodes["callback-1"]
+		assert.Equal(t, wfv1.NodeFailed, result.Phase)
+		assert.Equal(t, `successCondition 'fromJSON(callback.body).status == "done"' evaluated false`, result.Message)
+	})
+
+	t.Run("NotWaiting", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		taskSetIf := newCallbackTaskSet(t)
+
+		for _, nodeID := range []string{"callback-2", "callback-3"} {
+			err := CallbackHTTPNode(ctx, taskSetIf, "callback", nodeID, nil)
+			require.Error(t, err)
+			assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+		}
+	})
+}
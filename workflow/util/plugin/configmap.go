@@ -39,6 +39,12 @@ func ToConfigMap(p *spec.Plugin) (*apiv1.ConfigMap, error) {
 			"sidecar.container":                    string(data),
 		},
 	}
+	if p.Spec.Version != "" {
+		cm.Data["version"] = p.Spec.Version
+	}
+	if len(p.Spec.Capabilities) > 0 {
+		cm.Data["capabilities"] = strings.Join(p.Spec.Capabilities, ",")
+	}
 	maps.Copy(cm.Annotations, p.Annotations)
 	maps.Copy(cm.Labels, p.Labels)
 	return cm, nil
@@ -58,6 +64,10 @@ func FromConfigMap(cm *apiv1.ConfigMap) (*spec.Plugin, error) {
 	maps.Copy(p.Annotations, cm.Annotations)
 	maps.Copy(p.Labels, cm.Labels)
 	delete(p.Labels, common.LabelKeyConfigMapType)
+	p.Spec.Version = cm.Data["version"]
+	if capabilities := cm.Data["capabilities"]; capabilities != "" {
+		p.Spec.Capabilities = strings.Split(capabilities, ",")
+	}
 	p.Spec.Sidecar.AutomountServiceAccountToken = cm.Data["sidecar.automountServiceAccountToken"] == "true"
 	if err := yaml.UnmarshalStrict([]byte(cm.Data["sidecar.container"]), &p.Spec.Sidecar.Container); err != nil {
 		return nil, err
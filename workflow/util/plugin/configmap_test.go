@@ -58,6 +58,32 @@ func TestToConfigMap(t *testing.T) {
 			"sidecar.container":                    "name: \"\"\nports:\n- containerPort: 1234\nresources: {}\nsecurityContext: {}\n",
 		}, cm.Data)
 	})
+	t.Run("VersionAndCapabilities", func(t *testing.T) {
+		cm, err := ToConfigMap(&spec.Plugin{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-plug"},
+			Spec: spec.PluginSpec{
+				Version:      "v1.2.0",
+				Capabilities: []string{"hello", "goodbye"},
+				Sidecar: spec.Sidecar{
+					Container: apiv1.Container{
+						Ports: []apiv1.ContainerPort{{ContainerPort: 1234}},
+						Resources: apiv1.ResourceRequirements{
+							Limits:   map[apiv1.ResourceName]resource.Quantity{apiv1.ResourceCPU: resource.MustParse("100m")},
+							Requests: map[apiv1.ResourceName]resource.Quantity{apiv1.ResourceCPU: resource.MustParse("100m")},
+						},
+						SecurityContext: &apiv1.SecurityContext{},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.0", cm.Data["version"])
+		assert.Equal(t, "hello,goodbye", cm.Data["capabilities"])
+		p, err := FromConfigMap(cm)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.0", p.Spec.Version)
+		assert.Equal(t, []string{"hello", "goodbye"}, p.Spec.Capabilities)
+	})
 }
 
 func TestFromConfigMap(t *testing.T) {
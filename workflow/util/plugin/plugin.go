@@ -38,6 +38,9 @@ func New(address, token string, timeout time.Duration, backoff wait.Backoff) Cli
 	}
 }
 
+// errMethodNotFound is returned when the plugin does not implement the method
+var errMethodNotFound = stderrors.New("method not found")
+
 func (p *Client) Call(ctx context.Context, method string, args any, reply any) error {
 	if p.invalid[method] {
 		return nil
@@ -58,39 +61,67 @@ func (p *Client) Call(ctx context.Context, method string, args any, reply any) e
 		}
 		return strings.Contains(err.Error(), "connection refused") || errors.IsTransientErr(ctx, err)
 	}, func() error {
-		log.Debug(ctx, "Calling plugin")
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/%s", p.address, method), bytes.NewBuffer(body))
-		if err != nil {
+		err := p.post(ctx, method, body, reply)
+		if stderrors.Is(err, errMethodNotFound) {
+			log.Info(ctx, "method not found, not calling again")
+			p.invalid[method] = true
+			return nil
+		}
+		return err
+	})
+}
+
+// Probe calls the method once, without retrying, to check the plugin is serving. A plugin which does not implement the
+// method is still serving, so that is not an error, and unlike Call the method is called again next time.
+func (p *Client) Probe(ctx context.Context, method string, args any, reply any) error {
+	ctx, _ = logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{
+		"address": p.address,
+		"method":  method,
+	}).InContext(ctx)
+	body, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	if err := p.post(ctx, method, body, reply); err != nil && !stderrors.Is(err, errMethodNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (p *Client) post(ctx context.Context, method string, body []byte, reply any) error {
+	log := logging.RequireLoggerFromContext(ctx)
+	log.Debug(ctx, "Calling plugin")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/%s", p.address, method), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer "+p.token)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	log.WithField("statusCode", resp.StatusCode).Debug(ctx, "Called plugin")
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(reply)
+	case http.StatusNotFound:
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
 			return err
 		}
-		req.Header.Add("Content-Type", "application/json")
-		req.Header.Add("Authorization", "Bearer "+p.token)
-		resp, err := p.client.Do(req)
+		return errMethodNotFound
+	case http.StatusServiceUnavailable:
+		data, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return err
 		}
-		defer resp.Body.Close()
-		log.WithField("statusCode", resp.StatusCode).Debug(ctx, "Called plugin")
-		switch resp.StatusCode {
-		case http.StatusOK:
-			return json.NewDecoder(resp.Body).Decode(reply)
-		case http.StatusNotFound:
-			log.Info(ctx, "method not found, not calling again")
-			p.invalid[method] = true
-			_, err := io.Copy(io.Discard, resp.Body)
+		return errors.NewErrTransient(string(data))
+	default:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
 			return err
-		case http.StatusServiceUnavailable:
-			data, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return err
-			}
-			return errors.NewErrTransient(string(data))
-		default:
-			data, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return err
-			}
-			return fmt.Errorf("%s: %s", resp.Status, string(data))
 		}
-	})
+		return fmt.Errorf("%s: %s", resp.Status, string(data))
+	}
 }
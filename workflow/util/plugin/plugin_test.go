@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-workflows/v4/util/logging"
+)
+
+func TestClient(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/api/v1/plugin.health":
+			_, _ = w.Write([]byte(`{"version": "v1"}`))
+		case "/api/v1/plugin.broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := logging.TestContext(t.Context())
+
+	t.Run("Call", func(t *testing.T) {
+		client := New(server.URL, "my-token", time.Second, wait.Backoff{Steps: 1})
+		calls = 0
+		require.NoError(t, client.Call(ctx, "template.cancel", nil, &struct{}{}))
+		// methods which are not found are not called again
+		require.NoError(t, client.Call(ctx, "template.cancel", nil, &struct{}{}))
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("Probe", func(t *testing.T) {
+		client := New(server.URL, "my-token", time.Second, wait.Backoff{Steps: 1})
+		reply := &struct {
+			Version string `json:"version"`
+		}{}
+		require.NoError(t, client.Probe(ctx, "plugin.health", nil, reply))
+		assert.Equal(t, "v1", reply.Version)
+		calls = 0
+		require.NoError(t, client.Probe(ctx, "plugin.missing", nil, &struct{}{}))
+		require.NoError(t, client.Probe(ctx, "plugin.missing", nil, &struct{}{}))
+		assert.Equal(t, 2, calls)
+		require.EqualError(t, client.Probe(ctx, "plugin.broken", nil, &struct{}{}), "500 Internal Server Error: ")
+	})
+	t.Run("ProbeNotServing", func(t *testing.T) {
+		client := New("http://localhost:0", "my-token", time.Second, wait.Backoff{Steps: 1})
+		require.Error(t, client.Probe(ctx, "plugin.health", nil, &struct{}{}))
+	})
+}
@@ -544,9 +544,10 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 			workflowUpdated = true
 		}
 
-		// To resume a workflow with a suspended node we simply mark the node as Successful
+		// To resume a workflow with a suspended node we simply mark the node as Successful,
+		// approval gates can only be resumed by approving them
 		for nodeID, node := range wf.Status.Nodes {
-			if node.IsActiveSuspendNode() {
+			if node.IsActiveSuspendNode() && node.Approval == nil {
 				if err := OverrideOutputParametersWithDefault(node.Outputs); err != nil {
 					return false, err
 				}
@@ -661,6 +662,9 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 		for nodeID, node := range wf.Status.Nodes {
 			if node.IsActiveSuspendNode() {
 				if SelectorMatchesNode(selector, node) {
+					if node.Approval != nil {
+						return true, fmt.Errorf("node %s is an approval gate, it can only be approved or rejected", node.Name)
+					}
 					// Update phase
 					if values.Phase != "" {
 						node.Phase = values.Phase
@@ -1103,7 +1107,7 @@ func resetBoundaries(n *dagNode, resetFunc resetFn) (*dagNode, error) {
 		if curr == nil {
 			return curr, nil
 		}
-		if curr.parent != nil && curr.parent.n.Type == wfv1.NodeTypeRetry {
+		if curr.parent != nil && (curr.parent.n.Type == wfv1.NodeTypeRetry || curr.parent.n.Type == wfv1.NodeTypeLoop) {
 			resetFunc(curr.parent.n.ID)
 			curr = curr.parent
 		}
@@ -1181,7 +1185,7 @@ func resetPath(allNodes []*dagNode, startNode string) (map[string]bool, map[stri
 				return nil, nil, err
 			}
 			continue
-		case curr.n.Type == wfv1.NodeTypeRetry && curr.n.FailedOrError():
+		case (curr.n.Type == wfv1.NodeTypeRetry || curr.n.Type == wfv1.NodeTypeLoop) && curr.n.FailedOrError():
 			addToReset(curr.n.ID)
 		case curr.n.Type == wfv1.NodeTypeContainer:
 			curr, err = resetPod(curr, addToReset, addToDelete)
@@ -517,6 +517,9 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 		localParams[varkeys.PodName.Template()] = placeholderGenerator.NextPlaceholder()
 		scope[varkeys.PodName.Template()] = placeholderGenerator.NextPlaceholder()
 	}
+	if tmpl.HTTP != nil && tmpl.HTTP.Async != nil && tmpl.HTTP.Async.Callback != nil {
+		localParams[varkeys.HTTPCallbackURL.Template()] = placeholderGenerator.NextPlaceholder()
+	}
 	if tmpl.RetryStrategy != nil {
 		localParams[varkeys.Retries.Template()] = placeholderGenerator.NextPlaceholder()
 		localParams[varkeys.RetriesLastExitCode.Template()] = placeholderGenerator.NextPlaceholder()
@@ -975,6 +978,27 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]any, tmplCtx *t
 			}
 		}
 	}
+	if tmpl.Suspend != nil && tmpl.Suspend.Approval != nil {
+		if tmpl.Suspend.Duration != "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.duration and approval are mutually exclusive, use approval.timeout", tmpl.Name)
+		}
+		// timeouts which are input parameters are only known at runtime
+		approval := tmpl.Suspend.Approval.DeepCopy()
+		if placeholderGenerator.IsPlaceholder(approval.Timeout) {
+			approval.Timeout = "0s"
+		}
+		if approval.Escalation != nil && placeholderGenerator.IsPlaceholder(approval.Escalation.Timeout) {
+			approval.Escalation.Timeout = "0s"
+		}
+		if err := approval.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.approval.%s", tmpl.Name, err.Error())
+		}
+	}
+	if tmpl.HTTP != nil && tmpl.HTTP.Async != nil {
+		if err := tmpl.HTTP.Async.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.async.%s", tmpl.Name, err.Error())
+		}
+	}
 	// we don't validate tmpl.Plugin, because this is done by Plugin.UnmarshallJSON
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
@@ -1084,14 +1108,23 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
-			err = validateArguments(fmt.Sprintf("templates.%s.steps[%d].%s.arguments.", tmpl.Name, i, step.Name), step.Arguments, false)
-			if err != nil {
-				return err
-			}
-			resolvedTmpl, err := tctx.validateTemplateHolder(ctx, &step, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+			err = validateSwitch(step.Switch, step.Template != "" || step.TemplateRef != nil || step.Inline != nil, step.Arguments, step.ShouldExpand())
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			caseSteps := switchCaseSteps(step)
+			caseTmpls := make([]*wfv1.Template, len(caseSteps))
+			for j, caseStep := range caseSteps {
+				err = validateArguments(fmt.Sprintf("templates.%s.steps[%d].%s.arguments.", tmpl.Name, i, step.Name), caseStep.Arguments, false)
+				if err != nil {
+					return err
+				}
+				caseTmpls[j], err = tctx.validateTemplateHolder(ctx, &caseStep, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+				if err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
+			}
+			resolvedTmpl := mergeSwitchCaseTemplates(caseTmpls)
 
 			if step.HasExitHook() {
 				tctx.addOutputsToScope(ctx, resolvedTmpl, varkeys.StepsNodeRef, varkeys.StepsAggregate, step.Name, scope, false, false)
@@ -1116,6 +1149,9 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 					stepScope[varkeys.InputsParameterByName.Concretize(p.Name)] = placeholderGenerator.NextPlaceholder()
 				}
 			}
+			if err := validateLoop(step.Loop, step.ShouldExpand(), stepScope); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			}
 
 			err = resolveAllVariables(stepScope, tctx.globalParams, string(stepBytes), workflowTemplateValidation)
 			if err != nil {
@@ -1127,15 +1163,160 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 			tctx.addOutputsToScope(ctx, resolvedTmpl, varkeys.StepsNodeRef, varkeys.StepsAggregate, step.Name, scope, aggregate, false)
 
 			// Validate the template again with actual arguments.
-			_, err = tctx.validateTemplateHolder(ctx, &step, tmplCtx, &step.Arguments, workflowTemplateValidation)
-			if err != nil {
-				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			for _, caseStep := range caseSteps {
+				_, err = tctx.validateTemplateHolder(ctx, &caseStep, tmplCtx, &caseStep.Arguments, workflowTemplateValidation)
+				if err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
 			}
 		}
 	}
 	return nil
 }
 
+// validateSwitch validates the switch of a step or task, if any
+func validateSwitch(sw *wfv1.Switch, hasTemplate bool, args wfv1.Arguments, expands bool) error {
+	if sw == nil {
+		return nil
+	}
+	if hasTemplate {
+		return fmt.Errorf("switch cannot be used with template, templateRef or inline")
+	}
+	if len(args.Parameters) > 0 || len(args.Artifacts) > 0 {
+		return fmt.Errorf("switch cannot be used with arguments, each case has its own arguments")
+	}
+	if expands {
+		return fmt.Errorf("switch cannot be used with withItems, withParam or withSequence")
+	}
+	if sw.Expression == "" {
+		return fmt.Errorf("switch.expression is required")
+	}
+	if len(sw.Cases) == 0 {
+		return fmt.Errorf("switch.cases is required")
+	}
+	values := make(map[string]bool)
+	for i, c := range sw.Cases {
+		if values[c.Value] {
+			return fmt.Errorf("switch.cases[%d].value '%s' is not unique", i, c.Value)
+		}
+		values[c.Value] = true
+		if c.Template == "" && c.TemplateRef == nil {
+			return fmt.Errorf("switch.cases[%d] must have a template or templateRef", i)
+		}
+	}
+	if sw.Default != nil && sw.Default.Template == "" && sw.Default.TemplateRef == nil {
+		return fmt.Errorf("switch.default must have a template or templateRef")
+	}
+	return nil
+}
+
+// switchCaseSteps returns the step, or a copy of it per case of its switch, to be validated as the step would be
+func switchCaseSteps(step wfv1.WorkflowStep) []wfv1.WorkflowStep {
+	if step.Switch == nil {
+		return []wfv1.WorkflowStep{step}
+	}
+	var steps []wfv1.WorkflowStep
+	for _, c := range step.Switch.AllCases() {
+		steps = append(steps, step.WithSwitchCase(c))
+	}
+	return steps
+}
+
+// switchCaseTasks returns the task, or a copy of it per case of its switch, to be validated as the task would be
+func switchCaseTasks(task wfv1.DAGTask) []wfv1.DAGTask {
+	if task.Switch == nil {
+		return []wfv1.DAGTask{task}
+	}
+	var tasks []wfv1.DAGTask
+	for _, c := range task.Switch.AllCases() {
+		tasks = append(tasks, task.WithSwitchCase(c))
+	}
+	return tasks
+}
+
+// mergeSwitchCaseTemplates returns the template of a step or task, given the templates of each case of its switch.
+// The template of the first case is returned with the outputs of every case, so that later steps and tasks can
+// reference the outputs of whichever case is chosen.
+func mergeSwitchCaseTemplates(tmpls []*wfv1.Template) *wfv1.Template {
+	var merged *wfv1.Template
+	for _, tmpl := range tmpls {
+		if tmpl == nil {
+			continue
+		}
+		if merged == nil {
+			if len(tmpls) == 1 {
+				return tmpl
+			}
+			merged = tmpl.DeepCopy()
+			continue
+		}
+		for _, param := range tmpl.Outputs.Parameters {
+			if !slices.ContainsFunc(merged.Outputs.Parameters, func(p wfv1.Parameter) bool { return p.Name == param.Name }) {
+				merged.Outputs.Parameters = append(merged.Outputs.Parameters, param)
+			}
+		}
+		for _, art := range tmpl.Outputs.Artifacts {
+			if merged.Outputs.GetArtifactByName(art.Name) == nil {
+				merged.Outputs.Artifacts = append(merged.Outputs.Artifacts, art)
+			}
+		}
+	}
+	return merged
+}
+
+// validateLoop validates the loop of a step or task, if any, and adds the loop.iteration variable to its scope
+func validateLoop(loop *wfv1.Loop, expands bool, scope map[string]any) error {
+	if loop == nil {
+		return nil
+	}
+	if expands {
+		return fmt.Errorf("loop cannot be used with withItems, withParam or withSequence")
+	}
+	if loop.Until == "" {
+		return fmt.Errorf("loop.until is required")
+	}
+	if loop.MaxIterations == nil {
+		return fmt.Errorf("loop.maxIterations is required")
+	}
+	if !intstr.IsValidIntOrArgoVariable(loop.MaxIterations) {
+		return fmt.Errorf("loop.maxIterations must be an integer or an argo variable")
+	}
+	if maxIterations, err := intstr.Int(loop.MaxIterations); err == nil && *maxIterations < 1 {
+		return fmt.Errorf("loop.maxIterations must be at least 1")
+	}
+	if loop.Delay != "" && !isParameter(loop.Delay) {
+		if _, err := wfv1.ParseStringToDuration(loop.Delay); err != nil {
+			return fmt.Errorf("loop.delay is invalid: %w", err)
+		}
+	}
+	scope[varkeys.LoopIteration.Template()] = true
+	return nil
+}
+
+func validateGenerateTasks(generateTasks *wfv1.GenerateTasks, expands bool, tmpl *wfv1.Template) error {
+	if generateTasks == nil {
+		return nil
+	}
+	if expands {
+		return fmt.Errorf("generateTasks cannot be used with withItems, withParam or withSequence")
+	}
+	if tmpl == nil {
+		return nil
+	}
+	if generateTasks.Parameter == "" {
+		if !tmpl.HasOutput() {
+			return fmt.Errorf("generateTasks requires a template which outputs a result, or generateTasks.parameter")
+		}
+		return nil
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.Name == generateTasks.Parameter {
+			return nil
+		}
+	}
+	return fmt.Errorf("generateTasks.parameter '%s' is not an output parameter of the template", generateTasks.Parameter)
+}
+
 func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]any) error {
 	defined := 0
 	if len(withItems) > 0 {
@@ -1224,6 +1405,19 @@ func (tctx *templateValidationCtx) addOutputsToScope(ctx context.Context, tmpl *
 			}
 		}
 	}
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if ctr.Outputs == nil {
+				continue
+			}
+			for _, param := range ctr.Outputs.Parameters {
+				scope[ref.ContainerOutputsParameterByName.Concretize(name, ctr.Name, param.Name)] = true
+			}
+			for _, art := range ctr.Outputs.Artifacts {
+				scope[ref.ContainerOutputsArtifactByName.Concretize(name, ctr.Name, art.Name)] = true
+			}
+		}
+	}
 	if aggregate {
 		switch tmpl.GetType() {
 		// Not that we don't also include TemplateTypeContainer here, even though it uses `outputs.result` it uses
@@ -1269,6 +1463,17 @@ func validateOutputs(scope map[string]any, globalParams map[string]string, tmpl
 		} else if art.Path != "" {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path only valid in container/script templates", tmpl.Name, artRef)
 		}
+		if art.Stream {
+			if tmplType := tmpl.GetType(); tmplType != wfv1.TemplateTypeContainer && tmplType != wfv1.TemplateTypeScript {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.stream only valid in container/script templates", tmpl.Name, artRef)
+			}
+			if common.FindOverlappingVolume(tmpl, art.Path) == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path must be on a volume mount to be streamed", tmpl.Name, artRef)
+			}
+			if art.Archive != nil && art.Archive.None == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.archive must be none, as streams are not archived", tmpl.Name, artRef)
+			}
+		}
 		if art.GlobalName != "" && !isParameter(art.GlobalName) {
 			errs := isValidParamOrArtifactName(art.GlobalName)
 			if len(errs) > 0 {
@@ -1293,6 +1498,10 @@ func validateOutputs(scope map[string]any, globalParams map[string]string, tmpl
 				if param.ValueFrom.JQFilter == "" && param.ValueFrom.JSONPath == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s .jqFilter or jsonPath must be specified for %s templates", paramRef, tmplType)
 				}
+			case wfv1.TemplateTypeHTTP:
+				if param.ValueFrom.JSONPath == "" && param.ValueFrom.Expression == "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.jsonPath or expression must be specified for %s templates", paramRef, tmplType)
+				}
 			case wfv1.TemplateTypeDAG, wfv1.TemplateTypeSteps:
 				if param.ValueFrom.Parameter == "" && param.ValueFrom.Expression == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.parameter or expression must be specified for %s templates", paramRef, tmplType)
@@ -1477,11 +1686,20 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s cannot use 'continueOn' when using 'depends'. Instead use 'dep-task.Failed'/'dep-task.Errored'", tmpl.Name)
 		}
 
-		resolvedTmpl, validateErr := tctx.validateTemplateHolder(ctx, &task, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
-
-		if validateErr != nil {
-			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, validateErr.Error())
+		err = validateSwitch(task.Switch, task.Template != "" || task.TemplateRef != nil || task.Inline != nil, task.Arguments, task.ShouldExpand())
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		caseTasks := switchCaseTasks(task)
+		caseTmpls := make([]*wfv1.Template, len(caseTasks))
+		for i, caseTask := range caseTasks {
+			var validateErr error
+			caseTmpls[i], validateErr = tctx.validateTemplateHolder(ctx, &caseTask, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+			if validateErr != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, validateErr.Error())
+			}
+		}
+		resolvedTmpl := mergeSwitchCaseTemplates(caseTmpls)
 
 		resolvedTemplates[task.Name] = resolvedTmpl
 
@@ -1552,28 +1770,50 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
-		err = resolveAllVariables(taskScope, tctx.globalParams, string(taskBytes), workflowTemplateValidation)
+		err = validateLoop(task.Loop, task.ShouldExpand(), taskScope)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
-		err = validateArguments(fmt.Sprintf("templates.%s.tasks.%s.arguments.", tmpl.Name, task.Name), task.Arguments, false)
-		if err != nil {
-			return err
-		}
-		err = validateDAGTaskArgumentDependency(task.Arguments, ancestry)
+		err = validateGenerateTasks(task.GenerateTasks, task.ShouldExpand(), resolvedTmpl)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
-		// Validate the template again with actual arguments.
-		_, err = tctx.validateTemplateHolder(ctx, &task, tmplCtx, &task.Arguments, workflowTemplateValidation)
+		err = resolveAllVariables(taskScope, tctx.globalParams, string(taskBytes), workflowTemplateValidation)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		for _, caseTask := range switchCaseTasks(task) {
+			err = validateArguments(fmt.Sprintf("templates.%s.tasks.%s.arguments.", tmpl.Name, task.Name), caseTask.Arguments, false)
+			if err != nil {
+				return err
+			}
+			err = validateDAGTaskArgumentDependency(caseTask.Arguments, ancestry)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+			// Validate the template again with actual arguments.
+			_, err = tctx.validateTemplateHolder(ctx, &caseTask, tmplCtx, &caseTask.Arguments, workflowTemplateValidation)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+		}
 	}
 
 	return nil
 }
 
+// GeneratedDAGTasks validates a DAG template at runtime, once the tasks generated by its tasks with generateTasks have
+// been spliced into it. globalParams are the workflow's global variables.
+func GeneratedDAGTasks(ctx context.Context, wf *wfv1.Workflow, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, globalParams map[string]string) error {
+	tctx := newTemplateValidationCtx(wf, Opts{})
+	maps.Copy(tctx.globalParams, globalParams)
+	scope, err := validateInputs(tmpl)
+	if err != nil {
+		return err
+	}
+	return tctx.validateDAG(ctx, scope, tmplCtx, tmpl, false)
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
@@ -1237,6 +1237,76 @@ func TestVolumeMountArtifactPathCollision(t *testing.T) {
 	require.NoError(t, err)
 }
 
+var streamOutputArtifact = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: stream-
+spec:
+  entrypoint: main
+  volumes:
+  - name: work
+    emptyDir: {}
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: produce
+        template: produce
+      - name: consume
+        template: consume
+        depends: produce.Streaming
+        arguments:
+          artifacts:
+          - name: in
+            from: "{{tasks.produce.outputs.artifacts.out}}"
+  - name: produce
+    container:
+      image: alpine:3.23
+      command: [sh, -c]
+      args: ["seq 100 > /work/out"]
+      volumeMounts:
+      - name: work
+        mountPath: /work
+    outputs:
+      artifacts:
+      - name: out
+        path: /work/out
+        stream: true
+  - name: consume
+    inputs:
+      artifacts:
+      - name: in
+        path: /work/in
+    container:
+      image: alpine:3.23
+      command: [cat, /work/in]
+      volumeMounts:
+      - name: work
+        mountPath: /work
+`
+
+func TestStreamOutputArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, streamOutputArtifact)
+	require.NoError(t, err)
+
+	wf := unmarshalWf(streamOutputArtifact)
+	wf.Spec.Templates[1].Outputs.Artifacts[0].Archive = &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{}}
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.ErrorContains(t, err, "templates.produce.outputs.artifacts.out.archive must be none")
+
+	wf = unmarshalWf(streamOutputArtifact)
+	wf.Spec.Templates[1].Container.VolumeMounts = nil
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.ErrorContains(t, err, "templates.produce.outputs.artifacts.out.path must be on a volume mount to be streamed")
+
+	wf = unmarshalWf(streamOutputArtifact)
+	wf.Spec.Templates[0].DAG.Tasks[1].Depends = "produce.Streamed"
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.ErrorContains(t, err, "task result 'Streamed' for task 'produce' is invalid")
+}
+
 var activeDeadlineSeconds = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1821,6 +1891,126 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	require.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
 }
 
+var approvalGateWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: approval-gate-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: timeout
+      value: 1h
+  templates:
+  - name: main
+    steps:
+    - - name: approve
+        template: approve
+        arguments:
+          parameters:
+          - name: timeout
+            value: "{{workflow.parameters.timeout}}"
+  - name: approve
+    inputs:
+      parameters:
+      - name: timeout
+    suspend:
+      approval:
+        approvers:
+          groups: [release-managers]
+        timeout: "{{inputs.parameters.timeout}}"
+        timeoutAction: Escalate
+        escalation:
+          approvers:
+            users: [admin]
+`
+
+func TestApprovalGate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, approvalGateWorkflow)
+	require.NoError(t, err)
+
+	wf := unmarshalWf(approvalGateWorkflow)
+	wf.Spec.Templates[1].Suspend.Duration = "1h"
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.EqualError(t, err, "templates.main.steps[0].approve templates.approve.suspend.duration and approval are mutually exclusive, use approval.timeout")
+
+	wf = unmarshalWf(approvalGateWorkflow)
+	wf.Spec.Templates[1].Suspend.Approval.Escalation = nil
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.EqualError(t, err, "templates.main.steps[0].approve templates.approve.suspend.approval.escalation.approvers is required with the \"Escalate\" timeout action")
+
+	wf = unmarshalWf(approvalGateWorkflow)
+	wf.Spec.Templates[1].Suspend.Approval.Timeout = "soon"
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.ErrorContains(t, err, "templates.main.steps[0].approve templates.approve.suspend.approval.timeout \"soon\" is not a valid duration")
+}
+
+var httpOutputParametersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-outputs-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      url: https://example.com/jobs
+    outputs:
+      parameters:
+      - name: id
+        valueFrom:
+          jsonPath: "$.id"
+      - name: location
+        valueFrom:
+          expression: response.headers["Location"][0]
+`
+
+func TestHTTPOutputParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, httpOutputParametersWorkflow)
+	require.NoError(t, err)
+
+	wf := unmarshalWf(httpOutputParametersWorkflow)
+	wf.Spec.Templates[0].Outputs.Parameters[0].ValueFrom = &wfv1.ValueFrom{Path: "/tmp/id"}
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.EqualError(t, err, "templates.main.outputs.parameters.id.jsonPath or expression must be specified for HTTP templates")
+}
+
+var httpAsyncWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-async-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      url: https://example.com/jobs
+      body: "{{http.callbackURL}}"
+      async:
+        callback: {}
+`
+
+func TestHTTPAsync(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, httpAsyncWorkflow)
+	require.NoError(t, err)
+
+	wf := unmarshalWf(httpAsyncWorkflow)
+	wf.Spec.Templates[0].HTTP.Async.Poll = &wfv1.HTTPPoll{SuccessCondition: "true"}
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.EqualError(t, err, "templates.main.http.async.exactly one of poll or callback must be specified")
+
+	wf = unmarshalWf(httpAsyncWorkflow)
+	wf.Spec.Templates[0].HTTP.Async = &wfv1.HTTPAsync{Poll: &wfv1.HTTPPoll{}}
+	err = Workflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, Opts{})
+	require.EqualError(t, err, "templates.main.http.async.poll.successCondition is required")
+}
+
 var invalidPodGC = `
 metadata:
   generateName: pod-gc-strategy-unknown-
@@ -3372,6 +3562,83 @@ func TestStepsOutputParametersForContainerSet(t *testing.T) {
 	require.NoError(t, err)
 }
 
+var containerSetContainerOutputs = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: container-set-outputs-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: set
+            template: set
+          - name: print
+            template: print
+            depends: set
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{tasks.set.containerSet.a.outputs.parameters.p}}"
+              artifacts:
+                - name: file
+                  from: "{{tasks.set.containerSet.a.outputs.artifacts.file}}"
+    - name: set
+      containerSet:
+        containers:
+          - name: a
+            image: argoproj/argosay:v2
+            outputs:
+              parameters:
+                - name: p
+                  valueFrom:
+                    path: /tmp/p
+              artifacts:
+                - name: file
+                  path: /tmp/file
+    - name: print
+      inputs:
+        parameters:
+          - name: message
+        artifacts:
+          - name: file
+            path: /tmp/file
+      container:
+        image: argoproj/argosay:v2
+        args: ["{{inputs.parameters.message}}"]
+`
+
+func TestContainerSetContainerOutputs(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, containerSetContainerOutputs)
+	require.NoError(t, err)
+	err = validate(ctx, strings.Replace(containerSetContainerOutputs, "outputs.parameters.p}}", "outputs.parameters.q}}", 1))
+	require.ErrorContains(t, err, "failed to resolve {{tasks.set.containerSet.a.outputs.parameters.q}}")
+}
+
+func TestInvalidContainerSetContainerOutputs(t *testing.T) {
+	err := validate(logging.TestContext(t.Context()), `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: container-set-outputs-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      containerSet:
+        containers:
+          - name: main
+            image: argoproj/argosay:v2
+            outputs:
+              parameters:
+                - name: p
+`)
+	require.ErrorContains(t, err, "templates.main.containerSet.containers.main.outputs.parameters.p.valueFrom.path must be specified")
+}
+
 var globalAnnotationsAndLabels = `apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
@@ -4191,3 +4458,251 @@ func TestPodResourceClaimsValidation(t *testing.T) {
 	err = validate(ctx, resourceClaimsOnStepsTemplate)
 	require.ErrorContains(t, err, "templates.main.resourceClaims is not supported for Steps templates, which do not create a pod")
 }
+
+var stepsLoop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: steps-loop-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        arguments:
+          parameters:
+          - name: attempt
+            value: "{{loop.iteration}}"
+        loop:
+          until: outputs.result == "done"
+          maxIterations: 10
+          delay: 30s
+    - - name: after
+        template: poll
+        arguments:
+          parameters:
+          - name: attempt
+            value: "{{steps.poll.outputs.result}}"
+  - name: poll
+    inputs:
+      parameters:
+      - name: attempt
+    script:
+      image: alpine:3.23
+      command: [sh]
+      source: echo done
+`
+
+var dagLoop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-loop-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: poll
+        template: poll
+        withItems: [a, b]
+        loop:
+          until: outputs.result == "done"
+          maxIterations: 10
+  - name: poll
+    script:
+      image: alpine:3.23
+      command: [sh]
+      source: echo done
+`
+
+var loopIterationOutsideLoop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: loop-iteration-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        arguments:
+          parameters:
+          - name: attempt
+            value: "{{loop.iteration}}"
+  - name: poll
+    inputs:
+      parameters:
+      - name: attempt
+    container:
+      image: alpine:3.23
+`
+
+func TestLoopValidation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, stepsLoop))
+	err := validate(ctx, strings.Replace(stepsLoop, "maxIterations: 10", "maxIterations: 0", 1))
+	require.ErrorContains(t, err, "templates.main.steps[0].poll loop.maxIterations must be at least 1")
+	err = validate(ctx, strings.Replace(stepsLoop, "          maxIterations: 10\n", "", 1))
+	require.ErrorContains(t, err, "loop.maxIterations is required")
+	err = validate(ctx, strings.Replace(stepsLoop, `until: outputs.result == "done"`, `until: ""`, 1))
+	require.ErrorContains(t, err, "loop.until is required")
+	err = validate(ctx, strings.Replace(stepsLoop, "delay: 30s", "delay: soon", 1))
+	require.ErrorContains(t, err, "loop.delay is invalid")
+	err = validate(ctx, dagLoop)
+	require.ErrorContains(t, err, "templates.main.tasks.poll loop cannot be used with withItems, withParam or withSequence")
+	err = validate(ctx, loopIterationOutsideLoop)
+	require.ErrorContains(t, err, "failed to resolve {{loop.iteration}}")
+}
+
+var dagGenerateTasks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-generate-tasks-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+        generateTasks: {}
+      - name: after
+        template: generate
+        depends: generate
+  - name: generate
+    script:
+      image: alpine:3.23
+      command: [sh]
+      source: echo []
+    outputs:
+      parameters:
+      - name: tasks
+        valueFrom:
+          path: /tmp/tasks.json
+`
+
+func TestGenerateTasksValidation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, dagGenerateTasks))
+	require.NoError(t, validate(ctx, strings.Replace(dagGenerateTasks, "generateTasks: {}", "generateTasks: {parameter: tasks}", 1)))
+	err := validate(ctx, strings.Replace(dagGenerateTasks, "generateTasks: {}", "generateTasks: {parameter: missing}", 1))
+	require.ErrorContains(t, err, "templates.main.tasks.generate generateTasks.parameter 'missing' is not an output parameter of the template")
+	err = validate(ctx, strings.Replace(dagGenerateTasks, "generateTasks: {}", "generateTasks: {}\n        withItems: [a, b]", 1))
+	require.ErrorContains(t, err, "templates.main.tasks.generate generateTasks cannot be used with withItems, withParam or withSequence")
+}
+
+func TestGeneratedDAGTasks(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(dagGenerateTasks)
+	tmplCtx := templateresolution.NewContext(wftmplGetter, cwftmplGetter, wf, wf, logging.RequireLoggerFromContext(ctx))
+	tmpl := wf.GetTemplateByName("main").DeepCopy()
+	tmpl.DAG.Tasks = append(tmpl.DAG.Tasks, wfv1.DAGTask{Name: "a", Template: "generate", Depends: "generate"})
+	require.NoError(t, GeneratedDAGTasks(ctx, wf, tmplCtx, tmpl, nil))
+	tmpl.DAG.Tasks = append(tmpl.DAG.Tasks, wfv1.DAGTask{Name: "b", Template: "missing", Depends: "generate"})
+	require.ErrorContains(t, GeneratedDAGTasks(ctx, wf, tmplCtx, tmpl, nil), "templates.main.tasks.b template name 'missing' undefined")
+}
+
+var stepsSwitch = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: steps-switch-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: size
+      value: large
+  templates:
+  - name: main
+    steps:
+    - - name: process
+        switch:
+          expression: workflow.parameters.size
+          cases:
+          - value: small
+            template: small
+          - value: large
+            template: large
+            arguments:
+              parameters:
+              - name: message
+                value: large
+    - - name: after
+        template: large
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.process.outputs.parameters.small}} {{steps.process.outputs.parameters.large}}"
+  - name: small
+    container:
+      image: alpine:3.23
+    outputs:
+      parameters:
+      - name: small
+        valueFrom:
+          path: /tmp/small
+  - name: large
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.23
+    outputs:
+      parameters:
+      - name: large
+        valueFrom:
+          path: /tmp/large
+`
+
+var dagSwitch = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-switch-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: process
+        switch:
+          expression: "'small'"
+          cases:
+          - value: small
+            template: small
+          default:
+            template: small
+  - name: small
+    container:
+      image: alpine:3.23
+`
+
+func TestSwitchValidation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, stepsSwitch))
+	require.NoError(t, validate(ctx, dagSwitch))
+	err := validate(ctx, strings.Replace(stepsSwitch, "value: small\n            template: small", "value: small\n            template: missing", 1))
+	require.ErrorContains(t, err, "templates.main.steps[0].process template name 'missing' undefined")
+	err = validate(ctx, strings.Replace(stepsSwitch, "            arguments:\n              parameters:\n              - name: message\n                value: large\n", "", 1))
+	require.ErrorContains(t, err, "templates.main.steps[0].process templates.large inputs.parameters.message was not supplied")
+	err = validate(ctx, strings.Replace(stepsSwitch, "value: small", "value: large", 1))
+	require.ErrorContains(t, err, "templates.main.steps[0].process switch.cases[1].value 'large' is not unique")
+	err = validate(ctx, strings.Replace(stepsSwitch, "expression: workflow.parameters.size", "expression: \"\"", 1))
+	require.ErrorContains(t, err, "templates.main.steps[0].process switch.expression is required")
+	err = validate(ctx, strings.Replace(dagSwitch, "      - name: process\n", "      - name: process\n        template: small\n", 1))
+	require.ErrorContains(t, err, "templates.main.tasks.process switch cannot be used with template, templateRef or inline")
+	err = validate(ctx, strings.Replace(dagSwitch, "          default:\n            template: small\n", "          default: {}\n", 1))
+	require.ErrorContains(t, err, "templates.main.tasks.process switch.default must have a template or templateRef")
+	err = validate(ctx, strings.Replace(dagSwitch, "      - name: process\n", "      - name: process\n        withItems: [a]\n", 1))
+	require.ErrorContains(t, err, "templates.main.tasks.process switch cannot be used with withItems, withParam or withSequence")
+}